@@ -0,0 +1,279 @@
+package zebrahook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"zebrahook/models"
+	"zebrahook/utils"
+
+	"github.com/spf13/viper"
+)
+
+// event type as documented in the catalog
+type catalogEventType struct {
+	Name        string
+	Description string
+	Deprecated  bool
+	// indented json, empty if not set
+	Schema  string
+	Example string
+
+	schema  map[string]interface{}
+	example map[string]interface{}
+}
+
+// informations shared by all the event types
+type catalog struct {
+	SignatureHeaderName string
+	UserAgent           string
+	RetryPolicy         string
+	MaxAttempts         uint
+	EventTypes          []catalogEventType
+}
+
+func indentJson(content map[string]interface{}) string {
+	if content == nil {
+		return ""
+	}
+	indented, _ := json.MarshalIndent(content, "", "  ")
+	return string(indented)
+}
+
+func newCatalog(eventTypes []models.EventType) catalog {
+	deliveryPolicy := utils.GetDeliveryPolicy(models.Endpoint{})
+	// validated when loading the configuration
+	backoffStrategy, _ := deliveryPolicy.BackoffStrategy()
+
+	retryPolicy := fmt.Sprintf("Unless the endpoint has its own delivery policy, deliveries that don't receive a 2xx response within %d seconds are retried up to %d attempts in total, "+
+		"with a %s backoff: before each retry the delivery waits %s. "+
+		"A 410 Gone response stops the retries of the delivery and disables the endpoint. ",
+		deliveryPolicy.TimeoutSecs, deliveryPolicy.MaxAttempts, deliveryPolicy.BackoffType, backoffStrategy.Description())
+	// the endpoint health is handled by the circuit breaker when enabled
+	if viper.GetBool("circuitBreaker.enabled") {
+		retryPolicy += "When all the attempts fail the delivery is kept as a dead letter, that the sender can replay, and the endpoint stays enabled. " +
+			fmt.Sprintf("When at least %d%% of the attempts (and at least %d attempts) of the last %d seconds fail, the deliveries to the endpoint are held: "+
+				"every %d seconds one of them is sent as probe, once a probe is delivered all the held deliveries are sent.",
+				int(viper.GetFloat64("circuitBreaker.failureRatio")*100), viper.GetUint("circuitBreaker.minRequests"),
+				viper.GetUint("circuitBreaker.windowSecs"), viper.GetUint("circuitBreaker.probeIntervalSecs"))
+	} else {
+		retryPolicy += "When all the attempts fail the delivery is kept as a dead letter, that the sender can replay, and the endpoint is disabled."
+	}
+
+	eventCatalog := catalog{
+		SignatureHeaderName: viper.GetString("webhookRequest.signatureHeaderName"),
+		UserAgent:           viper.GetString("webhookRequest.userAgent"),
+		RetryPolicy:         retryPolicy,
+		MaxAttempts:         deliveryPolicy.MaxAttempts,
+	}
+
+	for _, eventType := range eventTypes {
+		formattedEventType := formatEventType(eventType)
+
+		catalogEventType := catalogEventType{
+			Name:       eventType.Name,
+			Deprecated: eventType.Deprecated,
+			schema:     formattedEventType.Schema,
+			example:    formattedEventType.Example,
+			Schema:     indentJson(formattedEventType.Schema),
+			Example:    indentJson(formattedEventType.Example),
+		}
+		if formattedEventType.Description != nil {
+			catalogEventType.Description = *formattedEventType.Description
+		}
+
+		eventCatalog.EventTypes = append(eventCatalog.EventTypes, catalogEventType)
+	}
+
+	return eventCatalog
+}
+
+func (c catalog) signatureDescription() string {
+	return "`t=<unix timestamp>,v1=<signature>`, the signature is the hex encoded HMAC SHA256 of `<unix timestamp>.<payload>` " +
+		"using the endpoint secret. After a secret rotation a second `v1` signature made with the previous secret is included."
+}
+
+// AsyncAPI 2.6 document, each event type is a channel that the
+// webhook endpoints subscribe to (http POST request)
+func (c catalog) asyncApi() ([]byte, error) {
+	channels := map[string]interface{}{}
+	messages := map[string]interface{}{}
+
+	for _, eventType := range c.EventTypes {
+		payload := eventType.schema
+		if payload == nil {
+			payload = map[string]interface{}{"type": "object"}
+		}
+
+		message := map[string]interface{}{
+			"name":        eventType.Name,
+			"title":       eventType.Name,
+			"contentType": "application/json",
+			"headers": map[string]interface{}{
+				"$ref": "#/components/schemas/WebhookHeaders",
+			},
+			"payload": payload,
+		}
+		if eventType.Description != "" {
+			message["summary"] = eventType.Description
+		}
+		if eventType.example != nil {
+			message["examples"] = []interface{}{
+				map[string]interface{}{"payload": eventType.example},
+			}
+		}
+		if eventType.Deprecated {
+			message["x-deprecated"] = true
+		}
+		messages[eventType.Name] = message
+
+		channels[eventType.Name] = map[string]interface{}{
+			"description": eventType.Description,
+			"subscribe": map[string]interface{}{
+				"operationId": eventType.Name,
+				"summary":     "Webhook request sent for each `" + eventType.Name + "` event",
+				"bindings": map[string]interface{}{
+					"http": map[string]interface{}{
+						"type":           "request",
+						"method":         "POST",
+						"bindingVersion": "0.1.0",
+					},
+				},
+				"message": map[string]interface{}{
+					"$ref": "#/components/messages/" + eventType.Name,
+				},
+			},
+		}
+	}
+
+	document := map[string]interface{}{
+		"asyncapi": "2.6.0",
+		"info": map[string]interface{}{
+			"title":       "Zebrahook webhooks",
+			"version":     "1.0.0",
+			"description": "Events delivered to the registered webhook endpoints.\n\n" + c.RetryPolicy,
+		},
+		"defaultContentType": "application/json",
+		"servers": map[string]interface{}{
+			"webhookEndpoint": map[string]interface{}{
+				"url":         "{endpointUrl}",
+				"protocol":    "https",
+				"description": "URL of the registered webhook endpoint",
+				"variables": map[string]interface{}{
+					"endpointUrl": map[string]interface{}{
+						"description": "webhook endpoint url, including scheme and path",
+					},
+				},
+			},
+		},
+		"channels": channels,
+		"components": map[string]interface{}{
+			"messages": messages,
+			"schemas": map[string]interface{}{
+				"WebhookHeaders": map[string]interface{}{
+					"type":     "object",
+					"required": []string{c.SignatureHeaderName},
+					"properties": map[string]interface{}{
+						c.SignatureHeaderName: map[string]interface{}{
+							"type":        "string",
+							"description": c.signatureDescription(),
+						},
+						"User-Agent": map[string]interface{}{
+							"type":  "string",
+							"const": c.UserAgent,
+						},
+					},
+				},
+			},
+		},
+		"x-zebrahook-retry-policy": map[string]interface{}{
+			"strategy":     viper.GetString("backoffStrategy.type"),
+			"maxAttempts":  c.MaxAttempts,
+			"baseSecs":     viper.GetUint("backoffStrategy.baseSecs"),
+			"maxSecs":      viper.GetUint("backoffStrategy.maxBackoffSecs"),
+			"scheduleSecs": utils.GetDeliveryPolicy(models.Endpoint{}).BackoffScheduleSecs,
+			"description":  c.RetryPolicy,
+			"circuitBreaker": map[string]interface{}{
+				"enabled":           viper.GetBool("circuitBreaker.enabled"),
+				"windowSecs":        viper.GetUint("circuitBreaker.windowSecs"),
+				"minRequests":       viper.GetUint("circuitBreaker.minRequests"),
+				"failureRatio":      viper.GetFloat64("circuitBreaker.failureRatio"),
+				"probeIntervalSecs": viper.GetUint("circuitBreaker.probeIntervalSecs"),
+			},
+		},
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
+
+func (c catalog) markdown() []byte {
+	var markdown strings.Builder
+
+	markdown.WriteString("# Zebrahook webhooks\n\n")
+	markdown.WriteString("Each event is delivered with an HTTP `POST` request to the webhook endpoints subscribed to its event type, the request body is the event content (`application/json`).\n\n")
+
+	markdown.WriteString("## Signature\n\n")
+	markdown.WriteString("The `" + c.SignatureHeaderName + "` header contains " + c.signatureDescription() + "\n\n")
+
+	markdown.WriteString("## Retry policy\n\n")
+	markdown.WriteString(c.RetryPolicy + "\n\n")
+
+	markdown.WriteString("## Event types\n\n")
+	if len(c.EventTypes) == 0 {
+		markdown.WriteString("No event types registered.\n")
+	}
+	for _, eventType := range c.EventTypes {
+		markdown.WriteString("### `" + eventType.Name + "`\n\n")
+		if eventType.Deprecated {
+			markdown.WriteString("**Deprecated**\n\n")
+		}
+		if eventType.Description != "" {
+			markdown.WriteString(eventType.Description + "\n\n")
+		}
+		if eventType.Schema != "" {
+			markdown.WriteString("Schema:\n\n```json\n" + eventType.Schema + "\n```\n\n")
+		}
+		if eventType.Example != "" {
+			markdown.WriteString("Example:\n\n```json\n" + eventType.Example + "\n```\n\n")
+		}
+	}
+
+	return []byte(markdown.String())
+}
+
+var catalogHtmlTemplate = template.Must(template.New("catalog").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Zebrahook webhooks</title>
+</head>
+<body>
+<h1>Zebrahook webhooks</h1>
+<p>Each event is delivered with an HTTP <code>POST</code> request to the webhook endpoints subscribed to its event type, the request body is the event content (<code>application/json</code>).</p>
+<h2>Signature</h2>
+<p>The <code>{{.SignatureHeaderName}}</code> header contains <code>t=&lt;unix timestamp&gt;,v1=&lt;signature&gt;</code>, the signature is the hex encoded HMAC SHA256 of <code>&lt;unix timestamp&gt;.&lt;payload&gt;</code> using the endpoint secret. After a secret rotation a second <code>v1</code> signature made with the previous secret is included.</p>
+<h2>Retry policy</h2>
+<p>{{.RetryPolicy}}</p>
+<h2>Event types</h2>
+{{range .EventTypes}}<h3><code>{{.Name}}</code></h3>
+{{if .Deprecated}}<p><strong>Deprecated</strong></p>
+{{end}}{{if .Description}}<p>{{.Description}}</p>
+{{end}}{{if .Schema}}<p>Schema:</p>
+<pre>{{.Schema}}</pre>
+{{end}}{{if .Example}}<p>Example:</p>
+<pre>{{.Example}}</pre>
+{{end}}{{else}}<p>No event types registered.</p>
+{{end}}</body>
+</html>
+`))
+
+func (c catalog) html() ([]byte, error) {
+	var html bytes.Buffer
+	if err := catalogHtmlTemplate.Execute(&html, c); err != nil {
+		return nil, err
+	}
+	return html.Bytes(), nil
+}
@@ -46,6 +46,8 @@ func main() {
 		secureF           = flag.Bool("secure", false, "Server, use secure scheme (https or grpcs)")
 		dbgF              = flag.Bool("http-verbose", false, "Server, log request and response bodies")
 		generateNewApiKey = flag.String("new-api-key", "", "Allows to generate a new API key")
+		apiKeyScopes      = flag.String("api-key-scopes", constants.ScopeAll, "Comma separated scopes granted to the new API key, available: "+strings.Join(constants.ApiKeyScopes, ", "))
+		apiKeyTenant      = flag.String("api-key-tenant", "", "Tenant owning the new API key, endpoints and events are isolated per tenant")
 		setupDb           = flag.Bool("setup", false, "Setup database with required sql tables")
 	)
 
@@ -91,6 +93,9 @@ func main() {
 			rawDb.Close()
 		}()
 
+		// deliveries created before the status column existed are backfilled after the migration
+		backfillDeliveryStatus := db.Migrator().HasTable(&models.EventDelivery{}) && !db.Migrator().HasColumn(&models.EventDelivery{}, "Status")
+
 		// auto migrate tables
 		err = db.AutoMigrate(
 			&models.Endpoint{},
@@ -98,11 +103,35 @@ func main() {
 			&models.Event{},
 			&models.EventDelivery{},
 			models.EventDeliveryAttempt{},
+			&models.EndpointRecovery{},
+			&models.IdempotencyKey{},
+			&models.EventType{},
+			&models.EndpointStatusEvent{},
+			&models.EndpointStatsRollup{},
+			&models.DeadLetter{},
 		)
 		if err != nil {
 			panic(err)
 		}
 
+		// success if an attempt succeeded, failed without attempts remaining, pending otherwise
+		if backfillDeliveryStatus {
+			err = db.Transaction(func(tx *gorm.DB) error {
+				result := tx.Exec(`UPDATE event_deliveries d SET status = ?
+				WHERE EXISTS (SELECT 1 FROM event_delivery_attempts a WHERE a.event_delivery_id = d.id AND a.status = ?)`,
+					constants.DeliveryStatusSuccess, constants.AttemptStatusSuccess)
+				if result.Error != nil {
+					return result.Error
+				}
+
+				return tx.Exec("UPDATE event_deliveries SET status = ? WHERE status = ? AND attempts_remaining = 0",
+					constants.DeliveryStatusFailed, constants.DeliveryStatusPending).Error
+			})
+			if err != nil {
+				panic(err)
+			}
+		}
+
 		// create pgql table
 		// ref https://github.com/btubbs/pgq/blob/0a3335913e86a402013ee81a9e45ffbe502bbffe/sql/create_table.sql
 		db.Exec(`BEGIN;
@@ -177,7 +206,14 @@ func main() {
 		// check if generate api key command is provided
 		if generateNewApiKey != nil && *generateNewApiKey != "" {
 			log.Print("generate api key!! ", *generateNewApiKey)
-			apiKeyCreationResult, _ := frontSvc.CreateAPIKey(ctx, &front.CreateAPIKeyPayload{Description: generateNewApiKey})
+			apiKeyCreationResult, err := frontSvc.CreateAPIKey(ctx, &front.CreateAPIKeyPayload{
+				Description: generateNewApiKey,
+				Scopes:      strings.Split(*apiKeyScopes, ","),
+				Tenant:      apiKeyTenant,
+			})
+			if err != nil {
+				zerologInstance.Fatal().Err(err).Msg("unable to create API key")
+			}
 
 			// output cleartext api key
 			log.Print("created new API Key: " + apiKeyCreationResult.APIKey)
@@ -9,14 +9,113 @@ const (
 
 	ZEBRAHOOK_ID_WEBHOOK_SECRET_PREFIX = ZEBRAHOOK_ID_PREFIX + "whsec_"
 
+	ZEBRAHOOK_ID_ENDPOINT_RECOVERY_PREFIX = ZEBRAHOOK_ID_PREFIX + "rec_"
+
 	StatusEnabled  string = "enabled"
 	StatusDisabled string = "disabled"
 
+	// event delivery status
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusSuccess   = "success"
+	DeliveryStatusFailed    = "failed"
+	DeliveryStatusCancelled = "cancelled"
+	DeliveryStatusHeld      = "held"
+
+	// event delivery attempt status
+	AttemptStatusPending   = "pending"
+	AttemptStatusSuccess   = "success"
+	AttemptStatusCancelled = "cancelled"
+	// request not sent, it couldn't be prepared (e.g. endpoint headers not decryptable)
+	AttemptStatusErrorInternal = "error_internal"
+	// request not sent, denied by the egress policy
+	AttemptStatusErrorBlocked = "error_blocked"
+
+	// reason of an endpoint status change
+	StatusReasonManual              = "manual"
+	StatusReasonRecovery            = "recovery"
+	StatusReasonMaxAttemptsExceeded = "max_attempts_exceeded"
+	StatusReasonHttpGone            = "http_410_gone"
+
+	// system events emitted by zebrahook, delivered only to the
+	// endpoints explicitly subscribed to them (not to `*`)
+	SystemEventPrefix            = "zebrahook."
+	SystemEventEndpointDisabled  = "zebrahook.endpoint.disabled"
+	SystemEventEndpointFailing   = "zebrahook.endpoint.failing"
+	SystemEventDeliveryExhausted = "zebrahook.delivery.exhausted"
+
+	// dead letter status
+	DeadLetterStatusDead     = "dead"
+	DeadLetterStatusReplayed = "replayed"
+
+	// endpoint circuit breaker state
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half_open"
+
+	// endpoint recovery status
+	RecoveryStatusPending   = "pending"
+	RecoveryStatusRunning   = "running"
+	RecoveryStatusCompleted = "completed"
+	RecoveryStatusFailed    = "failed"
+
+	// event mapping status returned on submission
+	EventMappingPending = "pending_mapping"
+	EventMappingMapped  = "mapped"
+
+	// error codes of rejected events on submission
+	EventErrorInvalidEventType    = "invalid_event_type"
+	EventErrorInvalidEventContent = "invalid_event_content"
+	EventErrorUnregisteredType    = "unregistered_event_type"
+	EventErrorSchemaValidation    = "schema_validation_failed"
+
+	// event type registry validation of submitted events
+	// strict: reject events of unregistered types
+	// warn: accept events of unregistered types (logged)
+	// off: registry not used
+	EventTypeValidationStrict = "strict"
+	EventTypeValidationWarn   = "warn"
+	EventTypeValidationOff    = "off"
+
+	// idempotency key scope
+	IdempotencyKindRequest = "request"
+	IdempotencyKindEvent   = "event"
+
+	// api key scopes
+	ScopeAll            = "*"
+	ScopeEventsRead     = "events:read"
+	ScopeEventsWrite    = "events:write"
+	ScopeEndpointsRead  = "endpoints:read"
+	ScopeEndpointsWrite = "endpoints:write"
+	ScopeSecretsRead    = "secrets:read"
+	ScopeAdmin          = "admin"
+
+	// backoff strategies between delivery attempts
+	BackoffExponential = "exponential"
+	BackoffLinear      = "linear"
+	BackoffFixed       = "fixed"
+	BackoffSchedule    = "schedule"
+
 	// queue naming
-	QueueEventMapping    = "event_mapping"
-	QueueWebhookDelivery = "webhook_delivery"
+	QueueEventMapping     = "event_mapping"
+	QueueWebhookDelivery  = "webhook_delivery"
+	QueueEndpointRecovery = "endpoint_recovery"
+	QueueCircuitProbe     = "endpoint_circuit_probe"
+	QueueStatsRollup      = "endpoint_stats_rollup"
+	QueueDeadLetterPurge  = "dead_letter_purge"
 
 	// worker naming (used for config)
 	WorkerEventMapping = "eventMapping"
 	WorkerDispatcher   = "dispatcher"
 )
+
+// allowed values of `backoffStrategy.type` configuration
+var BackoffTypes = []string{BackoffExponential, BackoffLinear, BackoffFixed, BackoffSchedule}
+
+// system events that can be sent by email (`notifications.smtp.eventTypes`)
+var SystemEventTypes = []string{SystemEventEndpointDisabled, SystemEventEndpointFailing, SystemEventDeliveryExhausted}
+
+// allowed values of `eventTypes.validation` configuration
+var EventTypeValidationModes = []string{EventTypeValidationStrict, EventTypeValidationWarn, EventTypeValidationOff}
+
+// scopes that can be granted to an api key
+var ApiKeyScopes = []string{ScopeAll, ScopeEventsRead, ScopeEventsWrite, ScopeEndpointsRead, ScopeEndpointsWrite, ScopeSecretsRead, ScopeAdmin}
@@ -2,12 +2,20 @@ package zebrahook
 
 import (
 	"context"
+	"zebrahook/utils"
 	// "github.com/golang-jwt/jwt/v4"
 )
 
 type authInfo struct {
 	// user   string
-	userId string
+	// tenant owning the api key, every resource access is restricted to it
+	tenantId string
+	// api key used to authenticate, 0 when authenticated with a jwt token
+	apiKeyId uint
+	// jwt token subject (`sub`), empty when authenticated with an api key
+	subject string
+	// scopes granted
+	scopes []string
 	// claims jwt.MapClaims
 	// key    string
 }
@@ -28,3 +36,18 @@ func contextAuthInfo(ctx context.Context) (auth authInfo) {
 	auth, _ = ctx.Value(ctxValueClaims).(authInfo)
 	return
 }
+
+// endpoint status change made by the authenticated api key or jwt subject
+func (auth authInfo) endpointStatusChange(status string, reason string) utils.EndpointStatusChange {
+	change := utils.EndpointStatusChange{
+		Status: status,
+		Reason: reason,
+	}
+	if auth.apiKeyId != 0 {
+		change.ActorApiKeyID = &auth.apiKeyId
+	}
+	if auth.subject != "" {
+		change.ActorSubject = &auth.subject
+	}
+	return change
+}
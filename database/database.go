@@ -2,8 +2,10 @@ package database
 
 import (
 	"database/sql"
+	"errors"
 
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 type DatabaseInfo struct {
@@ -27,3 +29,14 @@ func Open() DatabaseInfo {
 		Dsn:            dsn,
 	}
 }
+
+// returns the sql transaction used by a gorm transaction, allows to
+// enqueue pgq jobs in the same transaction of the other queries
+func SqlTx(tx *gorm.DB) (*sql.Tx, error) {
+	sqlTx, ok := tx.Statement.ConnPool.(*sql.Tx)
+	if !ok {
+		return nil, errors.New("expected gorm instance to be within a transaction")
+	}
+
+	return sqlTx, nil
+}
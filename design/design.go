@@ -3,7 +3,14 @@ package design
 import . "goa.design/goa/v3/dsl"
 
 var ApiKeyOrJWTviaToken = JWTSecurity("jwt", func() {
-	Description("Provide a JWT token or an API key")
+	Description("Provide a JWT token (HS256, RS256 or ES256, scopes granted via the `scope` claim) or an API key (`sk_...`)")
+
+	Scope("events:read", "Read submitted events and their deliveries")
+	Scope("events:write", "Submit new events and deliver them again")
+	Scope("endpoints:read", "Read webhook endpoints and their delivery attempts")
+	Scope("endpoints:write", "Register, update and delete webhook endpoints")
+	Scope("secrets:read", "Read webhook endpoints secrets")
+	Scope("admin", "Manage api keys")
 })
 
 // API describes the global properties of the API server.
@@ -44,9 +51,47 @@ var EventRequest = Type("EventRequest", func() {
 		Example(1000)
 	})
 
+	Attribute("idempotency_key", String, "Optional idempotency key for this event, if an event with the same key was already submitted the event is not created again", func() {
+		MinLength(1)
+		MaxLength(255)
+		Example("order-12643-shipped")
+	})
+
 	Required("event_type", "event_content")
 })
 
+var DeliveryPolicy = Type("DeliveryPolicy", func() {
+	Attribute("timeout_secs", UInt, "HTTP request timeout in seconds", func() {
+		Minimum(1)
+		Maximum(300)
+		Example(30)
+	})
+	Attribute("max_attempts", UInt, "maximum number of delivery attempts of each event", func() {
+		Minimum(1)
+		Maximum(100)
+		Example(5)
+	})
+	Attribute("backoff_type", String, "backoff strategy between two attempts: exponential (with full jitter), linear, fixed or schedule", func() {
+		Enum("exponential", "linear", "fixed", "schedule")
+	})
+	Attribute("backoff_base_secs", UInt, "base seconds used to calculate the backoff", func() {
+		Minimum(1)
+		Example(60)
+	})
+	Attribute("max_backoff_secs", UInt, "maximum seconds between two attempts", func() {
+		Minimum(1)
+		Example(3600)
+	})
+	Attribute("backoff_schedule_secs", ArrayOf(UInt), "seconds to wait before each retry with the schedule backoff type, the last one is used for the remaining attempts", func() {
+		MinLength(1)
+		MaxLength(100)
+		Elem(func() {
+			Minimum(1)
+		})
+		Example([]uint{60, 300, 1800, 3600, 18000, 36000})
+	})
+})
+
 var NewWebhookEndpointRequest = Type("NewWebhookEndpointRequest", func() {
 	Attribute("url", String, "URL of the webhook that will be called on each `enabled_events`", func() {
 		Format(FormatURI)
@@ -74,6 +119,19 @@ var NewWebhookEndpointRequest = Type("NewWebhookEndpointRequest", func() {
 		})
 	})
 
+	Attribute("headers", MapOf(String, String), "Optional custom HTTP headers added to every webhook request (e.g. `X-Api-Key`), values are stored encrypted and masked in responses. `User-Agent`, `Content-Type`, the signature header and the hop-by-hop headers are reserved", func() {
+		Example(map[string]string{"X-Api-Key": "your-gateway-api-key"})
+
+		Key(func() {
+			MinLength(1) // min length of map key
+		})
+		Elem(func() {
+			MinLength(1) // min length of map value
+		})
+	})
+
+	Attribute("delivery_policy", DeliveryPolicy, "Optional delivery policy of this endpoint, settings not provided fall back to the global configuration")
+
 	Required("url", "enabled_events")
 })
 
@@ -116,6 +174,19 @@ var UpdateWebhookEndpointRequest = Type("UpdateWebhookEndpointRequest", func() {
 		})
 	})
 
+	Attribute("headers", MapOf(String, String), "Custom HTTP headers added to every webhook request, replaces the current ones (use an empty object to remove them)", func() {
+		Example(map[string]string{"X-Api-Key": "your-gateway-api-key"})
+
+		Key(func() {
+			MinLength(1) // min length of map key
+		})
+		Elem(func() {
+			MinLength(1) // min length of map value
+		})
+	})
+
+	Attribute("delivery_policy", DeliveryPolicy, "Delivery policy of this endpoint, replaces the current one (settings not provided fall back to the global configuration)")
+
 	Extend(WebhookId)
 
 	Required("id")
@@ -137,6 +208,15 @@ var WebhookEndpointWithoutSecret = Type("WebhookEndpointWithoutSecret", func() {
 	Attribute("status", String, "status of current endpoint, enabled means that the webhook endpoint is eligible for receiving webhook events", func() {
 		Enum("enabled", "disabled")
 	})
+	Attribute("disabled_reason", String, "why the endpoint was disabled, not set when enabled", func() {
+		Enum("manual", "max_attempts_exceeded", "http_410_gone")
+	})
+	Attribute("circuit_state", String, "circuit breaker state, deliveries are held while open and a single probe delivery is sent while half open", func() {
+		Enum("closed", "open", "half_open")
+	})
+	Attribute("circuit_opened_at", Int64, "when the circuit was opened (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
 
 	Required("id", "url", "enabled_events", "createdAt", "updatedAt")
 
@@ -151,6 +231,350 @@ var WebhookEndpoint = Type("WebhookEndpoint", func() {
 	Extend(WebhookSecret)
 })
 
+var Event = Type("Event", func() {
+	Attribute("id", UInt, "identifier of the event", func() {
+		Example(1024)
+	})
+	Attribute("event_type", String, "Event type of the `event_content`", func() {
+		Example("merchant-93842.order.shipped")
+	})
+	Attribute("event_content", MapOf(String, Any), "event content dispatched to the webhook endpoints", func() {
+		Example(map[string]interface{}{
+			"sku": "002432800",
+		})
+	})
+	Attribute("priority", Int, "priority of this event", func() {
+		Example(1000)
+	})
+	Attribute("createdAt", Int64, "when this event was submitted (unix timestamp seconds)", func() {
+		Example(1646278413)
+	})
+
+	Required("id", "event_type", "event_content", "priority", "createdAt")
+})
+
+var EventDelivery = Type("EventDelivery", func() {
+	Attribute("id", UInt, "identifier of the event delivery", func() {
+		Example(2048)
+	})
+	Attribute("endpoint_id", String, "identifier of the webhook endpoint", func() {
+		Example("zhwe_c9ddsgbei1cst46tglh0")
+	})
+	Attribute("endpoint_url", String, "URL of the webhook endpoint", func() {
+		Example("https://example.com/notifications")
+	})
+	Attribute("status", String, "status of this delivery", func() {
+		Enum("pending", "success", "failed", "cancelled", "held")
+	})
+	Attribute("attempts_counter", UInt, "how many attempts have been made so far", func() {
+		Example(1)
+	})
+	Attribute("attempts_remaining", UInt, "how many attempts are remaining", func() {
+		Example(2)
+	})
+	Attribute("next_attempt_scheduled_at", UInt, "when the next attempt is scheduled (unix timestamp seconds)", func() {
+		Example(1646278473)
+	})
+	Attribute("createdAt", Int64, "when this item was created (unix timestamp seconds)", func() {
+		Example(1646278413)
+	})
+	Attribute("updatedAt", Int64, "when this item was last updated (unix timestamp seconds)", func() {
+		Example(1646278473)
+	})
+
+	Required("id", "endpoint_id", "status", "attempts_counter", "attempts_remaining", "createdAt", "updatedAt")
+})
+
+var EventWithDeliveries = Type("EventWithDeliveries", func() {
+	Attribute("deliveries", ArrayOf(EventDelivery), "deliveries of this event, one for each webhook endpoint subscribed")
+
+	Required("id", "event_type", "event_content", "priority", "createdAt", "deliveries")
+
+	Extend(Event)
+})
+
+var EventDeliveryAttempt = Type("EventDeliveryAttempt", func() {
+	Attribute("id", UInt, "identifier of the attempt", func() {
+		Example(4096)
+	})
+	Attribute("event_delivery_id", UInt, "identifier of the event delivery", func() {
+		Example(2048)
+	})
+	Attribute("event_id", UInt, "identifier of the event", func() {
+		Example(1024)
+	})
+	Attribute("event_type", String, "event type of the event delivered", func() {
+		Example("merchant-93842.order.shipped")
+	})
+	Attribute("status", String, "outcome of this attempt", func() {
+		Enum("pending", "success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal", "cancelled")
+	})
+	Attribute("attempt_made_at", Int64, "when the request was sent (unix timestamp seconds)", func() {
+		Example(1646278414)
+	})
+	Attribute("http_status_code", Int, "http status code of the response (if any)", func() {
+		Example(500)
+	})
+	Attribute("http_body_response", String, "response body returned from the endpoint, truncated (if any)", func() {
+		Example("Internal Server Error")
+	})
+	Attribute("http_response_time_secs", Float32, "response time in seconds", func() {
+		Example(0.35)
+	})
+	Attribute("createdAt", Int64, "when this item was created (unix timestamp seconds)", func() {
+		Example(1646278413)
+	})
+
+	Required("id", "event_delivery_id", "event_id", "event_type", "status", "createdAt")
+})
+
+var EndpointRecovery = Type("EndpointRecovery", func() {
+	Attribute("id", String, "identifier of the recovery", func() {
+		Example("zhrec_c9ddsgbei1cst46tglh0")
+	})
+	Attribute("endpoint_id", String, "identifier of the webhook endpoint", func() {
+		Example("zhwe_c9ddsgbei1cst46tglh0")
+	})
+	Attribute("since", Int64, "event deliveries created since this time are recovered (unix timestamp seconds)", func() {
+		Example(1646278413)
+	})
+	Attribute("status", String, "status of the recovery", func() {
+		Enum("pending", "running", "completed", "failed")
+	})
+	Attribute("deliveries_found", UInt, "how many event deliveries will be delivered again", func() {
+		Example(120)
+	})
+	Attribute("deliveries_enqueued", UInt, "how many event deliveries have been enqueued so far", func() {
+		Example(80)
+	})
+	Attribute("createdAt", Int64, "when this item was created (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+	Attribute("updatedAt", Int64, "when this item was last updated (unix timestamp seconds)", func() {
+		Example(1646369090)
+	})
+
+	Required("id", "endpoint_id", "since", "status", "deliveries_found", "deliveries_enqueued", "createdAt", "updatedAt")
+})
+
+var SubmittedEventResult = Type("SubmittedEventResult", func() {
+	Attribute("index", Int, "position of the event in the submitted events", func() {
+		Example(0)
+	})
+	Attribute("event_id", UInt, "identifier of the event, not present if the event was rejected", func() {
+		Example(1024)
+	})
+	Attribute("event_type", String, "normalized event type", func() {
+		Example("merchant-93842.order.shipped")
+	})
+	Attribute("mapping_status", String, "`pending_mapping` until the event is mapped to the subscribed webhook endpoints", func() {
+		Enum("pending_mapping", "mapped")
+	})
+	Attribute("endpoints_matched", UInt, "how many webhook endpoints are subscribed to this event, present once mapped", func() {
+		Example(3)
+	})
+	Attribute("error_code", String, "reason why the event was rejected", func() {
+		Enum("invalid_event_type", "invalid_event_content")
+	})
+	Attribute("error_message", String, "human readable reason why the event was rejected", func() {
+		Example("event type must be a dot separated list of alphanumeric words")
+	})
+
+	Required("index", "event_type")
+})
+
+var ApiKey = Type("ApiKey", func() {
+	Attribute("id", UInt, "identifier of the api key", func() {
+		Example(3)
+	})
+	Attribute("description", String, "description for internal use", func() {
+		Example("orders service")
+	})
+	Attribute("status", String, "status of the api key, disabled keys are rejected", func() {
+		Enum("enabled", "disabled")
+	})
+	Attribute("scopes", ArrayOf(String), "scopes granted to the api key, `*` grants all the scopes", func() {
+		Example([]string{"events:write"})
+	})
+	Attribute("tenant", String, "tenant owning the api key, endpoints and events are only visible within the same tenant", func() {
+		Example("payments-team")
+	})
+	Attribute("expiresAt", Int64, "when this api key expires (unix timestamp seconds)", func() {
+		Example(1677814413)
+	})
+	Attribute("lastUsedAt", Int64, "when this api key was last used (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+	Attribute("createdAt", Int64, "when this item was created (unix timestamp seconds)", func() {
+		Example(1646278413)
+	})
+	Attribute("updatedAt", Int64, "when this item was last updated (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+
+	Required("id", "description", "status", "scopes", "tenant", "createdAt", "updatedAt")
+})
+
+var EventType = Type("EventType", func() {
+	Attribute("name", String, "event type name, same format of the submitted `event_type`", func() {
+		Example("order.shipped")
+	})
+	Attribute("description", String, "what the event type represents", func() {
+		Example("An order was shipped to the customer")
+	})
+	Attribute("schema", MapOf(String, Any), "JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy", func() {
+		Example(map[string]interface{}{
+			"type":     "object",
+			"required": []string{"sku"},
+			"properties": map[string]interface{}{
+				"sku": map[string]interface{}{"type": "string"},
+			},
+		})
+	})
+	Attribute("example", MapOf(String, Any), "example of `event_content`", func() {
+		Example(map[string]interface{}{
+			"sku": "002432800",
+		})
+	})
+	Attribute("deprecated", Boolean, "deprecated event types are still accepted but should not be used by new producers")
+	Attribute("createdAt", Int64, "when this item was created (unix timestamp seconds)", func() {
+		Example(1646278413)
+	})
+	Attribute("updatedAt", Int64, "when this item was last updated (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+
+	Required("name", "deprecated", "createdAt", "updatedAt")
+})
+
+var EndpointStatusEvent = Type("EndpointStatusEvent", func() {
+	Attribute("id", UInt, "identifier of the status change", func() {
+		Example(64)
+	})
+	Attribute("old_status", String, "status before the change", func() {
+		Enum("enabled", "disabled")
+	})
+	Attribute("new_status", String, "status after the change", func() {
+		Enum("enabled", "disabled")
+	})
+	Attribute("reason", String, "why the status changed", func() {
+		Enum("manual", "recovery", "max_attempts_exceeded", "http_410_gone")
+	})
+	Attribute("actor_api_key_id", UInt, "api key that made the change, not set for automatic changes", func() {
+		Example(3)
+	})
+	Attribute("actor_subject", String, "jwt subject that made the change, not set for automatic changes", func() {
+		Example("user-1234")
+	})
+	Attribute("createdAt", Int64, "when the status changed (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+
+	Required("id", "old_status", "new_status", "reason", "createdAt")
+})
+
+var ResponseTimePercentiles = Type("ResponseTimePercentiles", func() {
+	Attribute("p50", Float64, "median response time in seconds", func() {
+		Example(0.25)
+	})
+	Attribute("p95", Float64, "95th percentile of the response time in seconds", func() {
+		Example(1)
+	})
+	Attribute("p99", Float64, "99th percentile of the response time in seconds", func() {
+		Example(2.5)
+	})
+
+	Required("p50", "p95", "p99")
+})
+
+var EndpointStats = Type("EndpointStats", func() {
+	Attribute("endpoint_id", String, "webhook endpoint identifier", func() {
+		Example("zhwe_c9ddsgbei1cst46tglh0")
+	})
+	Attribute("window", String, "time window of the stats", func() {
+		Enum("1h", "24h", "7d")
+	})
+	Attribute("from", Int64, "start of the window (unix timestamp seconds), aligned to the 5 minutes stats buckets", func() {
+		Example(1646282684)
+	})
+	Attribute("to", Int64, "end of the window (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+	Attribute("attempts", UInt, "delivery attempts made in the window", func() {
+		Example(1200)
+	})
+	Attribute("success_rate", Float64, "share of successful attempts (0-1), not set without attempts", func() {
+		Example(0.98)
+	})
+	Attribute("status_counts", MapOf(String, UInt), "attempts per attempt status", func() {
+		Example(map[string]uint{"success": 1176, "error_response": 20, "error_timeout": 4})
+	})
+	Attribute("http_status_codes", MapOf(String, UInt), "attempts per http response status code", func() {
+		Example(map[string]uint{"200": 1176, "500": 20})
+	})
+	Attribute("response_time_secs", ResponseTimePercentiles, "response time percentiles, estimated from a histogram (linear interpolation within the bucket holding the percentile), not set without responses")
+
+	Required("endpoint_id", "window", "from", "to", "attempts", "status_counts", "http_status_codes")
+})
+
+var DeadLetter = Type("DeadLetter", func() {
+	Attribute("id", UInt, "identifier of the dead letter", func() {
+		Example(128)
+	})
+	Attribute("event_delivery_id", UInt, "identifier of the failed event delivery", func() {
+		Example(2048)
+	})
+	Attribute("event_id", UInt, "identifier of the event", func() {
+		Example(1024)
+	})
+	Attribute("event_type", String, "event type of the event", func() {
+		Example("merchant-93842.order.shipped")
+	})
+	Attribute("endpoint_id", String, "identifier of the webhook endpoint", func() {
+		Example("zhwe_c9ddsgbei1cst46tglh0")
+	})
+	Attribute("reason", String, "why the delivery failed", func() {
+		Enum("max_attempts_exceeded", "http_410_gone")
+	})
+	Attribute("attempts_counter", UInt, "how many attempts have been made", func() {
+		Example(5)
+	})
+	Attribute("last_attempt_status", String, "outcome of the last attempt", func() {
+		Enum("error_timeout", "error_response", "error_network", "error_blocked", "error_internal")
+	})
+	Attribute("last_http_status_code", Int, "http status code of the last response (if any)", func() {
+		Example(500)
+	})
+	Attribute("last_attempt_made_at", Int64, "when the last attempt was made (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+	Attribute("status", String, "dead until the event is delivered again", func() {
+		Enum("dead", "replayed")
+	})
+	Attribute("replay_event_delivery_id", UInt, "event delivery created by the replay", func() {
+		Example(4096)
+	})
+	Attribute("replayed_at", Int64, "when the event was delivered again (unix timestamp seconds)", func() {
+		Example(1646372684)
+	})
+	Attribute("createdAt", Int64, "when the delivery failed (unix timestamp seconds)", func() {
+		Example(1646369084)
+	})
+
+	Required("id", "event_delivery_id", "event_id", "event_type", "endpoint_id", "reason", "attempts_counter", "last_attempt_status", "status", "createdAt")
+})
+
+var DeadLetterDetails = Type("DeadLetterDetails", func() {
+	Attribute("last_http_body_response", String, "body of the last response (if any)", func() {
+		Example("Internal Server Error")
+	})
+	Attribute("event", Event, "event that was not delivered")
+
+	Required("id", "event_delivery_id", "event_id", "event_type", "endpoint_id", "reason", "attempts_counter", "last_attempt_status", "status", "createdAt", "event")
+
+	Extend(DeadLetter)
+})
+
 // Service describes a service
 var _ = Service("Zebrahook", func() {
 	Description("Exposes API for Zebrahook")
@@ -168,6 +592,12 @@ var _ = Service("Zebrahook", func() {
 
 		Payload(func() {
 			Attribute("description", String, "description for internal use")
+			Attribute("scopes", ArrayOf(String), "scopes granted to the api key, `*` grants all the scopes", func() {
+				Example([]string{"events:write"})
+			})
+			Attribute("tenant", String, "tenant owning the api key, empty for the default tenant", func() {
+				Example("payments-team")
+			})
 		})
 
 		Result(func() {
@@ -182,6 +612,10 @@ var _ = Service("Zebrahook", func() {
 	Method("submitNewEvents", func() {
 		Description("Submit new events, all events will be asynchronously dispatched to all endpoints that are subscribed to the provided event type (`enabled_events`)")
 
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:write")
+		})
+
 		Payload(func() {
 			Token("token", String)
 
@@ -215,16 +649,27 @@ var _ = Service("Zebrahook", func() {
 				})
 			})
 
+			Attribute("idempotency_key", String, "Optional idempotency key for the whole request, requests with an already used key return the events created the first time", func() {
+				MinLength(1)
+				MaxLength(255)
+				Example("a5e1d1c2-8d5b-4b0e-9f3a-1f0c2d3e4f5a")
+			})
+
 			Required("token", "events")
 		})
 
 		Result(func() {
-			Attribute("success", Boolean, func() {
+			Attribute("success", Boolean, "true if all the submitted events have been accepted", func() {
 				Example(true)
 			})
+			Attribute("results", ArrayOf(SubmittedEventResult), "result for each submitted event, in the same order of the submitted events")
+
+			Required("success", "results")
 		})
 
 		HTTP(func() {
+			Header("idempotency_key:Idempotency-Key")
+
 			// Requests to the service consist of HTTP GET requests
 			// The payload fields are encoded as path parameters
 			POST("/events")
@@ -237,6 +682,11 @@ var _ = Service("Zebrahook", func() {
 	Method("register", func() {
 
 		Description("Allows to register a new webhook URL with the specified enabled events")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:write")
+		})
+
 		// Payload describes the method payload
 		// Here the payload is an object that consists of two fields
 		Payload(func() {
@@ -263,6 +713,11 @@ var _ = Service("Zebrahook", func() {
 	Method("update", func() {
 
 		Description("Allows to update a webhook created before")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:write")
+		})
+
 		// Payload describes the method payload
 		Payload(func() {
 			Token("token", String)
@@ -289,6 +744,11 @@ var _ = Service("Zebrahook", func() {
 
 	Method("listWebhookEndpoint", func() {
 		Description("Allows to list and query registered webhook")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:read")
+		})
+
 		// Payload describes the method payload
 		// Here the payload is an object that consists of two fields
 		Payload(func() {
@@ -350,6 +810,12 @@ var _ = Service("Zebrahook", func() {
 
 	Method("getWebhookEndpointById", func() {
 		Description("Allows to get info about a registered webhook URL via the identifier")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:read")
+			Scope("secrets:read")
+		})
+
 		// Payload describes the method payload
 		// Here the payload is an object that consists of two fields
 		Payload(func() {
@@ -375,4 +841,1072 @@ var _ = Service("Zebrahook", func() {
 			Response(StatusOK)
 		})
 	})
+
+	Method("deleteWebhookEndpoint", func() {
+		Description("Allows to delete a registered webhook endpoint, pending and held deliveries for this endpoint are cancelled")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("success", Boolean)
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			DELETE("/endpoints/{id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("listEvents", func() {
+		Description("Allows to list and query submitted events, most recent first")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("limit", Int32, "limit how many results to return", func() {
+				Default(50)
+				Example(50)
+				Minimum(1)
+				Maximum(500)
+			})
+			Attribute("starting_after", UInt, "cursor for pagination, return events created before the provided event identifier", func() {
+				Example(1024)
+			})
+
+			Attribute("event_type", String, "filter by event type", func() {
+				Example("merchant-93842.order.shipped")
+			})
+			Attribute("priority", Int, "filter by priority", func() {
+				Example(1000)
+			})
+			Attribute("createdAt.gte", UInt64, "filter by createdAt unix (greater than or equal)", func() {
+				Example(1646278413)
+				Minimum(0)
+			})
+			Attribute("createdAt.lt", UInt64, "filter by createdAt unix (less than)", func() {
+				Example(1646369084)
+				Minimum(0)
+			})
+
+			Required("token")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(Event))
+			Attribute("has_more", Boolean, "true if there are more events after this page, use the last identifier as `starting_after`")
+
+			Required("result", "has_more")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("limit")
+			Param("starting_after")
+
+			Param("event_type")
+			Param("priority")
+			Param("createdAt.gte")
+			Param("createdAt.lt")
+
+			GET("/events")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getEventById", func() {
+		Description("Allows to get a submitted event with the delivery status for each webhook endpoint")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "event identifier", func() {
+				Example(1024)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(EventWithDeliveries)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			GET("/events/{id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("listWebhookEndpointAttempts", func() {
+		Description("Allows to list the delivery attempts made to a webhook endpoint, most recent first")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+
+			Attribute("limit", Int32, "limit how many results to return", func() {
+				Default(50)
+				Example(50)
+				Minimum(1)
+				Maximum(500)
+			})
+			Attribute("starting_after", UInt, "cursor for pagination, return attempts created before the provided attempt identifier", func() {
+				Example(4096)
+			})
+
+			Attribute("status", String, "filter by attempt status", func() {
+				Enum("pending", "success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal", "cancelled")
+			})
+			Attribute("event_type", String, "filter by event type", func() {
+				Example("merchant-93842.order.shipped")
+			})
+			Attribute("createdAt.gte", UInt64, "filter by createdAt unix (greater than or equal)", func() {
+				Example(1646278413)
+				Minimum(0)
+			})
+			Attribute("createdAt.lt", UInt64, "filter by createdAt unix (less than)", func() {
+				Example(1646369084)
+				Minimum(0)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(EventDeliveryAttempt))
+			Attribute("has_more", Boolean, "true if there are more attempts after this page, use the last identifier as `starting_after`")
+
+			Required("result", "has_more")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("limit")
+			Param("starting_after")
+
+			Param("status")
+			Param("event_type")
+			Param("createdAt.gte")
+			Param("createdAt.lt")
+
+			GET("/endpoints/{id}/attempts")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("retryEventDelivery", func() {
+		Description("Allows to deliver again an event to a webhook endpoint, a new delivery is created even if the previous one has no more attempts remaining or the webhook endpoint is disabled")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "event identifier", func() {
+				Example(1024)
+			})
+			Attribute("endpoint_id", String, "identifier of the webhook endpoint that received the event", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+
+			Required("token", "id", "endpoint_id")
+		})
+
+		// Result describes the method result
+		Result(EventDelivery)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/events/{id}/deliveries/{endpoint_id}/retry")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("recoverWebhookEndpoint", func() {
+		Description("Allows to deliver again all the events not successfully delivered to a webhook endpoint since the provided time, the webhook endpoint is enabled if disabled. Recovery is asynchronous, use the returned identifier to check the progress")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("since", Int64, "recover event deliveries created since this time (unix timestamp seconds)", func() {
+				Example(1646278413)
+				Minimum(0)
+			})
+
+			Required("token", "id", "since")
+		})
+
+		// Result describes the method result
+		Result(EndpointRecovery)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/endpoints/{id}/recover")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getWebhookEndpointRecovery", func() {
+		Description("Allows to get the progress of a webhook endpoint recovery")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("recovery_id", String, "recovery identifier", func() {
+				Example("zhrec_c9ddsgbei1cst46tglh0")
+			})
+
+			Required("token", "id", "recovery_id")
+		})
+
+		// Result describes the method result
+		Result(EndpointRecovery)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			GET("/endpoints/{id}/recover/{recovery_id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("rotateWebhookEndpointSecret", func() {
+		Description("Allows to generate a new secret for a webhook endpoint, the previous secret stays valid for a grace period during which events are signed with both secrets. Only one previous secret is kept: the secret can't be rotated again until the grace period ends")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:write")
+			Scope("secrets:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("grace_period_secs", UInt, "how many seconds the previous secret stays valid, if not provided the configured value is used", func() {
+				Example(86400)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("previous_secret_expires_at", Int64, "until when the previous secret is used to sign events (unix timestamp seconds)", func() {
+				Example(1646364813)
+			})
+
+			Required("id", "secret", "previous_secret_expires_at")
+
+			Extend(WebhookIdAndSecret)
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/endpoints/{id}/rotate-secret")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("testWebhookEndpoint", func() {
+		Description("Allows to send a test event to a webhook endpoint, the event is signed and delivered synchronously and the outcome is returned. Test events are not stored and never disable the webhook endpoint")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("event_type", String, "event type set in the sample content (`zebrahook.test` by default), can't be used with `event_content` as deliveries send only the event content", func() {
+				Example("merchant-93842.order.shipped")
+			})
+			Attribute("event_content", MapOf(String, Any), "content of the test event, sent as is like the content of a delivery, if not provided a sample content is sent", func() {
+				Example(map[string]interface{}{
+					"sku": "002432800",
+				})
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("status", String, "outcome of the request", func() {
+				Enum("success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal")
+			})
+			Attribute("http_status_code", Int, "http status code of the response (if any)", func() {
+				Example(200)
+			})
+			Attribute("http_body_response", String, "response body returned from the endpoint, truncated (if any)", func() {
+				Example("ok")
+			})
+			Attribute("http_response_time_secs", Float64, "response time in seconds", func() {
+				Example(0.35)
+			})
+
+			Required("status", "http_response_time_secs")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/endpoints/{id}/test")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("listApiKeys", func() {
+		Description("Allows to list the api keys")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("admin")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("status", String, "filter by status", func() {
+				Enum("enabled", "disabled")
+			})
+
+			Required("token")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(ApiKey))
+
+			Required("result")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("status")
+
+			GET("/api-keys")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getApiKey", func() {
+		Description("Allows to describe an api key")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("admin")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "api key identifier", func() {
+				Example(3)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(ApiKey)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			GET("/api-keys/{id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("updateApiKey", func() {
+		Description("Allows to update the description and the expiration of an api key")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("admin")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "api key identifier", func() {
+				Example(3)
+			})
+			Attribute("description", String, "description for internal use", func() {
+				MinLength(1)
+				Example("orders service")
+			})
+			Attribute("expiresAt", Int64, "when this api key expires (unix timestamp seconds), use 0 to remove the expiration", func() {
+				Minimum(0)
+				Example(1677814413)
+			})
+			Attribute("scopes", ArrayOf(String, func() {
+				Enum("*", "events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin")
+			}), "scopes granted to the api key, `*` grants all the scopes", func() {
+				MinLength(1)
+				Example([]string{"events:write"})
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(ApiKey)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			PUT("/api-keys/{id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("revokeApiKey", func() {
+		Description("Allows to revoke an api key, revoked api keys can't be used anymore")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("admin")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "api key identifier", func() {
+				Example(3)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("success", Boolean)
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/api-keys/{id}/revoke")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("registerEventType", func() {
+		Description("Allows to declare a new event type, the content of the submitted events of this type is validated against its JSON Schema")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("admin")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("name", String, "event type name, same format of the submitted `event_type`", func() {
+				MinLength(1)
+				Example("order.shipped")
+			})
+			Attribute("description", String, "what the event type represents", func() {
+				Example("An order was shipped to the customer")
+			})
+			Attribute("schema", MapOf(String, Any), "JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy, external references are not allowed")
+			Attribute("example", MapOf(String, Any), "example of `event_content`, must satisfy the schema")
+			Attribute("deprecated", Boolean, "deprecated event types are still accepted but should not be used by new producers", func() {
+				Default(false)
+			})
+
+			Required("token", "name")
+		})
+
+		// Result describes the method result
+		Result(EventType)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/event-types")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("listEventTypes", func() {
+		Description("Allows to list the registered event types")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("deprecated", Boolean, "filter by deprecated flag")
+
+			Required("token")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(EventType))
+
+			Required("result")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("deprecated")
+
+			GET("/event-types")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getEventType", func() {
+		Description("Allows to retrieve a registered event type")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("name", String, "event type name", func() {
+				Example("order.shipped")
+			})
+
+			Required("token", "name")
+		})
+
+		// Result describes the method result
+		Result(EventType)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			GET("/event-types/{name}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("updateEventType", func() {
+		Description("Allows to update the description, the JSON Schema, the example or the deprecated flag of an event type")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("admin")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("name", String, "event type name", func() {
+				Example("order.shipped")
+			})
+			Attribute("description", String, "what the event type represents", func() {
+				Example("An order was shipped to the customer")
+			})
+			Attribute("schema", MapOf(String, Any), "JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy, external references are not allowed")
+			Attribute("example", MapOf(String, Any), "example of `event_content`, must satisfy the schema")
+			Attribute("deprecated", Boolean, "deprecated event types are still accepted but should not be used by new producers")
+
+			Required("token", "name")
+		})
+
+		// Result describes the method result
+		Result(EventType)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			PUT("/event-types/{name}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getEventTypesCatalog", func() {
+		Description("Allows to export the catalog of the registered event types as an AsyncAPI 2.x document, a Markdown or an HTML page, describing the webhook payloads, the signature header and the retry policy")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("format", String, "catalog format, `asyncapi` is an AsyncAPI 2.6 json document", func() {
+				Enum("asyncapi", "markdown", "html")
+				Default("asyncapi")
+			})
+
+			Required("token")
+		})
+
+		// Result describes the method result, the catalog is the response body
+		Result(func() {
+			Attribute("contentType", String, "content type of the catalog")
+
+			Required("contentType")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("format")
+
+			GET("/event-types/catalog")
+
+			// catalog is written as it is
+			SkipResponseBodyEncodeDecode()
+
+			// Responses use a "200 OK" HTTP status
+			Response(StatusOK, func() {
+				Header("contentType:Content-Type")
+			})
+		})
+	})
+
+	Method("getWebhookEndpointStatusHistory", func() {
+		Description("Allows to list the status changes of a webhook endpoint with their reason, most recent first")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+
+			Attribute("limit", Int32, "limit how many results to return", func() {
+				Default(50)
+				Example(50)
+				Minimum(1)
+				Maximum(500)
+			})
+			Attribute("starting_after", UInt, "cursor for pagination, return status changes made before the provided identifier", func() {
+				Example(64)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(EndpointStatusEvent))
+			Attribute("has_more", Boolean, "true if there are more status changes after this page, use the last identifier as `starting_after`")
+
+			Required("result", "has_more")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("limit")
+			Param("starting_after")
+
+			GET("/endpoints/{id}/status-history")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getWebhookEndpointStats", func() {
+		Description("Allows to retrieve the delivery stats of a webhook endpoint over a time window, stats are updated periodically and can lag behind the latest attempts by up to `stats.rollupIntervalSecs` seconds")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("endpoints:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", String, "webhook identifier returned in creation", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+
+			Attribute("window", String, "time window of the stats", func() {
+				Enum("1h", "24h", "7d")
+				Default("24h")
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(EndpointStats)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("window")
+
+			GET("/endpoints/{id}/stats")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("listDeadLetters", func() {
+		Description("Allows to list the event deliveries that failed without more attempts (dead letters), most recent first")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("limit", Int32, "limit how many results to return", func() {
+				Default(50)
+				Example(50)
+				Minimum(1)
+				Maximum(500)
+			})
+			Attribute("starting_after", UInt, "cursor for pagination, return dead letters created before the provided identifier", func() {
+				Example(128)
+			})
+
+			Attribute("status", String, "filter by status", func() {
+				Enum("dead", "replayed")
+				Default("dead")
+			})
+			Attribute("endpoint_id", String, "filter by webhook endpoint", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("event_type", String, "filter by event type", func() {
+				Example("merchant-93842.order.shipped")
+			})
+			Attribute("createdAt.gte", UInt64, "filter by createdAt unix (greater than or equal)", func() {
+				Example(1646278413)
+				Minimum(0)
+			})
+			Attribute("createdAt.lt", UInt64, "filter by createdAt unix (less than)", func() {
+				Example(1646369084)
+				Minimum(0)
+			})
+
+			Required("token")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(DeadLetter))
+			Attribute("has_more", Boolean, "true if there are more dead letters after this page, use the last identifier as `starting_after`")
+
+			Required("result", "has_more")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			// query params
+			Param("limit")
+			Param("starting_after")
+
+			Param("status")
+			Param("endpoint_id")
+			Param("event_type")
+			Param("createdAt.gte")
+			Param("createdAt.lt")
+
+			GET("/dead-letters")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("getDeadLetter", func() {
+		Description("Allows to inspect a dead letter with its event and the last response of the webhook endpoint")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:read")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "dead letter identifier", func() {
+				Example(128)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(DeadLetterDetails)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			GET("/dead-letters/{id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("replayDeadLetter", func() {
+		Description("Allows to deliver again the event of a dead letter to its webhook endpoint, a new delivery is created even if the webhook endpoint is disabled")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "dead letter identifier", func() {
+				Example(128)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(DeadLetter)
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/dead-letters/{id}/replay")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("replayDeadLetters", func() {
+		Description("Allows to deliver again the events of the dead letters matching the filters, oldest first. Dead letters of deleted webhook endpoints are skipped, call again while `has_more` is true")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("ids", ArrayOf(UInt), "replay only these dead letters", func() {
+				Example([]uint{128, 129})
+				MaxLength(500)
+			})
+			Attribute("endpoint_id", String, "replay only the dead letters of this webhook endpoint", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("event_type", String, "replay only the dead letters of this event type", func() {
+				Example("merchant-93842.order.shipped")
+			})
+			Attribute("createdAt.gte", UInt64, "replay only the dead letters created after (greater than or equal)", func() {
+				Example(1646278413)
+				Minimum(0)
+			})
+			Attribute("createdAt.lt", UInt64, "replay only the dead letters created before (less than)", func() {
+				Example(1646369084)
+				Minimum(0)
+			})
+			Attribute("limit", Int32, "limit how many dead letters to replay", func() {
+				Default(100)
+				Example(100)
+				Minimum(1)
+				Maximum(500)
+			})
+
+			Required("token")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("result", ArrayOf(DeadLetter), "replayed dead letters")
+			Attribute("has_more", Boolean, "true if there are more dead letters to replay matching the filters")
+
+			Required("result", "has_more")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/dead-letters/replay")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("deleteDeadLetter", func() {
+		Description("Allows to permanently remove a dead letter, the event and its deliveries are kept")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("id", UInt, "dead letter identifier", func() {
+				Example(128)
+			})
+
+			Required("token", "id")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("success", Boolean)
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			DELETE("/dead-letters/{id}")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
+
+	Method("purgeDeadLetters", func() {
+		Description("Allows to permanently remove the dead letters matching the filters, the events and their deliveries are kept")
+
+		Security(ApiKeyOrJWTviaToken, func() {
+			Scope("events:write")
+		})
+
+		// Payload describes the method payload
+		Payload(func() {
+			Token("token", String)
+
+			Attribute("status", String, "purge only the dead letters with this status", func() {
+				Enum("dead", "replayed")
+			})
+			Attribute("endpoint_id", String, "purge only the dead letters of this webhook endpoint", func() {
+				Example("zhwe_c9ddsgbei1cst46tglh0")
+			})
+			Attribute("event_type", String, "purge only the dead letters of this event type", func() {
+				Example("merchant-93842.order.shipped")
+			})
+			Attribute("createdAt.lt", UInt64, "purge only the dead letters created before (less than)", func() {
+				Example(1646369084)
+				Minimum(0)
+			})
+
+			Required("token")
+		})
+
+		// Result describes the method result
+		Result(func() {
+			Attribute("purged", Int64, "how many dead letters have been removed", func() {
+				Example(42)
+			})
+
+			Required("purged")
+		})
+
+		// HTTP describes the HTTP transport mapping
+		HTTP(func() {
+			POST("/dead-letters/purge")
+			// Responses use a "200 OK" HTTP status
+			// The result is encoded in the response body
+			Response(StatusOK)
+		})
+	})
 })
@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP client CLI support package
 //
@@ -20,10 +20,9 @@ import (
 
 // UsageCommands returns the set of commands and sub-commands using the format
 //
-//    command (subcommand1|subcommand2|...)
-//
+//	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `zebrahook (submit-new-events|register|update|list-webhook-endpoint|get-webhook-endpoint-by-id)
+	return `zebrahook (submit-new-events|register|update|list-webhook-endpoint|get-webhook-endpoint-by-id|delete-webhook-endpoint|list-events|get-event-by-id|list-webhook-endpoint-attempts|retry-event-delivery|recover-webhook-endpoint|get-webhook-endpoint-recovery|rotate-webhook-endpoint-secret|test-webhook-endpoint|list-api-keys|get-api-key|update-api-key|revoke-api-key|register-event-type|list-event-types|get-event-type|update-event-type|get-event-types-catalog|get-webhook-endpoint-status-history|get-webhook-endpoint-stats|list-dead-letters|get-dead-letter|replay-dead-letter|replay-dead-letters|delete-dead-letter|purge-dead-letters)
 `
 }
 
@@ -57,7 +56,7 @@ func UsageExamples() string {
             "event_type": "merchant-93842.order.shipped"
          }
       ]
-   }' --token "Veniam fugit consequuntur enim dignissimos sit."` + "\n" +
+   }' --idempotency-key "a5e1d1c2-8d5b-4b0e-9f3a-1f0c2d3e4f5a" --token "Dolores et."` + "\n" +
 		""
 }
 
@@ -73,9 +72,10 @@ func ParseEndpoint(
 	var (
 		zebrahookFlags = flag.NewFlagSet("zebrahook", flag.ContinueOnError)
 
-		zebrahookSubmitNewEventsFlags     = flag.NewFlagSet("submit-new-events", flag.ExitOnError)
-		zebrahookSubmitNewEventsBodyFlag  = zebrahookSubmitNewEventsFlags.String("body", "REQUIRED", "")
-		zebrahookSubmitNewEventsTokenFlag = zebrahookSubmitNewEventsFlags.String("token", "REQUIRED", "")
+		zebrahookSubmitNewEventsFlags              = flag.NewFlagSet("submit-new-events", flag.ExitOnError)
+		zebrahookSubmitNewEventsBodyFlag           = zebrahookSubmitNewEventsFlags.String("body", "REQUIRED", "")
+		zebrahookSubmitNewEventsIdempotencyKeyFlag = zebrahookSubmitNewEventsFlags.String("idempotency-key", "", "")
+		zebrahookSubmitNewEventsTokenFlag          = zebrahookSubmitNewEventsFlags.String("token", "REQUIRED", "")
 
 		zebrahookRegisterFlags     = flag.NewFlagSet("register", flag.ExitOnError)
 		zebrahookRegisterBodyFlag  = zebrahookRegisterFlags.String("body", "REQUIRED", "")
@@ -97,6 +97,137 @@ func ParseEndpoint(
 		zebrahookGetWebhookEndpointByIDFlags     = flag.NewFlagSet("get-webhook-endpoint-by-id", flag.ExitOnError)
 		zebrahookGetWebhookEndpointByIDIDFlag    = zebrahookGetWebhookEndpointByIDFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
 		zebrahookGetWebhookEndpointByIDTokenFlag = zebrahookGetWebhookEndpointByIDFlags.String("token", "REQUIRED", "")
+
+		zebrahookDeleteWebhookEndpointFlags     = flag.NewFlagSet("delete-webhook-endpoint", flag.ExitOnError)
+		zebrahookDeleteWebhookEndpointIDFlag    = zebrahookDeleteWebhookEndpointFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookDeleteWebhookEndpointTokenFlag = zebrahookDeleteWebhookEndpointFlags.String("token", "REQUIRED", "")
+
+		zebrahookListEventsFlags             = flag.NewFlagSet("list-events", flag.ExitOnError)
+		zebrahookListEventsLimitFlag         = zebrahookListEventsFlags.String("limit", "50", "")
+		zebrahookListEventsStartingAfterFlag = zebrahookListEventsFlags.String("starting-after", "", "")
+		zebrahookListEventsEventTypeFlag     = zebrahookListEventsFlags.String("event-type", "", "")
+		zebrahookListEventsPriorityFlag      = zebrahookListEventsFlags.String("priority", "", "")
+		zebrahookListEventsCreatedAtGteFlag  = zebrahookListEventsFlags.String("created-at-gte", "", "")
+		zebrahookListEventsCreatedAtLtFlag   = zebrahookListEventsFlags.String("created-at-lt", "", "")
+		zebrahookListEventsTokenFlag         = zebrahookListEventsFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetEventByIDFlags     = flag.NewFlagSet("get-event-by-id", flag.ExitOnError)
+		zebrahookGetEventByIDIDFlag    = zebrahookGetEventByIDFlags.String("id", "REQUIRED", "event identifier")
+		zebrahookGetEventByIDTokenFlag = zebrahookGetEventByIDFlags.String("token", "REQUIRED", "")
+
+		zebrahookListWebhookEndpointAttemptsFlags             = flag.NewFlagSet("list-webhook-endpoint-attempts", flag.ExitOnError)
+		zebrahookListWebhookEndpointAttemptsIDFlag            = zebrahookListWebhookEndpointAttemptsFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookListWebhookEndpointAttemptsLimitFlag         = zebrahookListWebhookEndpointAttemptsFlags.String("limit", "50", "")
+		zebrahookListWebhookEndpointAttemptsStartingAfterFlag = zebrahookListWebhookEndpointAttemptsFlags.String("starting-after", "", "")
+		zebrahookListWebhookEndpointAttemptsStatusFlag        = zebrahookListWebhookEndpointAttemptsFlags.String("status", "", "")
+		zebrahookListWebhookEndpointAttemptsEventTypeFlag     = zebrahookListWebhookEndpointAttemptsFlags.String("event-type", "", "")
+		zebrahookListWebhookEndpointAttemptsCreatedAtGteFlag  = zebrahookListWebhookEndpointAttemptsFlags.String("created-at-gte", "", "")
+		zebrahookListWebhookEndpointAttemptsCreatedAtLtFlag   = zebrahookListWebhookEndpointAttemptsFlags.String("created-at-lt", "", "")
+		zebrahookListWebhookEndpointAttemptsTokenFlag         = zebrahookListWebhookEndpointAttemptsFlags.String("token", "REQUIRED", "")
+
+		zebrahookRetryEventDeliveryFlags          = flag.NewFlagSet("retry-event-delivery", flag.ExitOnError)
+		zebrahookRetryEventDeliveryIDFlag         = zebrahookRetryEventDeliveryFlags.String("id", "REQUIRED", "event identifier")
+		zebrahookRetryEventDeliveryEndpointIDFlag = zebrahookRetryEventDeliveryFlags.String("endpoint-id", "REQUIRED", "identifier of the webhook endpoint that received the event")
+		zebrahookRetryEventDeliveryTokenFlag      = zebrahookRetryEventDeliveryFlags.String("token", "REQUIRED", "")
+
+		zebrahookRecoverWebhookEndpointFlags     = flag.NewFlagSet("recover-webhook-endpoint", flag.ExitOnError)
+		zebrahookRecoverWebhookEndpointBodyFlag  = zebrahookRecoverWebhookEndpointFlags.String("body", "REQUIRED", "")
+		zebrahookRecoverWebhookEndpointIDFlag    = zebrahookRecoverWebhookEndpointFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookRecoverWebhookEndpointTokenFlag = zebrahookRecoverWebhookEndpointFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetWebhookEndpointRecoveryFlags          = flag.NewFlagSet("get-webhook-endpoint-recovery", flag.ExitOnError)
+		zebrahookGetWebhookEndpointRecoveryIDFlag         = zebrahookGetWebhookEndpointRecoveryFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookGetWebhookEndpointRecoveryRecoveryIDFlag = zebrahookGetWebhookEndpointRecoveryFlags.String("recovery-id", "REQUIRED", "recovery identifier")
+		zebrahookGetWebhookEndpointRecoveryTokenFlag      = zebrahookGetWebhookEndpointRecoveryFlags.String("token", "REQUIRED", "")
+
+		zebrahookRotateWebhookEndpointSecretFlags     = flag.NewFlagSet("rotate-webhook-endpoint-secret", flag.ExitOnError)
+		zebrahookRotateWebhookEndpointSecretBodyFlag  = zebrahookRotateWebhookEndpointSecretFlags.String("body", "REQUIRED", "")
+		zebrahookRotateWebhookEndpointSecretIDFlag    = zebrahookRotateWebhookEndpointSecretFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookRotateWebhookEndpointSecretTokenFlag = zebrahookRotateWebhookEndpointSecretFlags.String("token", "REQUIRED", "")
+
+		zebrahookTestWebhookEndpointFlags     = flag.NewFlagSet("test-webhook-endpoint", flag.ExitOnError)
+		zebrahookTestWebhookEndpointBodyFlag  = zebrahookTestWebhookEndpointFlags.String("body", "REQUIRED", "")
+		zebrahookTestWebhookEndpointIDFlag    = zebrahookTestWebhookEndpointFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookTestWebhookEndpointTokenFlag = zebrahookTestWebhookEndpointFlags.String("token", "REQUIRED", "")
+
+		zebrahookListAPIKeysFlags      = flag.NewFlagSet("list-api-keys", flag.ExitOnError)
+		zebrahookListAPIKeysStatusFlag = zebrahookListAPIKeysFlags.String("status", "", "")
+		zebrahookListAPIKeysTokenFlag  = zebrahookListAPIKeysFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetAPIKeyFlags     = flag.NewFlagSet("get-api-key", flag.ExitOnError)
+		zebrahookGetAPIKeyIDFlag    = zebrahookGetAPIKeyFlags.String("id", "REQUIRED", "api key identifier")
+		zebrahookGetAPIKeyTokenFlag = zebrahookGetAPIKeyFlags.String("token", "REQUIRED", "")
+
+		zebrahookUpdateAPIKeyFlags     = flag.NewFlagSet("update-api-key", flag.ExitOnError)
+		zebrahookUpdateAPIKeyBodyFlag  = zebrahookUpdateAPIKeyFlags.String("body", "REQUIRED", "")
+		zebrahookUpdateAPIKeyIDFlag    = zebrahookUpdateAPIKeyFlags.String("id", "REQUIRED", "api key identifier")
+		zebrahookUpdateAPIKeyTokenFlag = zebrahookUpdateAPIKeyFlags.String("token", "REQUIRED", "")
+
+		zebrahookRevokeAPIKeyFlags     = flag.NewFlagSet("revoke-api-key", flag.ExitOnError)
+		zebrahookRevokeAPIKeyIDFlag    = zebrahookRevokeAPIKeyFlags.String("id", "REQUIRED", "api key identifier")
+		zebrahookRevokeAPIKeyTokenFlag = zebrahookRevokeAPIKeyFlags.String("token", "REQUIRED", "")
+
+		zebrahookRegisterEventTypeFlags     = flag.NewFlagSet("register-event-type", flag.ExitOnError)
+		zebrahookRegisterEventTypeBodyFlag  = zebrahookRegisterEventTypeFlags.String("body", "REQUIRED", "")
+		zebrahookRegisterEventTypeTokenFlag = zebrahookRegisterEventTypeFlags.String("token", "REQUIRED", "")
+
+		zebrahookListEventTypesFlags          = flag.NewFlagSet("list-event-types", flag.ExitOnError)
+		zebrahookListEventTypesDeprecatedFlag = zebrahookListEventTypesFlags.String("deprecated", "", "")
+		zebrahookListEventTypesTokenFlag      = zebrahookListEventTypesFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetEventTypeFlags     = flag.NewFlagSet("get-event-type", flag.ExitOnError)
+		zebrahookGetEventTypeNameFlag  = zebrahookGetEventTypeFlags.String("name", "REQUIRED", "event type name")
+		zebrahookGetEventTypeTokenFlag = zebrahookGetEventTypeFlags.String("token", "REQUIRED", "")
+
+		zebrahookUpdateEventTypeFlags     = flag.NewFlagSet("update-event-type", flag.ExitOnError)
+		zebrahookUpdateEventTypeBodyFlag  = zebrahookUpdateEventTypeFlags.String("body", "REQUIRED", "")
+		zebrahookUpdateEventTypeNameFlag  = zebrahookUpdateEventTypeFlags.String("name", "REQUIRED", "event type name")
+		zebrahookUpdateEventTypeTokenFlag = zebrahookUpdateEventTypeFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetEventTypesCatalogFlags      = flag.NewFlagSet("get-event-types-catalog", flag.ExitOnError)
+		zebrahookGetEventTypesCatalogFormatFlag = zebrahookGetEventTypesCatalogFlags.String("format", "asyncapi", "")
+		zebrahookGetEventTypesCatalogTokenFlag  = zebrahookGetEventTypesCatalogFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetWebhookEndpointStatusHistoryFlags             = flag.NewFlagSet("get-webhook-endpoint-status-history", flag.ExitOnError)
+		zebrahookGetWebhookEndpointStatusHistoryIDFlag            = zebrahookGetWebhookEndpointStatusHistoryFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookGetWebhookEndpointStatusHistoryLimitFlag         = zebrahookGetWebhookEndpointStatusHistoryFlags.String("limit", "50", "")
+		zebrahookGetWebhookEndpointStatusHistoryStartingAfterFlag = zebrahookGetWebhookEndpointStatusHistoryFlags.String("starting-after", "", "")
+		zebrahookGetWebhookEndpointStatusHistoryTokenFlag         = zebrahookGetWebhookEndpointStatusHistoryFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetWebhookEndpointStatsFlags      = flag.NewFlagSet("get-webhook-endpoint-stats", flag.ExitOnError)
+		zebrahookGetWebhookEndpointStatsIDFlag     = zebrahookGetWebhookEndpointStatsFlags.String("id", "REQUIRED", "webhook identifier returned in creation")
+		zebrahookGetWebhookEndpointStatsWindowFlag = zebrahookGetWebhookEndpointStatsFlags.String("window", "24h", "")
+		zebrahookGetWebhookEndpointStatsTokenFlag  = zebrahookGetWebhookEndpointStatsFlags.String("token", "REQUIRED", "")
+
+		zebrahookListDeadLettersFlags             = flag.NewFlagSet("list-dead-letters", flag.ExitOnError)
+		zebrahookListDeadLettersLimitFlag         = zebrahookListDeadLettersFlags.String("limit", "50", "")
+		zebrahookListDeadLettersStartingAfterFlag = zebrahookListDeadLettersFlags.String("starting-after", "", "")
+		zebrahookListDeadLettersStatusFlag        = zebrahookListDeadLettersFlags.String("status", "dead", "")
+		zebrahookListDeadLettersEndpointIDFlag    = zebrahookListDeadLettersFlags.String("endpoint-id", "", "")
+		zebrahookListDeadLettersEventTypeFlag     = zebrahookListDeadLettersFlags.String("event-type", "", "")
+		zebrahookListDeadLettersCreatedAtGteFlag  = zebrahookListDeadLettersFlags.String("created-at-gte", "", "")
+		zebrahookListDeadLettersCreatedAtLtFlag   = zebrahookListDeadLettersFlags.String("created-at-lt", "", "")
+		zebrahookListDeadLettersTokenFlag         = zebrahookListDeadLettersFlags.String("token", "REQUIRED", "")
+
+		zebrahookGetDeadLetterFlags     = flag.NewFlagSet("get-dead-letter", flag.ExitOnError)
+		zebrahookGetDeadLetterIDFlag    = zebrahookGetDeadLetterFlags.String("id", "REQUIRED", "dead letter identifier")
+		zebrahookGetDeadLetterTokenFlag = zebrahookGetDeadLetterFlags.String("token", "REQUIRED", "")
+
+		zebrahookReplayDeadLetterFlags     = flag.NewFlagSet("replay-dead-letter", flag.ExitOnError)
+		zebrahookReplayDeadLetterIDFlag    = zebrahookReplayDeadLetterFlags.String("id", "REQUIRED", "dead letter identifier")
+		zebrahookReplayDeadLetterTokenFlag = zebrahookReplayDeadLetterFlags.String("token", "REQUIRED", "")
+
+		zebrahookReplayDeadLettersFlags     = flag.NewFlagSet("replay-dead-letters", flag.ExitOnError)
+		zebrahookReplayDeadLettersBodyFlag  = zebrahookReplayDeadLettersFlags.String("body", "REQUIRED", "")
+		zebrahookReplayDeadLettersTokenFlag = zebrahookReplayDeadLettersFlags.String("token", "REQUIRED", "")
+
+		zebrahookDeleteDeadLetterFlags     = flag.NewFlagSet("delete-dead-letter", flag.ExitOnError)
+		zebrahookDeleteDeadLetterIDFlag    = zebrahookDeleteDeadLetterFlags.String("id", "REQUIRED", "dead letter identifier")
+		zebrahookDeleteDeadLetterTokenFlag = zebrahookDeleteDeadLetterFlags.String("token", "REQUIRED", "")
+
+		zebrahookPurgeDeadLettersFlags     = flag.NewFlagSet("purge-dead-letters", flag.ExitOnError)
+		zebrahookPurgeDeadLettersBodyFlag  = zebrahookPurgeDeadLettersFlags.String("body", "REQUIRED", "")
+		zebrahookPurgeDeadLettersTokenFlag = zebrahookPurgeDeadLettersFlags.String("token", "REQUIRED", "")
 	)
 	zebrahookFlags.Usage = zebrahookUsage
 	zebrahookSubmitNewEventsFlags.Usage = zebrahookSubmitNewEventsUsage
@@ -104,6 +235,32 @@ func ParseEndpoint(
 	zebrahookUpdateFlags.Usage = zebrahookUpdateUsage
 	zebrahookListWebhookEndpointFlags.Usage = zebrahookListWebhookEndpointUsage
 	zebrahookGetWebhookEndpointByIDFlags.Usage = zebrahookGetWebhookEndpointByIDUsage
+	zebrahookDeleteWebhookEndpointFlags.Usage = zebrahookDeleteWebhookEndpointUsage
+	zebrahookListEventsFlags.Usage = zebrahookListEventsUsage
+	zebrahookGetEventByIDFlags.Usage = zebrahookGetEventByIDUsage
+	zebrahookListWebhookEndpointAttemptsFlags.Usage = zebrahookListWebhookEndpointAttemptsUsage
+	zebrahookRetryEventDeliveryFlags.Usage = zebrahookRetryEventDeliveryUsage
+	zebrahookRecoverWebhookEndpointFlags.Usage = zebrahookRecoverWebhookEndpointUsage
+	zebrahookGetWebhookEndpointRecoveryFlags.Usage = zebrahookGetWebhookEndpointRecoveryUsage
+	zebrahookRotateWebhookEndpointSecretFlags.Usage = zebrahookRotateWebhookEndpointSecretUsage
+	zebrahookTestWebhookEndpointFlags.Usage = zebrahookTestWebhookEndpointUsage
+	zebrahookListAPIKeysFlags.Usage = zebrahookListAPIKeysUsage
+	zebrahookGetAPIKeyFlags.Usage = zebrahookGetAPIKeyUsage
+	zebrahookUpdateAPIKeyFlags.Usage = zebrahookUpdateAPIKeyUsage
+	zebrahookRevokeAPIKeyFlags.Usage = zebrahookRevokeAPIKeyUsage
+	zebrahookRegisterEventTypeFlags.Usage = zebrahookRegisterEventTypeUsage
+	zebrahookListEventTypesFlags.Usage = zebrahookListEventTypesUsage
+	zebrahookGetEventTypeFlags.Usage = zebrahookGetEventTypeUsage
+	zebrahookUpdateEventTypeFlags.Usage = zebrahookUpdateEventTypeUsage
+	zebrahookGetEventTypesCatalogFlags.Usage = zebrahookGetEventTypesCatalogUsage
+	zebrahookGetWebhookEndpointStatusHistoryFlags.Usage = zebrahookGetWebhookEndpointStatusHistoryUsage
+	zebrahookGetWebhookEndpointStatsFlags.Usage = zebrahookGetWebhookEndpointStatsUsage
+	zebrahookListDeadLettersFlags.Usage = zebrahookListDeadLettersUsage
+	zebrahookGetDeadLetterFlags.Usage = zebrahookGetDeadLetterUsage
+	zebrahookReplayDeadLetterFlags.Usage = zebrahookReplayDeadLetterUsage
+	zebrahookReplayDeadLettersFlags.Usage = zebrahookReplayDeadLettersUsage
+	zebrahookDeleteDeadLetterFlags.Usage = zebrahookDeleteDeadLetterUsage
+	zebrahookPurgeDeadLettersFlags.Usage = zebrahookPurgeDeadLettersUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -154,6 +311,84 @@ func ParseEndpoint(
 			case "get-webhook-endpoint-by-id":
 				epf = zebrahookGetWebhookEndpointByIDFlags
 
+			case "delete-webhook-endpoint":
+				epf = zebrahookDeleteWebhookEndpointFlags
+
+			case "list-events":
+				epf = zebrahookListEventsFlags
+
+			case "get-event-by-id":
+				epf = zebrahookGetEventByIDFlags
+
+			case "list-webhook-endpoint-attempts":
+				epf = zebrahookListWebhookEndpointAttemptsFlags
+
+			case "retry-event-delivery":
+				epf = zebrahookRetryEventDeliveryFlags
+
+			case "recover-webhook-endpoint":
+				epf = zebrahookRecoverWebhookEndpointFlags
+
+			case "get-webhook-endpoint-recovery":
+				epf = zebrahookGetWebhookEndpointRecoveryFlags
+
+			case "rotate-webhook-endpoint-secret":
+				epf = zebrahookRotateWebhookEndpointSecretFlags
+
+			case "test-webhook-endpoint":
+				epf = zebrahookTestWebhookEndpointFlags
+
+			case "list-api-keys":
+				epf = zebrahookListAPIKeysFlags
+
+			case "get-api-key":
+				epf = zebrahookGetAPIKeyFlags
+
+			case "update-api-key":
+				epf = zebrahookUpdateAPIKeyFlags
+
+			case "revoke-api-key":
+				epf = zebrahookRevokeAPIKeyFlags
+
+			case "register-event-type":
+				epf = zebrahookRegisterEventTypeFlags
+
+			case "list-event-types":
+				epf = zebrahookListEventTypesFlags
+
+			case "get-event-type":
+				epf = zebrahookGetEventTypeFlags
+
+			case "update-event-type":
+				epf = zebrahookUpdateEventTypeFlags
+
+			case "get-event-types-catalog":
+				epf = zebrahookGetEventTypesCatalogFlags
+
+			case "get-webhook-endpoint-status-history":
+				epf = zebrahookGetWebhookEndpointStatusHistoryFlags
+
+			case "get-webhook-endpoint-stats":
+				epf = zebrahookGetWebhookEndpointStatsFlags
+
+			case "list-dead-letters":
+				epf = zebrahookListDeadLettersFlags
+
+			case "get-dead-letter":
+				epf = zebrahookGetDeadLetterFlags
+
+			case "replay-dead-letter":
+				epf = zebrahookReplayDeadLetterFlags
+
+			case "replay-dead-letters":
+				epf = zebrahookReplayDeadLettersFlags
+
+			case "delete-dead-letter":
+				epf = zebrahookDeleteDeadLetterFlags
+
+			case "purge-dead-letters":
+				epf = zebrahookPurgeDeadLettersFlags
+
 			}
 
 		}
@@ -181,7 +416,7 @@ func ParseEndpoint(
 			switch epn {
 			case "submit-new-events":
 				endpoint = c.SubmitNewEvents()
-				data, err = zebrahookc.BuildSubmitNewEventsPayload(*zebrahookSubmitNewEventsBodyFlag, *zebrahookSubmitNewEventsTokenFlag)
+				data, err = zebrahookc.BuildSubmitNewEventsPayload(*zebrahookSubmitNewEventsBodyFlag, *zebrahookSubmitNewEventsIdempotencyKeyFlag, *zebrahookSubmitNewEventsTokenFlag)
 			case "register":
 				endpoint = c.Register()
 				data, err = zebrahookc.BuildRegisterPayload(*zebrahookRegisterBodyFlag, *zebrahookRegisterTokenFlag)
@@ -194,6 +429,84 @@ func ParseEndpoint(
 			case "get-webhook-endpoint-by-id":
 				endpoint = c.GetWebhookEndpointByID()
 				data, err = zebrahookc.BuildGetWebhookEndpointByIDPayload(*zebrahookGetWebhookEndpointByIDIDFlag, *zebrahookGetWebhookEndpointByIDTokenFlag)
+			case "delete-webhook-endpoint":
+				endpoint = c.DeleteWebhookEndpoint()
+				data, err = zebrahookc.BuildDeleteWebhookEndpointPayload(*zebrahookDeleteWebhookEndpointIDFlag, *zebrahookDeleteWebhookEndpointTokenFlag)
+			case "list-events":
+				endpoint = c.ListEvents()
+				data, err = zebrahookc.BuildListEventsPayload(*zebrahookListEventsLimitFlag, *zebrahookListEventsStartingAfterFlag, *zebrahookListEventsEventTypeFlag, *zebrahookListEventsPriorityFlag, *zebrahookListEventsCreatedAtGteFlag, *zebrahookListEventsCreatedAtLtFlag, *zebrahookListEventsTokenFlag)
+			case "get-event-by-id":
+				endpoint = c.GetEventByID()
+				data, err = zebrahookc.BuildGetEventByIDPayload(*zebrahookGetEventByIDIDFlag, *zebrahookGetEventByIDTokenFlag)
+			case "list-webhook-endpoint-attempts":
+				endpoint = c.ListWebhookEndpointAttempts()
+				data, err = zebrahookc.BuildListWebhookEndpointAttemptsPayload(*zebrahookListWebhookEndpointAttemptsIDFlag, *zebrahookListWebhookEndpointAttemptsLimitFlag, *zebrahookListWebhookEndpointAttemptsStartingAfterFlag, *zebrahookListWebhookEndpointAttemptsStatusFlag, *zebrahookListWebhookEndpointAttemptsEventTypeFlag, *zebrahookListWebhookEndpointAttemptsCreatedAtGteFlag, *zebrahookListWebhookEndpointAttemptsCreatedAtLtFlag, *zebrahookListWebhookEndpointAttemptsTokenFlag)
+			case "retry-event-delivery":
+				endpoint = c.RetryEventDelivery()
+				data, err = zebrahookc.BuildRetryEventDeliveryPayload(*zebrahookRetryEventDeliveryIDFlag, *zebrahookRetryEventDeliveryEndpointIDFlag, *zebrahookRetryEventDeliveryTokenFlag)
+			case "recover-webhook-endpoint":
+				endpoint = c.RecoverWebhookEndpoint()
+				data, err = zebrahookc.BuildRecoverWebhookEndpointPayload(*zebrahookRecoverWebhookEndpointBodyFlag, *zebrahookRecoverWebhookEndpointIDFlag, *zebrahookRecoverWebhookEndpointTokenFlag)
+			case "get-webhook-endpoint-recovery":
+				endpoint = c.GetWebhookEndpointRecovery()
+				data, err = zebrahookc.BuildGetWebhookEndpointRecoveryPayload(*zebrahookGetWebhookEndpointRecoveryIDFlag, *zebrahookGetWebhookEndpointRecoveryRecoveryIDFlag, *zebrahookGetWebhookEndpointRecoveryTokenFlag)
+			case "rotate-webhook-endpoint-secret":
+				endpoint = c.RotateWebhookEndpointSecret()
+				data, err = zebrahookc.BuildRotateWebhookEndpointSecretPayload(*zebrahookRotateWebhookEndpointSecretBodyFlag, *zebrahookRotateWebhookEndpointSecretIDFlag, *zebrahookRotateWebhookEndpointSecretTokenFlag)
+			case "test-webhook-endpoint":
+				endpoint = c.TestWebhookEndpoint()
+				data, err = zebrahookc.BuildTestWebhookEndpointPayload(*zebrahookTestWebhookEndpointBodyFlag, *zebrahookTestWebhookEndpointIDFlag, *zebrahookTestWebhookEndpointTokenFlag)
+			case "list-api-keys":
+				endpoint = c.ListAPIKeys()
+				data, err = zebrahookc.BuildListAPIKeysPayload(*zebrahookListAPIKeysStatusFlag, *zebrahookListAPIKeysTokenFlag)
+			case "get-api-key":
+				endpoint = c.GetAPIKey()
+				data, err = zebrahookc.BuildGetAPIKeyPayload(*zebrahookGetAPIKeyIDFlag, *zebrahookGetAPIKeyTokenFlag)
+			case "update-api-key":
+				endpoint = c.UpdateAPIKey()
+				data, err = zebrahookc.BuildUpdateAPIKeyPayload(*zebrahookUpdateAPIKeyBodyFlag, *zebrahookUpdateAPIKeyIDFlag, *zebrahookUpdateAPIKeyTokenFlag)
+			case "revoke-api-key":
+				endpoint = c.RevokeAPIKey()
+				data, err = zebrahookc.BuildRevokeAPIKeyPayload(*zebrahookRevokeAPIKeyIDFlag, *zebrahookRevokeAPIKeyTokenFlag)
+			case "register-event-type":
+				endpoint = c.RegisterEventType()
+				data, err = zebrahookc.BuildRegisterEventTypePayload(*zebrahookRegisterEventTypeBodyFlag, *zebrahookRegisterEventTypeTokenFlag)
+			case "list-event-types":
+				endpoint = c.ListEventTypes()
+				data, err = zebrahookc.BuildListEventTypesPayload(*zebrahookListEventTypesDeprecatedFlag, *zebrahookListEventTypesTokenFlag)
+			case "get-event-type":
+				endpoint = c.GetEventType()
+				data, err = zebrahookc.BuildGetEventTypePayload(*zebrahookGetEventTypeNameFlag, *zebrahookGetEventTypeTokenFlag)
+			case "update-event-type":
+				endpoint = c.UpdateEventType()
+				data, err = zebrahookc.BuildUpdateEventTypePayload(*zebrahookUpdateEventTypeBodyFlag, *zebrahookUpdateEventTypeNameFlag, *zebrahookUpdateEventTypeTokenFlag)
+			case "get-event-types-catalog":
+				endpoint = c.GetEventTypesCatalog()
+				data, err = zebrahookc.BuildGetEventTypesCatalogPayload(*zebrahookGetEventTypesCatalogFormatFlag, *zebrahookGetEventTypesCatalogTokenFlag)
+			case "get-webhook-endpoint-status-history":
+				endpoint = c.GetWebhookEndpointStatusHistory()
+				data, err = zebrahookc.BuildGetWebhookEndpointStatusHistoryPayload(*zebrahookGetWebhookEndpointStatusHistoryIDFlag, *zebrahookGetWebhookEndpointStatusHistoryLimitFlag, *zebrahookGetWebhookEndpointStatusHistoryStartingAfterFlag, *zebrahookGetWebhookEndpointStatusHistoryTokenFlag)
+			case "get-webhook-endpoint-stats":
+				endpoint = c.GetWebhookEndpointStats()
+				data, err = zebrahookc.BuildGetWebhookEndpointStatsPayload(*zebrahookGetWebhookEndpointStatsIDFlag, *zebrahookGetWebhookEndpointStatsWindowFlag, *zebrahookGetWebhookEndpointStatsTokenFlag)
+			case "list-dead-letters":
+				endpoint = c.ListDeadLetters()
+				data, err = zebrahookc.BuildListDeadLettersPayload(*zebrahookListDeadLettersLimitFlag, *zebrahookListDeadLettersStartingAfterFlag, *zebrahookListDeadLettersStatusFlag, *zebrahookListDeadLettersEndpointIDFlag, *zebrahookListDeadLettersEventTypeFlag, *zebrahookListDeadLettersCreatedAtGteFlag, *zebrahookListDeadLettersCreatedAtLtFlag, *zebrahookListDeadLettersTokenFlag)
+			case "get-dead-letter":
+				endpoint = c.GetDeadLetter()
+				data, err = zebrahookc.BuildGetDeadLetterPayload(*zebrahookGetDeadLetterIDFlag, *zebrahookGetDeadLetterTokenFlag)
+			case "replay-dead-letter":
+				endpoint = c.ReplayDeadLetter()
+				data, err = zebrahookc.BuildReplayDeadLetterPayload(*zebrahookReplayDeadLetterIDFlag, *zebrahookReplayDeadLetterTokenFlag)
+			case "replay-dead-letters":
+				endpoint = c.ReplayDeadLetters()
+				data, err = zebrahookc.BuildReplayDeadLettersPayload(*zebrahookReplayDeadLettersBodyFlag, *zebrahookReplayDeadLettersTokenFlag)
+			case "delete-dead-letter":
+				endpoint = c.DeleteDeadLetter()
+				data, err = zebrahookc.BuildDeleteDeadLetterPayload(*zebrahookDeleteDeadLetterIDFlag, *zebrahookDeleteDeadLetterTokenFlag)
+			case "purge-dead-letters":
+				endpoint = c.PurgeDeadLetters()
+				data, err = zebrahookc.BuildPurgeDeadLettersPayload(*zebrahookPurgeDeadLettersBodyFlag, *zebrahookPurgeDeadLettersTokenFlag)
 			}
 		}
 	}
@@ -217,16 +530,43 @@ COMMAND:
     update: Allows to update a webhook created before
     list-webhook-endpoint: Allows to list and query registered webhook
     get-webhook-endpoint-by-id: Allows to get info about a registered webhook URL via the identifier
+    delete-webhook-endpoint: Allows to delete a registered webhook endpoint, pending and held deliveries for this endpoint are cancelled
+    list-events: Allows to list and query submitted events, most recent first
+    get-event-by-id: Allows to get a submitted event with the delivery status for each webhook endpoint
+    list-webhook-endpoint-attempts: Allows to list the delivery attempts made to a webhook endpoint, most recent first
+    retry-event-delivery: Allows to deliver again an event to a webhook endpoint, a new delivery is created even if the previous one has no more attempts remaining or the webhook endpoint is disabled
+    recover-webhook-endpoint: Allows to deliver again all the events not successfully delivered to a webhook endpoint since the provided time, the webhook endpoint is enabled if disabled. Recovery is asynchronous, use the returned identifier to check the progress
+    get-webhook-endpoint-recovery: Allows to get the progress of a webhook endpoint recovery
+    rotate-webhook-endpoint-secret: Allows to generate a new secret for a webhook endpoint, the previous secret stays valid for a grace period during which events are signed with both secrets. Only one previous secret is kept: the secret can't be rotated again until the grace period ends
+    test-webhook-endpoint: Allows to send a test event to a webhook endpoint, the event is signed and delivered synchronously and the outcome is returned. Test events are not stored and never disable the webhook endpoint
+    list-api-keys: Allows to list the api keys
+    get-api-key: Allows to describe an api key
+    update-api-key: Allows to update the description and the expiration of an api key
+    revoke-api-key: Allows to revoke an api key, revoked api keys can't be used anymore
+    register-event-type: Allows to declare a new event type, the content of the submitted events of this type is validated against its JSON Schema
+    list-event-types: Allows to list the registered event types
+    get-event-type: Allows to retrieve a registered event type
+    update-event-type: Allows to update the description, the JSON Schema, the example or the deprecated flag of an event type
+    get-event-types-catalog: Allows to export the catalog of the registered event types as an AsyncAPI 2.x document, a Markdown or an HTML page, describing the webhook payloads, the signature header and the retry policy
+    get-webhook-endpoint-status-history: Allows to list the status changes of a webhook endpoint with their reason, most recent first
+    get-webhook-endpoint-stats: Allows to retrieve the delivery stats of a webhook endpoint over a time window, stats are updated periodically and can lag behind the latest attempts by up to `+"`"+`stats.rollupIntervalSecs`+"`"+` seconds
+    list-dead-letters: Allows to list the event deliveries that failed without more attempts (dead letters), most recent first
+    get-dead-letter: Allows to inspect a dead letter with its event and the last response of the webhook endpoint
+    replay-dead-letter: Allows to deliver again the event of a dead letter to its webhook endpoint, a new delivery is created even if the webhook endpoint is disabled
+    replay-dead-letters: Allows to deliver again the events of the dead letters matching the filters, oldest first. Dead letters of deleted webhook endpoints are skipped, call again while `+"`"+`has_more`+"`"+` is true
+    delete-dead-letter: Allows to permanently remove a dead letter, the event and its deliveries are kept
+    purge-dead-letters: Allows to permanently remove the dead letters matching the filters, the events and their deliveries are kept
 
 Additional help:
     %[1]s zebrahook COMMAND --help
 `, os.Args[0])
 }
 func zebrahookSubmitNewEventsUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook submit-new-events -body JSON -token STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook submit-new-events -body JSON -idempotency-key STRING -token STRING
 
 Submit new events, all events will be asynchronously dispatched to all endpoints that are subscribed to the provided event type (`+"`"+`enabled_events`+"`"+`)
     -body JSON: 
+    -idempotency-key STRING: 
     -token STRING: 
 
 Example:
@@ -258,7 +598,7 @@ Example:
             "event_type": "merchant-93842.order.shipped"
          }
       ]
-   }' --token "Veniam fugit consequuntur enim dignissimos sit."
+   }' --idempotency-key "a5e1d1c2-8d5b-4b0e-9f3a-1f0c2d3e4f5a" --token "Dolores et."
 `, os.Args[0])
 }
 
@@ -271,15 +611,33 @@ Allows to register a new webhook URL with the specified enabled events
 
 Example:
     %[1]s zebrahook register --body '{
+      "delivery_policy": {
+         "backoff_base_secs": 60,
+         "backoff_schedule_secs": [
+            60,
+            300,
+            1800,
+            3600,
+            18000,
+            36000
+         ],
+         "backoff_type": "fixed",
+         "max_attempts": 5,
+         "max_backoff_secs": 3600,
+         "timeout_secs": 30
+      },
       "enabled_events": [
          "merchant-93842.order.*",
          "my.custom.event"
       ],
+      "headers": {
+         "X-Api-Key": "your-gateway-api-key"
+      },
       "metadata": {
          "anyKeyHere": "any value here"
       },
       "url": "https://example.com/notifications"
-   }' --token "Est excepturi tempore id cum aut."
+   }' --token "Sit praesentium sit quaerat maxime labore."
 `, os.Args[0])
 }
 
@@ -293,16 +651,34 @@ Allows to update a webhook created before
 
 Example:
     %[1]s zebrahook update --body '{
-      "disabled": true,
+      "delivery_policy": {
+         "backoff_base_secs": 60,
+         "backoff_schedule_secs": [
+            60,
+            300,
+            1800,
+            3600,
+            18000,
+            36000
+         ],
+         "backoff_type": "fixed",
+         "max_attempts": 5,
+         "max_backoff_secs": 3600,
+         "timeout_secs": 30
+      },
+      "disabled": false,
       "enabled_events": [
          "your.event_name",
          "custom.event.*"
       ],
+      "headers": {
+         "X-Api-Key": "your-gateway-api-key"
+      },
       "metadata": {
          "anyKeyHere": "any value here"
       },
       "url": "https://example.com/notifications"
-   }' --id "zhwe_c9ddsgbei1cst46tglh0" --token "Sint perferendis eos repudiandae non."
+   }' --id "zhwe_c9ddsgbei1cst46tglh0" --token "Rerum fuga neque accusamus ducimus voluptatem."
 `, os.Args[0])
 }
 
@@ -320,7 +696,7 @@ Allows to list and query registered webhook
 Example:
     %[1]s zebrahook list-webhook-endpoint --limit 50 --offset 0 --created-at-gte 1646278413 --updated-at-lt 1646369084 --metadata '{
       "metadata": "valuehere"
-   }' --token "Aspernatur doloribus eius."
+   }' --token "Alias odio earum eligendi non."
 `, os.Args[0])
 }
 
@@ -332,6 +708,396 @@ Allows to get info about a registered webhook URL via the identifier
     -token STRING: 
 
 Example:
-    %[1]s zebrahook get-webhook-endpoint-by-id --id "zhwe_c9ddsgbei1cst46tglh0" --token "Dolor fugiat illum sed in debitis voluptatem."
+    %[1]s zebrahook get-webhook-endpoint-by-id --id "zhwe_c9ddsgbei1cst46tglh0" --token "Eum voluptatem voluptatem quo maxime eos."
+`, os.Args[0])
+}
+
+func zebrahookDeleteWebhookEndpointUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook delete-webhook-endpoint -id STRING -token STRING
+
+Allows to delete a registered webhook endpoint, pending and held deliveries for this endpoint are cancelled
+    -id STRING: webhook identifier returned in creation
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook delete-webhook-endpoint --id "zhwe_c9ddsgbei1cst46tglh0" --token "Adipisci ut odit et."
+`, os.Args[0])
+}
+
+func zebrahookListEventsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook list-events -limit INT32 -starting-after UINT -event-type STRING -priority INT -created-at-gte UINT64 -created-at-lt UINT64 -token STRING
+
+Allows to list and query submitted events, most recent first
+    -limit INT32: 
+    -starting-after UINT: 
+    -event-type STRING: 
+    -priority INT: 
+    -created-at-gte UINT64: 
+    -created-at-lt UINT64: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook list-events --limit 50 --starting-after 1024 --event-type "merchant-93842.order.shipped" --priority 1000 --created-at-gte 1646278413 --created-at-lt 1646369084 --token "Eaque excepturi quia."
+`, os.Args[0])
+}
+
+func zebrahookGetEventByIDUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-event-by-id -id UINT -token STRING
+
+Allows to get a submitted event with the delivery status for each webhook endpoint
+    -id UINT: event identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-event-by-id --id 1024 --token "Vel commodi odit quia delectus eaque perferendis."
+`, os.Args[0])
+}
+
+func zebrahookListWebhookEndpointAttemptsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook list-webhook-endpoint-attempts -id STRING -limit INT32 -starting-after UINT -status STRING -event-type STRING -created-at-gte UINT64 -created-at-lt UINT64 -token STRING
+
+Allows to list the delivery attempts made to a webhook endpoint, most recent first
+    -id STRING: webhook identifier returned in creation
+    -limit INT32: 
+    -starting-after UINT: 
+    -status STRING: 
+    -event-type STRING: 
+    -created-at-gte UINT64: 
+    -created-at-lt UINT64: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook list-webhook-endpoint-attempts --id "zhwe_c9ddsgbei1cst46tglh0" --limit 50 --starting-after 4096 --status "error_timeout" --event-type "merchant-93842.order.shipped" --created-at-gte 1646278413 --created-at-lt 1646369084 --token "Necessitatibus nihil."
+`, os.Args[0])
+}
+
+func zebrahookRetryEventDeliveryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook retry-event-delivery -id UINT -endpoint-id STRING -token STRING
+
+Allows to deliver again an event to a webhook endpoint, a new delivery is created even if the previous one has no more attempts remaining or the webhook endpoint is disabled
+    -id UINT: event identifier
+    -endpoint-id STRING: identifier of the webhook endpoint that received the event
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook retry-event-delivery --id 1024 --endpoint-id "zhwe_c9ddsgbei1cst46tglh0" --token "Distinctio omnis nesciunt."
+`, os.Args[0])
+}
+
+func zebrahookRecoverWebhookEndpointUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook recover-webhook-endpoint -body JSON -id STRING -token STRING
+
+Allows to deliver again all the events not successfully delivered to a webhook endpoint since the provided time, the webhook endpoint is enabled if disabled. Recovery is asynchronous, use the returned identifier to check the progress
+    -body JSON: 
+    -id STRING: webhook identifier returned in creation
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook recover-webhook-endpoint --body '{
+      "since": 1646278413
+   }' --id "zhwe_c9ddsgbei1cst46tglh0" --token "Qui autem aut qui rerum."
+`, os.Args[0])
+}
+
+func zebrahookGetWebhookEndpointRecoveryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-webhook-endpoint-recovery -id STRING -recovery-id STRING -token STRING
+
+Allows to get the progress of a webhook endpoint recovery
+    -id STRING: webhook identifier returned in creation
+    -recovery-id STRING: recovery identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-webhook-endpoint-recovery --id "zhwe_c9ddsgbei1cst46tglh0" --recovery-id "zhrec_c9ddsgbei1cst46tglh0" --token "Soluta id voluptatem eos."
+`, os.Args[0])
+}
+
+func zebrahookRotateWebhookEndpointSecretUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook rotate-webhook-endpoint-secret -body JSON -id STRING -token STRING
+
+Allows to generate a new secret for a webhook endpoint, the previous secret stays valid for a grace period during which events are signed with both secrets. Only one previous secret is kept: the secret can't be rotated again until the grace period ends
+    -body JSON: 
+    -id STRING: webhook identifier returned in creation
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook rotate-webhook-endpoint-secret --body '{
+      "grace_period_secs": 86400
+   }' --id "zhwe_c9ddsgbei1cst46tglh0" --token "Itaque ipsam illum."
+`, os.Args[0])
+}
+
+func zebrahookTestWebhookEndpointUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook test-webhook-endpoint -body JSON -id STRING -token STRING
+
+Allows to send a test event to a webhook endpoint, the event is signed and delivered synchronously and the outcome is returned. Test events are not stored and never disable the webhook endpoint
+    -body JSON: 
+    -id STRING: webhook identifier returned in creation
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook test-webhook-endpoint --body '{
+      "event_content": {
+         "sku": "002432800"
+      },
+      "event_type": "merchant-93842.order.shipped"
+   }' --id "zhwe_c9ddsgbei1cst46tglh0" --token "Et distinctio facere cupiditate."
+`, os.Args[0])
+}
+
+func zebrahookListAPIKeysUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook list-api-keys -status STRING -token STRING
+
+Allows to list the api keys
+    -status STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook list-api-keys --status "disabled" --token "Assumenda ut."
+`, os.Args[0])
+}
+
+func zebrahookGetAPIKeyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-api-key -id UINT -token STRING
+
+Allows to describe an api key
+    -id UINT: api key identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-api-key --id 3 --token "Dolores aliquid adipisci consequuntur."
+`, os.Args[0])
+}
+
+func zebrahookUpdateAPIKeyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook update-api-key -body JSON -id UINT -token STRING
+
+Allows to update the description and the expiration of an api key
+    -body JSON: 
+    -id UINT: api key identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook update-api-key --body '{
+      "description": "orders service",
+      "expiresAt": 1677814413,
+      "scopes": [
+         "events:write"
+      ]
+   }' --id 3 --token "Totam inventore qui."
+`, os.Args[0])
+}
+
+func zebrahookRevokeAPIKeyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook revoke-api-key -id UINT -token STRING
+
+Allows to revoke an api key, revoked api keys can't be used anymore
+    -id UINT: api key identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook revoke-api-key --id 3 --token "Et sed vel sit."
+`, os.Args[0])
+}
+
+func zebrahookRegisterEventTypeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook register-event-type -body JSON -token STRING
+
+Allows to declare a new event type, the content of the submitted events of this type is validated against its JSON Schema
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook register-event-type --body '{
+      "deprecated": false,
+      "description": "An order was shipped to the customer",
+      "example": {
+         "Quasi sit sunt non et.": "Facilis temporibus nulla.",
+         "Voluptas non.": "Eveniet suscipit id aperiam voluptas quis amet."
+      },
+      "name": "order.shipped",
+      "schema": {
+         "Est rerum dolorum.": "Voluptate nulla placeat.",
+         "Quia architecto voluptatem et.": "Optio dolorum."
+      }
+   }' --token "Deleniti ratione magnam et labore blanditiis voluptate."
+`, os.Args[0])
+}
+
+func zebrahookListEventTypesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook list-event-types -deprecated BOOL -token STRING
+
+Allows to list the registered event types
+    -deprecated BOOL: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook list-event-types --deprecated true --token "Autem commodi."
+`, os.Args[0])
+}
+
+func zebrahookGetEventTypeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-event-type -name STRING -token STRING
+
+Allows to retrieve a registered event type
+    -name STRING: event type name
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-event-type --name "order.shipped" --token "Quia porro voluptatem."
+`, os.Args[0])
+}
+
+func zebrahookUpdateEventTypeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook update-event-type -body JSON -name STRING -token STRING
+
+Allows to update the description, the JSON Schema, the example or the deprecated flag of an event type
+    -body JSON: 
+    -name STRING: event type name
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook update-event-type --body '{
+      "deprecated": false,
+      "description": "An order was shipped to the customer",
+      "example": {
+         "Dignissimos consectetur odio voluptate ea recusandae doloremque.": "Sequi nemo natus."
+      },
+      "schema": {
+         "Tempora nobis iure perspiciatis inventore dolores non.": "Explicabo quia."
+      }
+   }' --name "order.shipped" --token "Commodi qui."
+`, os.Args[0])
+}
+
+func zebrahookGetEventTypesCatalogUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-event-types-catalog -format STRING -token STRING
+
+Allows to export the catalog of the registered event types as an AsyncAPI 2.x document, a Markdown or an HTML page, describing the webhook payloads, the signature header and the retry policy
+    -format STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-event-types-catalog --format "html" --token "Aspernatur voluptas ut et corrupti."
+`, os.Args[0])
+}
+
+func zebrahookGetWebhookEndpointStatusHistoryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-webhook-endpoint-status-history -id STRING -limit INT32 -starting-after UINT -token STRING
+
+Allows to list the status changes of a webhook endpoint with their reason, most recent first
+    -id STRING: webhook identifier returned in creation
+    -limit INT32: 
+    -starting-after UINT: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-webhook-endpoint-status-history --id "zhwe_c9ddsgbei1cst46tglh0" --limit 50 --starting-after 64 --token "Eveniet ut."
+`, os.Args[0])
+}
+
+func zebrahookGetWebhookEndpointStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-webhook-endpoint-stats -id STRING -window STRING -token STRING
+
+Allows to retrieve the delivery stats of a webhook endpoint over a time window, stats are updated periodically and can lag behind the latest attempts by up to `+"`"+`stats.rollupIntervalSecs`+"`"+` seconds
+    -id STRING: webhook identifier returned in creation
+    -window STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-webhook-endpoint-stats --id "zhwe_c9ddsgbei1cst46tglh0" --window "7d" --token "Pariatur sit et."
+`, os.Args[0])
+}
+
+func zebrahookListDeadLettersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook list-dead-letters -limit INT32 -starting-after UINT -status STRING -endpoint-id STRING -event-type STRING -created-at-gte UINT64 -created-at-lt UINT64 -token STRING
+
+Allows to list the event deliveries that failed without more attempts (dead letters), most recent first
+    -limit INT32: 
+    -starting-after UINT: 
+    -status STRING: 
+    -endpoint-id STRING: 
+    -event-type STRING: 
+    -created-at-gte UINT64: 
+    -created-at-lt UINT64: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook list-dead-letters --limit 50 --starting-after 128 --status "dead" --endpoint-id "zhwe_c9ddsgbei1cst46tglh0" --event-type "merchant-93842.order.shipped" --created-at-gte 1646278413 --created-at-lt 1646369084 --token "Et saepe officiis similique."
+`, os.Args[0])
+}
+
+func zebrahookGetDeadLetterUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook get-dead-letter -id UINT -token STRING
+
+Allows to inspect a dead letter with its event and the last response of the webhook endpoint
+    -id UINT: dead letter identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook get-dead-letter --id 128 --token "Aperiam dolor architecto."
+`, os.Args[0])
+}
+
+func zebrahookReplayDeadLetterUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook replay-dead-letter -id UINT -token STRING
+
+Allows to deliver again the event of a dead letter to its webhook endpoint, a new delivery is created even if the webhook endpoint is disabled
+    -id UINT: dead letter identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook replay-dead-letter --id 128 --token "Totam officia dolorum sit vero."
+`, os.Args[0])
+}
+
+func zebrahookReplayDeadLettersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook replay-dead-letters -body JSON -token STRING
+
+Allows to deliver again the events of the dead letters matching the filters, oldest first. Dead letters of deleted webhook endpoints are skipped, call again while `+"`"+`has_more`+"`"+` is true
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook replay-dead-letters --body '{
+      "createdAt.gte": 1646278413,
+      "createdAt.lt": 1646369084,
+      "endpoint_id": "zhwe_c9ddsgbei1cst46tglh0",
+      "event_type": "merchant-93842.order.shipped",
+      "ids": [
+         128,
+         129
+      ],
+      "limit": 100
+   }' --token "Aut doloribus ut illo fugit odit est."
+`, os.Args[0])
+}
+
+func zebrahookDeleteDeadLetterUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook delete-dead-letter -id UINT -token STRING
+
+Allows to permanently remove a dead letter, the event and its deliveries are kept
+    -id UINT: dead letter identifier
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook delete-dead-letter --id 128 --token "Omnis vel ea."
+`, os.Args[0])
+}
+
+func zebrahookPurgeDeadLettersUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] zebrahook purge-dead-letters -body JSON -token STRING
+
+Allows to permanently remove the dead letters matching the filters, the events and their deliveries are kept
+    -body JSON: 
+    -token STRING: 
+
+Example:
+    %[1]s zebrahook purge-dead-letters --body '{
+      "createdAt.lt": 1646369084,
+      "endpoint_id": "zhwe_c9ddsgbei1cst46tglh0",
+      "event_type": "merchant-93842.order.shipped",
+      "status": "replayed"
+   }' --token "Excepturi impedit adipisci."
 `, os.Args[0])
 }
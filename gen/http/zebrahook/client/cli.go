@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP client CLI support package
 //
@@ -19,7 +19,7 @@ import (
 
 // BuildSubmitNewEventsPayload builds the payload for the Zebrahook
 // submitNewEvents endpoint from CLI flags.
-func BuildSubmitNewEventsPayload(zebrahookSubmitNewEventsBody string, zebrahookSubmitNewEventsToken string) (*zebrahook.SubmitNewEventsPayload, error) {
+func BuildSubmitNewEventsPayload(zebrahookSubmitNewEventsBody string, zebrahookSubmitNewEventsIdempotencyKey string, zebrahookSubmitNewEventsToken string) (*zebrahook.SubmitNewEventsPayload, error) {
 	var err error
 	var body SubmitNewEventsRequestBody
 	{
@@ -30,10 +30,32 @@ func BuildSubmitNewEventsPayload(zebrahookSubmitNewEventsBody string, zebrahookS
 		if body.Events == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
 		}
+		for _, e := range body.Events {
+			if e != nil {
+				if err2 := ValidateEventRequestRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
 	}
+	var idempotencyKey *string
+	{
+		if zebrahookSubmitNewEventsIdempotencyKey != "" {
+			idempotencyKey = &zebrahookSubmitNewEventsIdempotencyKey
+			if utf8.RuneCountInString(*idempotencyKey) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 1, true))
+			}
+			if utf8.RuneCountInString(*idempotencyKey) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 255, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var token string
 	{
 		token = zebrahookSubmitNewEventsToken
@@ -45,6 +67,7 @@ func BuildSubmitNewEventsPayload(zebrahookSubmitNewEventsBody string, zebrahookS
 			v.Events[i] = marshalEventRequestRequestBodyToZebrahookEventRequest(val)
 		}
 	}
+	v.IdempotencyKey = idempotencyKey
 	v.Token = token
 
 	return v, nil
@@ -58,7 +81,7 @@ func BuildRegisterPayload(zebrahookRegisterBody string, zebrahookRegisterToken s
 	{
 		err = json.Unmarshal([]byte(zebrahookRegisterBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"enabled_events\": [\n         \"merchant-93842.order.*\",\n         \"my.custom.event\"\n      ],\n      \"metadata\": {\n         \"anyKeyHere\": \"any value here\"\n      },\n      \"url\": \"https://example.com/notifications\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_policy\": {\n         \"backoff_base_secs\": 60,\n         \"backoff_schedule_secs\": [\n            60,\n            300,\n            1800,\n            3600,\n            18000,\n            36000\n         ],\n         \"backoff_type\": \"fixed\",\n         \"max_attempts\": 5,\n         \"max_backoff_secs\": 3600,\n         \"timeout_secs\": 30\n      },\n      \"enabled_events\": [\n         \"merchant-93842.order.*\",\n         \"my.custom.event\"\n      ],\n      \"headers\": {\n         \"X-Api-Key\": \"your-gateway-api-key\"\n      },\n      \"metadata\": {\n         \"anyKeyHere\": \"any value here\"\n      },\n      \"url\": \"https://example.com/notifications\"\n   }'")
 		}
 		if body.EnabledEvents == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("enabled_events", "body"))
@@ -76,6 +99,19 @@ func BuildRegisterPayload(zebrahookRegisterBody string, zebrahookRegisterToken s
 				err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata[key]", v, utf8.RuneCountInString(v), 1, true))
 			}
 		}
+		for k, v := range body.Headers {
+			if utf8.RuneCountInString(k) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers.key", k, utf8.RuneCountInString(k), 1, true))
+			}
+			if utf8.RuneCountInString(v) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers[key]", v, utf8.RuneCountInString(v), 1, true))
+			}
+		}
+		if body.DeliveryPolicy != nil {
+			if err2 := ValidateDeliveryPolicyRequestBody(body.DeliveryPolicy); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -101,6 +137,17 @@ func BuildRegisterPayload(zebrahookRegisterBody string, zebrahookRegisterToken s
 			v.Metadata[tk] = tv
 		}
 	}
+	if body.Headers != nil {
+		v.Headers = make(map[string]string, len(body.Headers))
+		for key, val := range body.Headers {
+			tk := key
+			tv := val
+			v.Headers[tk] = tv
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		v.DeliveryPolicy = marshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy(body.DeliveryPolicy)
+	}
 	v.Token = token
 
 	return v, nil
@@ -114,7 +161,7 @@ func BuildUpdatePayload(zebrahookUpdateBody string, zebrahookUpdateID string, ze
 	{
 		err = json.Unmarshal([]byte(zebrahookUpdateBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"disabled\": true,\n      \"enabled_events\": [\n         \"your.event_name\",\n         \"custom.event.*\"\n      ],\n      \"metadata\": {\n         \"anyKeyHere\": \"any value here\"\n      },\n      \"url\": \"https://example.com/notifications\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"delivery_policy\": {\n         \"backoff_base_secs\": 60,\n         \"backoff_schedule_secs\": [\n            60,\n            300,\n            1800,\n            3600,\n            18000,\n            36000\n         ],\n         \"backoff_type\": \"fixed\",\n         \"max_attempts\": 5,\n         \"max_backoff_secs\": 3600,\n         \"timeout_secs\": 30\n      },\n      \"disabled\": false,\n      \"enabled_events\": [\n         \"your.event_name\",\n         \"custom.event.*\"\n      ],\n      \"headers\": {\n         \"X-Api-Key\": \"your-gateway-api-key\"\n      },\n      \"metadata\": {\n         \"anyKeyHere\": \"any value here\"\n      },\n      \"url\": \"https://example.com/notifications\"\n   }'")
 		}
 		if body.URL != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
@@ -127,6 +174,19 @@ func BuildUpdatePayload(zebrahookUpdateBody string, zebrahookUpdateID string, ze
 				err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata[key]", v, utf8.RuneCountInString(v), 1, true))
 			}
 		}
+		for k, v := range body.Headers {
+			if utf8.RuneCountInString(k) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers.key", k, utf8.RuneCountInString(k), 1, true))
+			}
+			if utf8.RuneCountInString(v) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers[key]", v, utf8.RuneCountInString(v), 1, true))
+			}
+		}
+		if body.DeliveryPolicy != nil {
+			if err2 := ValidateDeliveryPolicyRequestBody(body.DeliveryPolicy); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -157,6 +217,17 @@ func BuildUpdatePayload(zebrahookUpdateBody string, zebrahookUpdateID string, ze
 			v.Metadata[tk] = tv
 		}
 	}
+	if body.Headers != nil {
+		v.Headers = make(map[string]string, len(body.Headers))
+		for key, val := range body.Headers {
+			tk := key
+			tv := val
+			v.Headers[tk] = tv
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		v.DeliveryPolicy = marshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy(body.DeliveryPolicy)
+	}
 	v.ID = id
 	v.Token = token
 
@@ -197,10 +268,8 @@ func BuildListWebhookEndpointPayload(zebrahookListWebhookEndpointLimit string, z
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for createdAtGte, must be UINT64")
 			}
-			if createdAtGte != nil {
-				if *createdAtGte < 0 {
-					err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
-				}
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
 			}
 			if err != nil {
 				return nil, err
@@ -215,10 +284,8 @@ func BuildListWebhookEndpointPayload(zebrahookListWebhookEndpointLimit string, z
 			if err != nil {
 				return nil, fmt.Errorf("invalid value for updatedAtLt, must be UINT64")
 			}
-			if updatedAtLt != nil {
-				if *updatedAtLt < 0 {
-					err = goa.MergeErrors(err, goa.InvalidRangeError("updatedAtLt", *updatedAtLt, 0, true))
-				}
+			if *updatedAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("updatedAtLt", *updatedAtLt, 0, true))
 			}
 			if err != nil {
 				return nil, err
@@ -266,3 +333,1075 @@ func BuildGetWebhookEndpointByIDPayload(zebrahookGetWebhookEndpointByIDID string
 
 	return v, nil
 }
+
+// BuildDeleteWebhookEndpointPayload builds the payload for the Zebrahook
+// deleteWebhookEndpoint endpoint from CLI flags.
+func BuildDeleteWebhookEndpointPayload(zebrahookDeleteWebhookEndpointID string, zebrahookDeleteWebhookEndpointToken string) (*zebrahook.DeleteWebhookEndpointPayload, error) {
+	var id string
+	{
+		id = zebrahookDeleteWebhookEndpointID
+	}
+	var token string
+	{
+		token = zebrahookDeleteWebhookEndpointToken
+	}
+	v := &zebrahook.DeleteWebhookEndpointPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListEventsPayload builds the payload for the Zebrahook listEvents
+// endpoint from CLI flags.
+func BuildListEventsPayload(zebrahookListEventsLimit string, zebrahookListEventsStartingAfter string, zebrahookListEventsEventType string, zebrahookListEventsPriority string, zebrahookListEventsCreatedAtGte string, zebrahookListEventsCreatedAtLt string, zebrahookListEventsToken string) (*zebrahook.ListEventsPayload, error) {
+	var err error
+	var limit int32
+	{
+		if zebrahookListEventsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(zebrahookListEventsLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var startingAfter *uint
+	{
+		if zebrahookListEventsStartingAfter != "" {
+			var v uint64
+			v, err = strconv.ParseUint(zebrahookListEventsStartingAfter, 10, strconv.IntSize)
+			val := uint(v)
+			startingAfter = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startingAfter, must be UINT")
+			}
+		}
+	}
+	var eventType *string
+	{
+		if zebrahookListEventsEventType != "" {
+			eventType = &zebrahookListEventsEventType
+		}
+	}
+	var priority *int
+	{
+		if zebrahookListEventsPriority != "" {
+			var v int64
+			v, err = strconv.ParseInt(zebrahookListEventsPriority, 10, strconv.IntSize)
+			val := int(v)
+			priority = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for priority, must be INT")
+			}
+		}
+	}
+	var createdAtGte *uint64
+	{
+		if zebrahookListEventsCreatedAtGte != "" {
+			val, err := strconv.ParseUint(zebrahookListEventsCreatedAtGte, 10, 64)
+			createdAtGte = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdAtGte, must be UINT64")
+			}
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var createdAtLt *uint64
+	{
+		if zebrahookListEventsCreatedAtLt != "" {
+			val, err := strconv.ParseUint(zebrahookListEventsCreatedAtLt, 10, 64)
+			createdAtLt = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdAtLt, must be UINT64")
+			}
+			if *createdAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtLt", *createdAtLt, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookListEventsToken
+	}
+	v := &zebrahook.ListEventsPayload{}
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.EventType = eventType
+	v.Priority = priority
+	v.CreatedAtGte = createdAtGte
+	v.CreatedAtLt = createdAtLt
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetEventByIDPayload builds the payload for the Zebrahook getEventById
+// endpoint from CLI flags.
+func BuildGetEventByIDPayload(zebrahookGetEventByIDID string, zebrahookGetEventByIDToken string) (*zebrahook.GetEventByIDPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookGetEventByIDID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookGetEventByIDToken
+	}
+	v := &zebrahook.GetEventByIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListWebhookEndpointAttemptsPayload builds the payload for the Zebrahook
+// listWebhookEndpointAttempts endpoint from CLI flags.
+func BuildListWebhookEndpointAttemptsPayload(zebrahookListWebhookEndpointAttemptsID string, zebrahookListWebhookEndpointAttemptsLimit string, zebrahookListWebhookEndpointAttemptsStartingAfter string, zebrahookListWebhookEndpointAttemptsStatus string, zebrahookListWebhookEndpointAttemptsEventType string, zebrahookListWebhookEndpointAttemptsCreatedAtGte string, zebrahookListWebhookEndpointAttemptsCreatedAtLt string, zebrahookListWebhookEndpointAttemptsToken string) (*zebrahook.ListWebhookEndpointAttemptsPayload, error) {
+	var err error
+	var id string
+	{
+		id = zebrahookListWebhookEndpointAttemptsID
+	}
+	var limit int32
+	{
+		if zebrahookListWebhookEndpointAttemptsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(zebrahookListWebhookEndpointAttemptsLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var startingAfter *uint
+	{
+		if zebrahookListWebhookEndpointAttemptsStartingAfter != "" {
+			var v uint64
+			v, err = strconv.ParseUint(zebrahookListWebhookEndpointAttemptsStartingAfter, 10, strconv.IntSize)
+			val := uint(v)
+			startingAfter = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startingAfter, must be UINT")
+			}
+		}
+	}
+	var status *string
+	{
+		if zebrahookListWebhookEndpointAttemptsStatus != "" {
+			status = &zebrahookListWebhookEndpointAttemptsStatus
+			if !(*status == "pending" || *status == "success" || *status == "error_timeout" || *status == "error_response" || *status == "error_network" || *status == "error_blocked" || *status == "error_internal" || *status == "cancelled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []interface{}{"pending", "success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal", "cancelled"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var eventType *string
+	{
+		if zebrahookListWebhookEndpointAttemptsEventType != "" {
+			eventType = &zebrahookListWebhookEndpointAttemptsEventType
+		}
+	}
+	var createdAtGte *uint64
+	{
+		if zebrahookListWebhookEndpointAttemptsCreatedAtGte != "" {
+			val, err := strconv.ParseUint(zebrahookListWebhookEndpointAttemptsCreatedAtGte, 10, 64)
+			createdAtGte = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdAtGte, must be UINT64")
+			}
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var createdAtLt *uint64
+	{
+		if zebrahookListWebhookEndpointAttemptsCreatedAtLt != "" {
+			val, err := strconv.ParseUint(zebrahookListWebhookEndpointAttemptsCreatedAtLt, 10, 64)
+			createdAtLt = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdAtLt, must be UINT64")
+			}
+			if *createdAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtLt", *createdAtLt, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookListWebhookEndpointAttemptsToken
+	}
+	v := &zebrahook.ListWebhookEndpointAttemptsPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.Status = status
+	v.EventType = eventType
+	v.CreatedAtGte = createdAtGte
+	v.CreatedAtLt = createdAtLt
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRetryEventDeliveryPayload builds the payload for the Zebrahook
+// retryEventDelivery endpoint from CLI flags.
+func BuildRetryEventDeliveryPayload(zebrahookRetryEventDeliveryID string, zebrahookRetryEventDeliveryEndpointID string, zebrahookRetryEventDeliveryToken string) (*zebrahook.RetryEventDeliveryPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookRetryEventDeliveryID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var endpointID string
+	{
+		endpointID = zebrahookRetryEventDeliveryEndpointID
+	}
+	var token string
+	{
+		token = zebrahookRetryEventDeliveryToken
+	}
+	v := &zebrahook.RetryEventDeliveryPayload{}
+	v.ID = id
+	v.EndpointID = endpointID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRecoverWebhookEndpointPayload builds the payload for the Zebrahook
+// recoverWebhookEndpoint endpoint from CLI flags.
+func BuildRecoverWebhookEndpointPayload(zebrahookRecoverWebhookEndpointBody string, zebrahookRecoverWebhookEndpointID string, zebrahookRecoverWebhookEndpointToken string) (*zebrahook.RecoverWebhookEndpointPayload, error) {
+	var err error
+	var body RecoverWebhookEndpointRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookRecoverWebhookEndpointBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"since\": 1646278413\n   }'")
+		}
+		if body.Since < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.since", body.Since, 0, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = zebrahookRecoverWebhookEndpointID
+	}
+	var token string
+	{
+		token = zebrahookRecoverWebhookEndpointToken
+	}
+	v := &zebrahook.RecoverWebhookEndpointPayload{
+		Since: body.Since,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetWebhookEndpointRecoveryPayload builds the payload for the Zebrahook
+// getWebhookEndpointRecovery endpoint from CLI flags.
+func BuildGetWebhookEndpointRecoveryPayload(zebrahookGetWebhookEndpointRecoveryID string, zebrahookGetWebhookEndpointRecoveryRecoveryID string, zebrahookGetWebhookEndpointRecoveryToken string) (*zebrahook.GetWebhookEndpointRecoveryPayload, error) {
+	var id string
+	{
+		id = zebrahookGetWebhookEndpointRecoveryID
+	}
+	var recoveryID string
+	{
+		recoveryID = zebrahookGetWebhookEndpointRecoveryRecoveryID
+	}
+	var token string
+	{
+		token = zebrahookGetWebhookEndpointRecoveryToken
+	}
+	v := &zebrahook.GetWebhookEndpointRecoveryPayload{}
+	v.ID = id
+	v.RecoveryID = recoveryID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRotateWebhookEndpointSecretPayload builds the payload for the Zebrahook
+// rotateWebhookEndpointSecret endpoint from CLI flags.
+func BuildRotateWebhookEndpointSecretPayload(zebrahookRotateWebhookEndpointSecretBody string, zebrahookRotateWebhookEndpointSecretID string, zebrahookRotateWebhookEndpointSecretToken string) (*zebrahook.RotateWebhookEndpointSecretPayload, error) {
+	var err error
+	var body RotateWebhookEndpointSecretRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookRotateWebhookEndpointSecretBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"grace_period_secs\": 86400\n   }'")
+		}
+	}
+	var id string
+	{
+		id = zebrahookRotateWebhookEndpointSecretID
+	}
+	var token string
+	{
+		token = zebrahookRotateWebhookEndpointSecretToken
+	}
+	v := &zebrahook.RotateWebhookEndpointSecretPayload{
+		GracePeriodSecs: body.GracePeriodSecs,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildTestWebhookEndpointPayload builds the payload for the Zebrahook
+// testWebhookEndpoint endpoint from CLI flags.
+func BuildTestWebhookEndpointPayload(zebrahookTestWebhookEndpointBody string, zebrahookTestWebhookEndpointID string, zebrahookTestWebhookEndpointToken string) (*zebrahook.TestWebhookEndpointPayload, error) {
+	var err error
+	var body TestWebhookEndpointRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookTestWebhookEndpointBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"event_content\": {\n         \"sku\": \"002432800\"\n      },\n      \"event_type\": \"merchant-93842.order.shipped\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = zebrahookTestWebhookEndpointID
+	}
+	var token string
+	{
+		token = zebrahookTestWebhookEndpointToken
+	}
+	v := &zebrahook.TestWebhookEndpointPayload{
+		EventType: body.EventType,
+	}
+	if body.EventContent != nil {
+		v.EventContent = make(map[string]interface{}, len(body.EventContent))
+		for key, val := range body.EventContent {
+			tk := key
+			tv := val
+			v.EventContent[tk] = tv
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListAPIKeysPayload builds the payload for the Zebrahook listApiKeys
+// endpoint from CLI flags.
+func BuildListAPIKeysPayload(zebrahookListAPIKeysStatus string, zebrahookListAPIKeysToken string) (*zebrahook.ListAPIKeysPayload, error) {
+	var err error
+	var status *string
+	{
+		if zebrahookListAPIKeysStatus != "" {
+			status = &zebrahookListAPIKeysStatus
+			if !(*status == "enabled" || *status == "disabled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []interface{}{"enabled", "disabled"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookListAPIKeysToken
+	}
+	v := &zebrahook.ListAPIKeysPayload{}
+	v.Status = status
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetAPIKeyPayload builds the payload for the Zebrahook getApiKey
+// endpoint from CLI flags.
+func BuildGetAPIKeyPayload(zebrahookGetAPIKeyID string, zebrahookGetAPIKeyToken string) (*zebrahook.GetAPIKeyPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookGetAPIKeyID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookGetAPIKeyToken
+	}
+	v := &zebrahook.GetAPIKeyPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateAPIKeyPayload builds the payload for the Zebrahook updateApiKey
+// endpoint from CLI flags.
+func BuildUpdateAPIKeyPayload(zebrahookUpdateAPIKeyBody string, zebrahookUpdateAPIKeyID string, zebrahookUpdateAPIKeyToken string) (*zebrahook.UpdateAPIKeyPayload, error) {
+	var err error
+	var body UpdateAPIKeyRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookUpdateAPIKeyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"orders service\",\n      \"expiresAt\": 1677814413,\n      \"scopes\": [\n         \"events:write\"\n      ]\n   }'")
+		}
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 1, true))
+			}
+		}
+		if body.ExpiresAt != nil {
+			if *body.ExpiresAt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiresAt", *body.ExpiresAt, 0, true))
+			}
+		}
+		if len(body.Scopes) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scopes", body.Scopes, len(body.Scopes), 1, true))
+		}
+		for _, e := range body.Scopes {
+			if !(e == "*" || e == "events:read" || e == "events:write" || e == "endpoints:read" || e == "endpoints:write" || e == "secrets:read" || e == "admin") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scopes[*]", e, []interface{}{"*", "events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookUpdateAPIKeyID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookUpdateAPIKeyToken
+	}
+	v := &zebrahook.UpdateAPIKeyPayload{
+		Description: body.Description,
+		ExpiresAt:   body.ExpiresAt,
+	}
+	if body.Scopes != nil {
+		v.Scopes = make([]string, len(body.Scopes))
+		for i, val := range body.Scopes {
+			v.Scopes[i] = val
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeAPIKeyPayload builds the payload for the Zebrahook revokeApiKey
+// endpoint from CLI flags.
+func BuildRevokeAPIKeyPayload(zebrahookRevokeAPIKeyID string, zebrahookRevokeAPIKeyToken string) (*zebrahook.RevokeAPIKeyPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookRevokeAPIKeyID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookRevokeAPIKeyToken
+	}
+	v := &zebrahook.RevokeAPIKeyPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRegisterEventTypePayload builds the payload for the Zebrahook
+// registerEventType endpoint from CLI flags.
+func BuildRegisterEventTypePayload(zebrahookRegisterEventTypeBody string, zebrahookRegisterEventTypeToken string) (*zebrahook.RegisterEventTypePayload, error) {
+	var err error
+	var body RegisterEventTypeRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookRegisterEventTypeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deprecated\": false,\n      \"description\": \"An order was shipped to the customer\",\n      \"example\": {\n         \"Quasi sit sunt non et.\": \"Facilis temporibus nulla.\",\n         \"Voluptas non.\": \"Eveniet suscipit id aperiam voluptas quis amet.\"\n      },\n      \"name\": \"order.shipped\",\n      \"schema\": {\n         \"Est rerum dolorum.\": \"Voluptate nulla placeat.\",\n         \"Quia architecto voluptatem et.\": \"Optio dolorum.\"\n      }\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = zebrahookRegisterEventTypeToken
+	}
+	v := &zebrahook.RegisterEventTypePayload{
+		Name:        body.Name,
+		Description: body.Description,
+		Deprecated:  body.Deprecated,
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+	{
+		var zero bool
+		if v.Deprecated == zero {
+			v.Deprecated = false
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListEventTypesPayload builds the payload for the Zebrahook
+// listEventTypes endpoint from CLI flags.
+func BuildListEventTypesPayload(zebrahookListEventTypesDeprecated string, zebrahookListEventTypesToken string) (*zebrahook.ListEventTypesPayload, error) {
+	var err error
+	var deprecated *bool
+	{
+		if zebrahookListEventTypesDeprecated != "" {
+			var val bool
+			val, err = strconv.ParseBool(zebrahookListEventTypesDeprecated)
+			deprecated = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for deprecated, must be BOOL")
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookListEventTypesToken
+	}
+	v := &zebrahook.ListEventTypesPayload{}
+	v.Deprecated = deprecated
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetEventTypePayload builds the payload for the Zebrahook getEventType
+// endpoint from CLI flags.
+func BuildGetEventTypePayload(zebrahookGetEventTypeName string, zebrahookGetEventTypeToken string) (*zebrahook.GetEventTypePayload, error) {
+	var name string
+	{
+		name = zebrahookGetEventTypeName
+	}
+	var token string
+	{
+		token = zebrahookGetEventTypeToken
+	}
+	v := &zebrahook.GetEventTypePayload{}
+	v.Name = name
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateEventTypePayload builds the payload for the Zebrahook
+// updateEventType endpoint from CLI flags.
+func BuildUpdateEventTypePayload(zebrahookUpdateEventTypeBody string, zebrahookUpdateEventTypeName string, zebrahookUpdateEventTypeToken string) (*zebrahook.UpdateEventTypePayload, error) {
+	var err error
+	var body UpdateEventTypeRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookUpdateEventTypeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deprecated\": false,\n      \"description\": \"An order was shipped to the customer\",\n      \"example\": {\n         \"Dignissimos consectetur odio voluptate ea recusandae doloremque.\": \"Sequi nemo natus.\"\n      },\n      \"schema\": {\n         \"Tempora nobis iure perspiciatis inventore dolores non.\": \"Explicabo quia.\"\n      }\n   }'")
+		}
+	}
+	var name string
+	{
+		name = zebrahookUpdateEventTypeName
+	}
+	var token string
+	{
+		token = zebrahookUpdateEventTypeToken
+	}
+	v := &zebrahook.UpdateEventTypePayload{
+		Description: body.Description,
+		Deprecated:  body.Deprecated,
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+	v.Name = name
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetEventTypesCatalogPayload builds the payload for the Zebrahook
+// getEventTypesCatalog endpoint from CLI flags.
+func BuildGetEventTypesCatalogPayload(zebrahookGetEventTypesCatalogFormat string, zebrahookGetEventTypesCatalogToken string) (*zebrahook.GetEventTypesCatalogPayload, error) {
+	var err error
+	var format string
+	{
+		if zebrahookGetEventTypesCatalogFormat != "" {
+			format = zebrahookGetEventTypesCatalogFormat
+			if !(format == "asyncapi" || format == "markdown" || format == "html") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []interface{}{"asyncapi", "markdown", "html"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookGetEventTypesCatalogToken
+	}
+	v := &zebrahook.GetEventTypesCatalogPayload{}
+	v.Format = format
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetWebhookEndpointStatusHistoryPayload builds the payload for the
+// Zebrahook getWebhookEndpointStatusHistory endpoint from CLI flags.
+func BuildGetWebhookEndpointStatusHistoryPayload(zebrahookGetWebhookEndpointStatusHistoryID string, zebrahookGetWebhookEndpointStatusHistoryLimit string, zebrahookGetWebhookEndpointStatusHistoryStartingAfter string, zebrahookGetWebhookEndpointStatusHistoryToken string) (*zebrahook.GetWebhookEndpointStatusHistoryPayload, error) {
+	var err error
+	var id string
+	{
+		id = zebrahookGetWebhookEndpointStatusHistoryID
+	}
+	var limit int32
+	{
+		if zebrahookGetWebhookEndpointStatusHistoryLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(zebrahookGetWebhookEndpointStatusHistoryLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var startingAfter *uint
+	{
+		if zebrahookGetWebhookEndpointStatusHistoryStartingAfter != "" {
+			var v uint64
+			v, err = strconv.ParseUint(zebrahookGetWebhookEndpointStatusHistoryStartingAfter, 10, strconv.IntSize)
+			val := uint(v)
+			startingAfter = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startingAfter, must be UINT")
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookGetWebhookEndpointStatusHistoryToken
+	}
+	v := &zebrahook.GetWebhookEndpointStatusHistoryPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetWebhookEndpointStatsPayload builds the payload for the Zebrahook
+// getWebhookEndpointStats endpoint from CLI flags.
+func BuildGetWebhookEndpointStatsPayload(zebrahookGetWebhookEndpointStatsID string, zebrahookGetWebhookEndpointStatsWindow string, zebrahookGetWebhookEndpointStatsToken string) (*zebrahook.GetWebhookEndpointStatsPayload, error) {
+	var err error
+	var id string
+	{
+		id = zebrahookGetWebhookEndpointStatsID
+	}
+	var window string
+	{
+		if zebrahookGetWebhookEndpointStatsWindow != "" {
+			window = zebrahookGetWebhookEndpointStatsWindow
+			if !(window == "1h" || window == "24h" || window == "7d") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("window", window, []interface{}{"1h", "24h", "7d"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookGetWebhookEndpointStatsToken
+	}
+	v := &zebrahook.GetWebhookEndpointStatsPayload{}
+	v.ID = id
+	v.Window = window
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListDeadLettersPayload builds the payload for the Zebrahook
+// listDeadLetters endpoint from CLI flags.
+func BuildListDeadLettersPayload(zebrahookListDeadLettersLimit string, zebrahookListDeadLettersStartingAfter string, zebrahookListDeadLettersStatus string, zebrahookListDeadLettersEndpointID string, zebrahookListDeadLettersEventType string, zebrahookListDeadLettersCreatedAtGte string, zebrahookListDeadLettersCreatedAtLt string, zebrahookListDeadLettersToken string) (*zebrahook.ListDeadLettersPayload, error) {
+	var err error
+	var limit int32
+	{
+		if zebrahookListDeadLettersLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(zebrahookListDeadLettersLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var startingAfter *uint
+	{
+		if zebrahookListDeadLettersStartingAfter != "" {
+			var v uint64
+			v, err = strconv.ParseUint(zebrahookListDeadLettersStartingAfter, 10, strconv.IntSize)
+			val := uint(v)
+			startingAfter = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for startingAfter, must be UINT")
+			}
+		}
+	}
+	var status string
+	{
+		if zebrahookListDeadLettersStatus != "" {
+			status = zebrahookListDeadLettersStatus
+			if !(status == "dead" || status == "replayed") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", status, []interface{}{"dead", "replayed"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var endpointID *string
+	{
+		if zebrahookListDeadLettersEndpointID != "" {
+			endpointID = &zebrahookListDeadLettersEndpointID
+		}
+	}
+	var eventType *string
+	{
+		if zebrahookListDeadLettersEventType != "" {
+			eventType = &zebrahookListDeadLettersEventType
+		}
+	}
+	var createdAtGte *uint64
+	{
+		if zebrahookListDeadLettersCreatedAtGte != "" {
+			val, err := strconv.ParseUint(zebrahookListDeadLettersCreatedAtGte, 10, 64)
+			createdAtGte = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdAtGte, must be UINT64")
+			}
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var createdAtLt *uint64
+	{
+		if zebrahookListDeadLettersCreatedAtLt != "" {
+			val, err := strconv.ParseUint(zebrahookListDeadLettersCreatedAtLt, 10, 64)
+			createdAtLt = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdAtLt, must be UINT64")
+			}
+			if *createdAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtLt", *createdAtLt, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = zebrahookListDeadLettersToken
+	}
+	v := &zebrahook.ListDeadLettersPayload{}
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.Status = status
+	v.EndpointID = endpointID
+	v.EventType = eventType
+	v.CreatedAtGte = createdAtGte
+	v.CreatedAtLt = createdAtLt
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetDeadLetterPayload builds the payload for the Zebrahook getDeadLetter
+// endpoint from CLI flags.
+func BuildGetDeadLetterPayload(zebrahookGetDeadLetterID string, zebrahookGetDeadLetterToken string) (*zebrahook.GetDeadLetterPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookGetDeadLetterID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookGetDeadLetterToken
+	}
+	v := &zebrahook.GetDeadLetterPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildReplayDeadLetterPayload builds the payload for the Zebrahook
+// replayDeadLetter endpoint from CLI flags.
+func BuildReplayDeadLetterPayload(zebrahookReplayDeadLetterID string, zebrahookReplayDeadLetterToken string) (*zebrahook.ReplayDeadLetterPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookReplayDeadLetterID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookReplayDeadLetterToken
+	}
+	v := &zebrahook.ReplayDeadLetterPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildReplayDeadLettersPayload builds the payload for the Zebrahook
+// replayDeadLetters endpoint from CLI flags.
+func BuildReplayDeadLettersPayload(zebrahookReplayDeadLettersBody string, zebrahookReplayDeadLettersToken string) (*zebrahook.ReplayDeadLettersPayload, error) {
+	var err error
+	var body ReplayDeadLettersRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookReplayDeadLettersBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"createdAt.gte\": 1646278413,\n      \"createdAt.lt\": 1646369084,\n      \"endpoint_id\": \"zhwe_c9ddsgbei1cst46tglh0\",\n      \"event_type\": \"merchant-93842.order.shipped\",\n      \"ids\": [\n         128,\n         129\n      ],\n      \"limit\": 100\n   }'")
+		}
+		if len(body.Ids) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.ids", body.Ids, len(body.Ids), 500, false))
+		}
+		if body.CreatedAtGte != nil {
+			if *body.CreatedAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.createdAt.gte", *body.CreatedAtGte, 0, true))
+			}
+		}
+		if body.CreatedAtLt != nil {
+			if *body.CreatedAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.createdAt.lt", *body.CreatedAtLt, 0, true))
+			}
+		}
+		if body.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", body.Limit, 1, true))
+		}
+		if body.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", body.Limit, 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = zebrahookReplayDeadLettersToken
+	}
+	v := &zebrahook.ReplayDeadLettersPayload{
+		EndpointID:   body.EndpointID,
+		EventType:    body.EventType,
+		CreatedAtGte: body.CreatedAtGte,
+		CreatedAtLt:  body.CreatedAtLt,
+		Limit:        body.Limit,
+	}
+	if body.Ids != nil {
+		v.Ids = make([]uint, len(body.Ids))
+		for i, val := range body.Ids {
+			v.Ids[i] = val
+		}
+	}
+	{
+		var zero int32
+		if v.Limit == zero {
+			v.Limit = 100
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteDeadLetterPayload builds the payload for the Zebrahook
+// deleteDeadLetter endpoint from CLI flags.
+func BuildDeleteDeadLetterPayload(zebrahookDeleteDeadLetterID string, zebrahookDeleteDeadLetterToken string) (*zebrahook.DeleteDeadLetterPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(zebrahookDeleteDeadLetterID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = zebrahookDeleteDeadLetterToken
+	}
+	v := &zebrahook.DeleteDeadLetterPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPurgeDeadLettersPayload builds the payload for the Zebrahook
+// purgeDeadLetters endpoint from CLI flags.
+func BuildPurgeDeadLettersPayload(zebrahookPurgeDeadLettersBody string, zebrahookPurgeDeadLettersToken string) (*zebrahook.PurgeDeadLettersPayload, error) {
+	var err error
+	var body PurgeDeadLettersRequestBody
+	{
+		err = json.Unmarshal([]byte(zebrahookPurgeDeadLettersBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"createdAt.lt\": 1646369084,\n      \"endpoint_id\": \"zhwe_c9ddsgbei1cst46tglh0\",\n      \"event_type\": \"merchant-93842.order.shipped\",\n      \"status\": \"replayed\"\n   }'")
+		}
+		if body.Status != nil {
+			if !(*body.Status == "dead" || *body.Status == "replayed") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"dead", "replayed"}))
+			}
+		}
+		if body.CreatedAtLt != nil {
+			if *body.CreatedAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.createdAt.lt", *body.CreatedAtLt, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = zebrahookPurgeDeadLettersToken
+	}
+	v := &zebrahook.PurgeDeadLettersPayload{
+		Status:      body.Status,
+		EndpointID:  body.EndpointID,
+		EventType:   body.EventType,
+		CreatedAtLt: body.CreatedAtLt,
+	}
+	v.Token = token
+
+	return v, nil
+}
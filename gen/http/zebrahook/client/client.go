@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook client HTTP transport
 //
@@ -10,6 +10,7 @@ package client
 import (
 	"context"
 	"net/http"
+	zebrahook "zebrahook/gen/zebrahook"
 
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -36,6 +37,110 @@ type Client struct {
 	// getWebhookEndpointById endpoint.
 	GetWebhookEndpointByIDDoer goahttp.Doer
 
+	// DeleteWebhookEndpoint Doer is the HTTP client used to make requests to the
+	// deleteWebhookEndpoint endpoint.
+	DeleteWebhookEndpointDoer goahttp.Doer
+
+	// ListEvents Doer is the HTTP client used to make requests to the listEvents
+	// endpoint.
+	ListEventsDoer goahttp.Doer
+
+	// GetEventByID Doer is the HTTP client used to make requests to the
+	// getEventById endpoint.
+	GetEventByIDDoer goahttp.Doer
+
+	// ListWebhookEndpointAttempts Doer is the HTTP client used to make requests to
+	// the listWebhookEndpointAttempts endpoint.
+	ListWebhookEndpointAttemptsDoer goahttp.Doer
+
+	// RetryEventDelivery Doer is the HTTP client used to make requests to the
+	// retryEventDelivery endpoint.
+	RetryEventDeliveryDoer goahttp.Doer
+
+	// RecoverWebhookEndpoint Doer is the HTTP client used to make requests to the
+	// recoverWebhookEndpoint endpoint.
+	RecoverWebhookEndpointDoer goahttp.Doer
+
+	// GetWebhookEndpointRecovery Doer is the HTTP client used to make requests to
+	// the getWebhookEndpointRecovery endpoint.
+	GetWebhookEndpointRecoveryDoer goahttp.Doer
+
+	// RotateWebhookEndpointSecret Doer is the HTTP client used to make requests to
+	// the rotateWebhookEndpointSecret endpoint.
+	RotateWebhookEndpointSecretDoer goahttp.Doer
+
+	// TestWebhookEndpoint Doer is the HTTP client used to make requests to the
+	// testWebhookEndpoint endpoint.
+	TestWebhookEndpointDoer goahttp.Doer
+
+	// ListAPIKeys Doer is the HTTP client used to make requests to the listApiKeys
+	// endpoint.
+	ListAPIKeysDoer goahttp.Doer
+
+	// GetAPIKey Doer is the HTTP client used to make requests to the getApiKey
+	// endpoint.
+	GetAPIKeyDoer goahttp.Doer
+
+	// UpdateAPIKey Doer is the HTTP client used to make requests to the
+	// updateApiKey endpoint.
+	UpdateAPIKeyDoer goahttp.Doer
+
+	// RevokeAPIKey Doer is the HTTP client used to make requests to the
+	// revokeApiKey endpoint.
+	RevokeAPIKeyDoer goahttp.Doer
+
+	// RegisterEventType Doer is the HTTP client used to make requests to the
+	// registerEventType endpoint.
+	RegisterEventTypeDoer goahttp.Doer
+
+	// ListEventTypes Doer is the HTTP client used to make requests to the
+	// listEventTypes endpoint.
+	ListEventTypesDoer goahttp.Doer
+
+	// GetEventType Doer is the HTTP client used to make requests to the
+	// getEventType endpoint.
+	GetEventTypeDoer goahttp.Doer
+
+	// UpdateEventType Doer is the HTTP client used to make requests to the
+	// updateEventType endpoint.
+	UpdateEventTypeDoer goahttp.Doer
+
+	// GetEventTypesCatalog Doer is the HTTP client used to make requests to the
+	// getEventTypesCatalog endpoint.
+	GetEventTypesCatalogDoer goahttp.Doer
+
+	// GetWebhookEndpointStatusHistory Doer is the HTTP client used to make
+	// requests to the getWebhookEndpointStatusHistory endpoint.
+	GetWebhookEndpointStatusHistoryDoer goahttp.Doer
+
+	// GetWebhookEndpointStats Doer is the HTTP client used to make requests to the
+	// getWebhookEndpointStats endpoint.
+	GetWebhookEndpointStatsDoer goahttp.Doer
+
+	// ListDeadLetters Doer is the HTTP client used to make requests to the
+	// listDeadLetters endpoint.
+	ListDeadLettersDoer goahttp.Doer
+
+	// GetDeadLetter Doer is the HTTP client used to make requests to the
+	// getDeadLetter endpoint.
+	GetDeadLetterDoer goahttp.Doer
+
+	// ReplayDeadLetter Doer is the HTTP client used to make requests to the
+	// replayDeadLetter endpoint.
+	ReplayDeadLetterDoer goahttp.Doer
+
+	// ReplayDeadLetters Doer is the HTTP client used to make requests to the
+	// replayDeadLetters endpoint.
+	ReplayDeadLettersDoer goahttp.Doer
+
+	// DeleteDeadLetter Doer is the HTTP client used to make requests to the
+	// deleteDeadLetter endpoint.
+	DeleteDeadLetterDoer goahttp.Doer
+
+	// PurgeDeadLetters Doer is the HTTP client used to make requests to the
+	// purgeDeadLetters endpoint.
+	PurgeDeadLettersDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -56,16 +161,42 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		SubmitNewEventsDoer:        doer,
-		RegisterDoer:               doer,
-		UpdateDoer:                 doer,
-		ListWebhookEndpointDoer:    doer,
-		GetWebhookEndpointByIDDoer: doer,
-		RestoreResponseBody:        restoreBody,
-		scheme:                     scheme,
-		host:                       host,
-		decoder:                    dec,
-		encoder:                    enc,
+		SubmitNewEventsDoer:                 doer,
+		RegisterDoer:                        doer,
+		UpdateDoer:                          doer,
+		ListWebhookEndpointDoer:             doer,
+		GetWebhookEndpointByIDDoer:          doer,
+		DeleteWebhookEndpointDoer:           doer,
+		ListEventsDoer:                      doer,
+		GetEventByIDDoer:                    doer,
+		ListWebhookEndpointAttemptsDoer:     doer,
+		RetryEventDeliveryDoer:              doer,
+		RecoverWebhookEndpointDoer:          doer,
+		GetWebhookEndpointRecoveryDoer:      doer,
+		RotateWebhookEndpointSecretDoer:     doer,
+		TestWebhookEndpointDoer:             doer,
+		ListAPIKeysDoer:                     doer,
+		GetAPIKeyDoer:                       doer,
+		UpdateAPIKeyDoer:                    doer,
+		RevokeAPIKeyDoer:                    doer,
+		RegisterEventTypeDoer:               doer,
+		ListEventTypesDoer:                  doer,
+		GetEventTypeDoer:                    doer,
+		UpdateEventTypeDoer:                 doer,
+		GetEventTypesCatalogDoer:            doer,
+		GetWebhookEndpointStatusHistoryDoer: doer,
+		GetWebhookEndpointStatsDoer:         doer,
+		ListDeadLettersDoer:                 doer,
+		GetDeadLetterDoer:                   doer,
+		ReplayDeadLetterDoer:                doer,
+		ReplayDeadLettersDoer:               doer,
+		DeleteDeadLetterDoer:                doer,
+		PurgeDeadLettersDoer:                doer,
+		RestoreResponseBody:                 restoreBody,
+		scheme:                              scheme,
+		host:                                host,
+		decoder:                             dec,
+		encoder:                             enc,
 	}
 }
 
@@ -188,3 +319,632 @@ func (c *Client) GetWebhookEndpointByID() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// DeleteWebhookEndpoint returns an endpoint that makes HTTP requests to the
+// Zebrahook service deleteWebhookEndpoint server.
+func (c *Client) DeleteWebhookEndpoint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteWebhookEndpointRequest(c.encoder)
+		decodeResponse = DecodeDeleteWebhookEndpointResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildDeleteWebhookEndpointRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookEndpointDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "deleteWebhookEndpoint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListEvents returns an endpoint that makes HTTP requests to the Zebrahook
+// service listEvents server.
+func (c *Client) ListEvents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListEventsRequest(c.encoder)
+		decodeResponse = DecodeListEventsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildListEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "listEvents", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetEventByID returns an endpoint that makes HTTP requests to the Zebrahook
+// service getEventById server.
+func (c *Client) GetEventByID() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetEventByIDRequest(c.encoder)
+		decodeResponse = DecodeGetEventByIDResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetEventByIDRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetEventByIDDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getEventById", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookEndpointAttempts returns an endpoint that makes HTTP requests to
+// the Zebrahook service listWebhookEndpointAttempts server.
+func (c *Client) ListWebhookEndpointAttempts() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookEndpointAttemptsRequest(c.encoder)
+		decodeResponse = DecodeListWebhookEndpointAttemptsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildListWebhookEndpointAttemptsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookEndpointAttemptsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "listWebhookEndpointAttempts", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RetryEventDelivery returns an endpoint that makes HTTP requests to the
+// Zebrahook service retryEventDelivery server.
+func (c *Client) RetryEventDelivery() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRetryEventDeliveryRequest(c.encoder)
+		decodeResponse = DecodeRetryEventDeliveryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildRetryEventDeliveryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RetryEventDeliveryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "retryEventDelivery", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RecoverWebhookEndpoint returns an endpoint that makes HTTP requests to the
+// Zebrahook service recoverWebhookEndpoint server.
+func (c *Client) RecoverWebhookEndpoint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRecoverWebhookEndpointRequest(c.encoder)
+		decodeResponse = DecodeRecoverWebhookEndpointResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildRecoverWebhookEndpointRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RecoverWebhookEndpointDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "recoverWebhookEndpoint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWebhookEndpointRecovery returns an endpoint that makes HTTP requests to
+// the Zebrahook service getWebhookEndpointRecovery server.
+func (c *Client) GetWebhookEndpointRecovery() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWebhookEndpointRecoveryRequest(c.encoder)
+		decodeResponse = DecodeGetWebhookEndpointRecoveryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetWebhookEndpointRecoveryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWebhookEndpointRecoveryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getWebhookEndpointRecovery", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RotateWebhookEndpointSecret returns an endpoint that makes HTTP requests to
+// the Zebrahook service rotateWebhookEndpointSecret server.
+func (c *Client) RotateWebhookEndpointSecret() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRotateWebhookEndpointSecretRequest(c.encoder)
+		decodeResponse = DecodeRotateWebhookEndpointSecretResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildRotateWebhookEndpointSecretRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RotateWebhookEndpointSecretDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "rotateWebhookEndpointSecret", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// TestWebhookEndpoint returns an endpoint that makes HTTP requests to the
+// Zebrahook service testWebhookEndpoint server.
+func (c *Client) TestWebhookEndpoint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTestWebhookEndpointRequest(c.encoder)
+		decodeResponse = DecodeTestWebhookEndpointResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildTestWebhookEndpointRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TestWebhookEndpointDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "testWebhookEndpoint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAPIKeys returns an endpoint that makes HTTP requests to the Zebrahook
+// service listApiKeys server.
+func (c *Client) ListAPIKeys() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAPIKeysRequest(c.encoder)
+		decodeResponse = DecodeListAPIKeysResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildListAPIKeysRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAPIKeysDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "listApiKeys", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAPIKey returns an endpoint that makes HTTP requests to the Zebrahook
+// service getApiKey server.
+func (c *Client) GetAPIKey() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAPIKeyRequest(c.encoder)
+		decodeResponse = DecodeGetAPIKeyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetAPIKeyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAPIKeyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getApiKey", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateAPIKey returns an endpoint that makes HTTP requests to the Zebrahook
+// service updateApiKey server.
+func (c *Client) UpdateAPIKey() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateAPIKeyRequest(c.encoder)
+		decodeResponse = DecodeUpdateAPIKeyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildUpdateAPIKeyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateAPIKeyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "updateApiKey", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeAPIKey returns an endpoint that makes HTTP requests to the Zebrahook
+// service revokeApiKey server.
+func (c *Client) RevokeAPIKey() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeAPIKeyRequest(c.encoder)
+		decodeResponse = DecodeRevokeAPIKeyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildRevokeAPIKeyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeAPIKeyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "revokeApiKey", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RegisterEventType returns an endpoint that makes HTTP requests to the
+// Zebrahook service registerEventType server.
+func (c *Client) RegisterEventType() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRegisterEventTypeRequest(c.encoder)
+		decodeResponse = DecodeRegisterEventTypeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildRegisterEventTypeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RegisterEventTypeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "registerEventType", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListEventTypes returns an endpoint that makes HTTP requests to the Zebrahook
+// service listEventTypes server.
+func (c *Client) ListEventTypes() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListEventTypesRequest(c.encoder)
+		decodeResponse = DecodeListEventTypesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildListEventTypesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListEventTypesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "listEventTypes", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetEventType returns an endpoint that makes HTTP requests to the Zebrahook
+// service getEventType server.
+func (c *Client) GetEventType() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetEventTypeRequest(c.encoder)
+		decodeResponse = DecodeGetEventTypeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetEventTypeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetEventTypeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getEventType", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateEventType returns an endpoint that makes HTTP requests to the
+// Zebrahook service updateEventType server.
+func (c *Client) UpdateEventType() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateEventTypeRequest(c.encoder)
+		decodeResponse = DecodeUpdateEventTypeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildUpdateEventTypeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateEventTypeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "updateEventType", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetEventTypesCatalog returns an endpoint that makes HTTP requests to the
+// Zebrahook service getEventTypesCatalog server.
+func (c *Client) GetEventTypesCatalog() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetEventTypesCatalogRequest(c.encoder)
+		decodeResponse = DecodeGetEventTypesCatalogResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetEventTypesCatalogRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetEventTypesCatalogDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getEventTypesCatalog", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &zebrahook.GetEventTypesCatalogResponseData{Result: res.(*zebrahook.GetEventTypesCatalogResult), Body: resp.Body}, nil
+	}
+}
+
+// GetWebhookEndpointStatusHistory returns an endpoint that makes HTTP requests
+// to the Zebrahook service getWebhookEndpointStatusHistory server.
+func (c *Client) GetWebhookEndpointStatusHistory() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWebhookEndpointStatusHistoryRequest(c.encoder)
+		decodeResponse = DecodeGetWebhookEndpointStatusHistoryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetWebhookEndpointStatusHistoryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWebhookEndpointStatusHistoryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getWebhookEndpointStatusHistory", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetWebhookEndpointStats returns an endpoint that makes HTTP requests to the
+// Zebrahook service getWebhookEndpointStats server.
+func (c *Client) GetWebhookEndpointStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetWebhookEndpointStatsRequest(c.encoder)
+		decodeResponse = DecodeGetWebhookEndpointStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetWebhookEndpointStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetWebhookEndpointStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getWebhookEndpointStats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListDeadLetters returns an endpoint that makes HTTP requests to the
+// Zebrahook service listDeadLetters server.
+func (c *Client) ListDeadLetters() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListDeadLettersRequest(c.encoder)
+		decodeResponse = DecodeListDeadLettersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildListDeadLettersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDeadLettersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "listDeadLetters", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetDeadLetter returns an endpoint that makes HTTP requests to the Zebrahook
+// service getDeadLetter server.
+func (c *Client) GetDeadLetter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetDeadLetterRequest(c.encoder)
+		decodeResponse = DecodeGetDeadLetterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildGetDeadLetterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDeadLetterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "getDeadLetter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReplayDeadLetter returns an endpoint that makes HTTP requests to the
+// Zebrahook service replayDeadLetter server.
+func (c *Client) ReplayDeadLetter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReplayDeadLetterRequest(c.encoder)
+		decodeResponse = DecodeReplayDeadLetterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildReplayDeadLetterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReplayDeadLetterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "replayDeadLetter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReplayDeadLetters returns an endpoint that makes HTTP requests to the
+// Zebrahook service replayDeadLetters server.
+func (c *Client) ReplayDeadLetters() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReplayDeadLettersRequest(c.encoder)
+		decodeResponse = DecodeReplayDeadLettersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildReplayDeadLettersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReplayDeadLettersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "replayDeadLetters", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteDeadLetter returns an endpoint that makes HTTP requests to the
+// Zebrahook service deleteDeadLetter server.
+func (c *Client) DeleteDeadLetter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteDeadLetterRequest(c.encoder)
+		decodeResponse = DecodeDeleteDeadLetterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildDeleteDeadLetterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteDeadLetterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "deleteDeadLetter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PurgeDeadLetters returns an endpoint that makes HTTP requests to the
+// Zebrahook service purgeDeadLetters server.
+func (c *Client) PurgeDeadLetters() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePurgeDeadLettersRequest(c.encoder)
+		decodeResponse = DecodePurgeDeadLettersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v interface{}) (interface{}, error) {
+		req, err := c.BuildPurgeDeadLettersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PurgeDeadLettersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("Zebrahook", "purgeDeadLetters", err)
+		}
+		return decodeResponse(resp)
+	}
+}
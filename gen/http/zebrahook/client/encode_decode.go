@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP client encoders and decoders
 //
@@ -18,6 +18,7 @@ import (
 	zebrahook "zebrahook/gen/zebrahook"
 
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildSubmitNewEventsRequest instantiates a HTTP request object with method
@@ -43,6 +44,10 @@ func EncodeSubmitNewEventsRequest(encoder func(*http.Request) goahttp.Encoder) f
 		if !ok {
 			return goahttp.ErrInvalidType("Zebrahook", "submitNewEvents", "*zebrahook.SubmitNewEventsPayload", v)
 		}
+		if p.IdempotencyKey != nil {
+			head := *p.IdempotencyKey
+			req.Header.Set("Idempotency-Key", head)
+		}
 		{
 			head := p.Token
 			if !strings.Contains(head, " ") {
@@ -86,6 +91,10 @@ func DecodeSubmitNewEventsResponse(decoder func(*http.Response) goahttp.Decoder,
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("Zebrahook", "submitNewEvents", err)
 			}
+			err = ValidateSubmitNewEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "submitNewEvents", err)
+			}
 			res := NewSubmitNewEventsResultOK(&body)
 			return res, nil
 		default:
@@ -436,66 +445,2576 @@ func DecodeGetWebhookEndpointByIDResponse(decoder func(*http.Response) goahttp.D
 	}
 }
 
-// marshalZebrahookEventRequestToEventRequestRequestBody builds a value of type
-// *EventRequestRequestBody from a value of type *zebrahook.EventRequest.
-func marshalZebrahookEventRequestToEventRequestRequestBody(v *zebrahook.EventRequest) *EventRequestRequestBody {
-	res := &EventRequestRequestBody{
-		EventType: v.EventType,
-		Priority:  v.Priority,
+// BuildDeleteWebhookEndpointRequest instantiates a HTTP request object with
+// method and path set to call the "Zebrahook" service "deleteWebhookEndpoint"
+// endpoint
+func (c *Client) BuildDeleteWebhookEndpointRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.DeleteWebhookEndpointPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "deleteWebhookEndpoint", "*zebrahook.DeleteWebhookEndpointPayload", v)
+		}
+		id = p.ID
 	}
-	if v.EventContent != nil {
-		res.EventContent = make(map[string]interface{}, len(v.EventContent))
-		for key, val := range v.EventContent {
-			tk := key
-			tv := val
-			res.EventContent[tk] = tv
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookEndpointZebrahookPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "deleteWebhookEndpoint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteWebhookEndpointRequest returns an encoder for requests sent to
+// the Zebrahook deleteWebhookEndpoint server.
+func EncodeDeleteWebhookEndpointRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.DeleteWebhookEndpointPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "deleteWebhookEndpoint", "*zebrahook.DeleteWebhookEndpointPayload", v)
 		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
 	}
+}
 
-	return res
+// DecodeDeleteWebhookEndpointResponse returns a decoder for responses returned
+// by the Zebrahook deleteWebhookEndpoint endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+func DecodeDeleteWebhookEndpointResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteWebhookEndpointResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "deleteWebhookEndpoint", err)
+			}
+			res := NewDeleteWebhookEndpointResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "deleteWebhookEndpoint", resp.StatusCode, string(body))
+		}
+	}
 }
 
-// marshalEventRequestRequestBodyToZebrahookEventRequest builds a value of type
-// *zebrahook.EventRequest from a value of type *EventRequestRequestBody.
-func marshalEventRequestRequestBodyToZebrahookEventRequest(v *EventRequestRequestBody) *zebrahook.EventRequest {
-	res := &zebrahook.EventRequest{
-		EventType: v.EventType,
-		Priority:  v.Priority,
+// BuildListEventsRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "listEvents" endpoint
+func (c *Client) BuildListEventsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListEventsZebrahookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "listEvents", u.String(), err)
 	}
-	if v.EventContent != nil {
-		res.EventContent = make(map[string]interface{}, len(v.EventContent))
-		for key, val := range v.EventContent {
-			tk := key
-			tv := val
-			res.EventContent[tk] = tv
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListEventsRequest returns an encoder for requests sent to the
+// Zebrahook listEvents server.
+func EncodeListEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ListEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "listEvents", "*zebrahook.ListEventsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.StartingAfter != nil {
+			values.Add("starting_after", fmt.Sprintf("%v", *p.StartingAfter))
+		}
+		if p.EventType != nil {
+			values.Add("event_type", *p.EventType)
+		}
+		if p.Priority != nil {
+			values.Add("priority", fmt.Sprintf("%v", *p.Priority))
+		}
+		if p.CreatedAtGte != nil {
+			values.Add("createdAt.gte", fmt.Sprintf("%v", *p.CreatedAtGte))
 		}
+		if p.CreatedAtLt != nil {
+			values.Add("createdAt.lt", fmt.Sprintf("%v", *p.CreatedAtLt))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
 	}
+}
 
-	return res
+// DecodeListEventsResponse returns a decoder for responses returned by the
+// Zebrahook listEvents endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeListEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "listEvents", err)
+			}
+			err = ValidateListEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "listEvents", err)
+			}
+			res := NewListEventsResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "listEvents", resp.StatusCode, string(body))
+		}
+	}
 }
 
-// unmarshalWebhookEndpointWithoutSecretResponseBodyToZebrahookWebhookEndpointWithoutSecret
-// builds a value of type *zebrahook.WebhookEndpointWithoutSecret from a value
-// of type *WebhookEndpointWithoutSecretResponseBody.
-func unmarshalWebhookEndpointWithoutSecretResponseBodyToZebrahookWebhookEndpointWithoutSecret(v *WebhookEndpointWithoutSecretResponseBody) *zebrahook.WebhookEndpointWithoutSecret {
-	res := &zebrahook.WebhookEndpointWithoutSecret{
-		CreatedAt: *v.CreatedAt,
-		UpdatedAt: *v.UpdatedAt,
-		Status:    v.Status,
-		URL:       *v.URL,
-		ID:        *v.ID,
+// BuildGetEventByIDRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "getEventById" endpoint
+func (c *Client) BuildGetEventByIDRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.GetEventByIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getEventById", "*zebrahook.GetEventByIDPayload", v)
+		}
+		id = p.ID
 	}
-	res.EnabledEvents = make([]string, len(v.EnabledEvents))
-	for i, val := range v.EnabledEvents {
-		res.EnabledEvents[i] = val
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetEventByIDZebrahookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getEventById", u.String(), err)
 	}
-	if v.Metadata != nil {
-		res.Metadata = make(map[string]string, len(v.Metadata))
-		for key, val := range v.Metadata {
-			tk := key
-			tv := val
-			res.Metadata[tk] = tv
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetEventByIDRequest returns an encoder for requests sent to the
+// Zebrahook getEventById server.
+func EncodeGetEventByIDRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetEventByIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getEventById", "*zebrahook.GetEventByIDPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetEventByIDResponse returns a decoder for responses returned by the
+// Zebrahook getEventById endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeGetEventByIDResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetEventByIDResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getEventById", err)
+			}
+			err = ValidateGetEventByIDResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getEventById", err)
+			}
+			res := NewGetEventByIDEventWithDeliveriesOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getEventById", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookEndpointAttemptsRequest instantiates a HTTP request object
+// with method and path set to call the "Zebrahook" service
+// "listWebhookEndpointAttempts" endpoint
+func (c *Client) BuildListWebhookEndpointAttemptsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.ListWebhookEndpointAttemptsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "listWebhookEndpointAttempts", "*zebrahook.ListWebhookEndpointAttemptsPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookEndpointAttemptsZebrahookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "listWebhookEndpointAttempts", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookEndpointAttemptsRequest returns an encoder for requests
+// sent to the Zebrahook listWebhookEndpointAttempts server.
+func EncodeListWebhookEndpointAttemptsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ListWebhookEndpointAttemptsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "listWebhookEndpointAttempts", "*zebrahook.ListWebhookEndpointAttemptsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.StartingAfter != nil {
+			values.Add("starting_after", fmt.Sprintf("%v", *p.StartingAfter))
+		}
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		if p.EventType != nil {
+			values.Add("event_type", *p.EventType)
 		}
+		if p.CreatedAtGte != nil {
+			values.Add("createdAt.gte", fmt.Sprintf("%v", *p.CreatedAtGte))
+		}
+		if p.CreatedAtLt != nil {
+			values.Add("createdAt.lt", fmt.Sprintf("%v", *p.CreatedAtLt))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListWebhookEndpointAttemptsResponse returns a decoder for responses
+// returned by the Zebrahook listWebhookEndpointAttempts endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+func DecodeListWebhookEndpointAttemptsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookEndpointAttemptsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "listWebhookEndpointAttempts", err)
+			}
+			err = ValidateListWebhookEndpointAttemptsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "listWebhookEndpointAttempts", err)
+			}
+			res := NewListWebhookEndpointAttemptsResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "listWebhookEndpointAttempts", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRetryEventDeliveryRequest instantiates a HTTP request object with
+// method and path set to call the "Zebrahook" service "retryEventDelivery"
+// endpoint
+func (c *Client) BuildRetryEventDeliveryRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id         uint
+		endpointID string
+	)
+	{
+		p, ok := v.(*zebrahook.RetryEventDeliveryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "retryEventDelivery", "*zebrahook.RetryEventDeliveryPayload", v)
+		}
+		id = p.ID
+		endpointID = p.EndpointID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RetryEventDeliveryZebrahookPath(id, endpointID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "retryEventDelivery", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRetryEventDeliveryRequest returns an encoder for requests sent to the
+// Zebrahook retryEventDelivery server.
+func EncodeRetryEventDeliveryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.RetryEventDeliveryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "retryEventDelivery", "*zebrahook.RetryEventDeliveryPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRetryEventDeliveryResponse returns a decoder for responses returned by
+// the Zebrahook retryEventDelivery endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeRetryEventDeliveryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RetryEventDeliveryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "retryEventDelivery", err)
+			}
+			err = ValidateRetryEventDeliveryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "retryEventDelivery", err)
+			}
+			res := NewRetryEventDeliveryEventDeliveryOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "retryEventDelivery", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRecoverWebhookEndpointRequest instantiates a HTTP request object with
+// method and path set to call the "Zebrahook" service "recoverWebhookEndpoint"
+// endpoint
+func (c *Client) BuildRecoverWebhookEndpointRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.RecoverWebhookEndpointPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "recoverWebhookEndpoint", "*zebrahook.RecoverWebhookEndpointPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RecoverWebhookEndpointZebrahookPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "recoverWebhookEndpoint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRecoverWebhookEndpointRequest returns an encoder for requests sent to
+// the Zebrahook recoverWebhookEndpoint server.
+func EncodeRecoverWebhookEndpointRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.RecoverWebhookEndpointPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "recoverWebhookEndpoint", "*zebrahook.RecoverWebhookEndpointPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRecoverWebhookEndpointRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "recoverWebhookEndpoint", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRecoverWebhookEndpointResponse returns a decoder for responses
+// returned by the Zebrahook recoverWebhookEndpoint endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+func DecodeRecoverWebhookEndpointResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RecoverWebhookEndpointResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "recoverWebhookEndpoint", err)
+			}
+			err = ValidateRecoverWebhookEndpointResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "recoverWebhookEndpoint", err)
+			}
+			res := NewRecoverWebhookEndpointEndpointRecoveryOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "recoverWebhookEndpoint", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWebhookEndpointRecoveryRequest instantiates a HTTP request object
+// with method and path set to call the "Zebrahook" service
+// "getWebhookEndpointRecovery" endpoint
+func (c *Client) BuildGetWebhookEndpointRecoveryRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id         string
+		recoveryID string
+	)
+	{
+		p, ok := v.(*zebrahook.GetWebhookEndpointRecoveryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getWebhookEndpointRecovery", "*zebrahook.GetWebhookEndpointRecoveryPayload", v)
+		}
+		id = p.ID
+		recoveryID = p.RecoveryID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWebhookEndpointRecoveryZebrahookPath(id, recoveryID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getWebhookEndpointRecovery", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWebhookEndpointRecoveryRequest returns an encoder for requests sent
+// to the Zebrahook getWebhookEndpointRecovery server.
+func EncodeGetWebhookEndpointRecoveryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetWebhookEndpointRecoveryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getWebhookEndpointRecovery", "*zebrahook.GetWebhookEndpointRecoveryPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetWebhookEndpointRecoveryResponse returns a decoder for responses
+// returned by the Zebrahook getWebhookEndpointRecovery endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+func DecodeGetWebhookEndpointRecoveryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWebhookEndpointRecoveryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getWebhookEndpointRecovery", err)
+			}
+			err = ValidateGetWebhookEndpointRecoveryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getWebhookEndpointRecovery", err)
+			}
+			res := NewGetWebhookEndpointRecoveryEndpointRecoveryOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getWebhookEndpointRecovery", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRotateWebhookEndpointSecretRequest instantiates a HTTP request object
+// with method and path set to call the "Zebrahook" service
+// "rotateWebhookEndpointSecret" endpoint
+func (c *Client) BuildRotateWebhookEndpointSecretRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.RotateWebhookEndpointSecretPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "rotateWebhookEndpointSecret", "*zebrahook.RotateWebhookEndpointSecretPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RotateWebhookEndpointSecretZebrahookPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "rotateWebhookEndpointSecret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRotateWebhookEndpointSecretRequest returns an encoder for requests
+// sent to the Zebrahook rotateWebhookEndpointSecret server.
+func EncodeRotateWebhookEndpointSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.RotateWebhookEndpointSecretPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "rotateWebhookEndpointSecret", "*zebrahook.RotateWebhookEndpointSecretPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRotateWebhookEndpointSecretRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "rotateWebhookEndpointSecret", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRotateWebhookEndpointSecretResponse returns a decoder for responses
+// returned by the Zebrahook rotateWebhookEndpointSecret endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+func DecodeRotateWebhookEndpointSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RotateWebhookEndpointSecretResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "rotateWebhookEndpointSecret", err)
+			}
+			err = ValidateRotateWebhookEndpointSecretResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "rotateWebhookEndpointSecret", err)
+			}
+			res := NewRotateWebhookEndpointSecretResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "rotateWebhookEndpointSecret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTestWebhookEndpointRequest instantiates a HTTP request object with
+// method and path set to call the "Zebrahook" service "testWebhookEndpoint"
+// endpoint
+func (c *Client) BuildTestWebhookEndpointRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.TestWebhookEndpointPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "testWebhookEndpoint", "*zebrahook.TestWebhookEndpointPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TestWebhookEndpointZebrahookPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "testWebhookEndpoint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTestWebhookEndpointRequest returns an encoder for requests sent to the
+// Zebrahook testWebhookEndpoint server.
+func EncodeTestWebhookEndpointRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.TestWebhookEndpointPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "testWebhookEndpoint", "*zebrahook.TestWebhookEndpointPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewTestWebhookEndpointRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "testWebhookEndpoint", err)
+		}
+		return nil
+	}
+}
+
+// DecodeTestWebhookEndpointResponse returns a decoder for responses returned
+// by the Zebrahook testWebhookEndpoint endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+func DecodeTestWebhookEndpointResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body TestWebhookEndpointResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "testWebhookEndpoint", err)
+			}
+			err = ValidateTestWebhookEndpointResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "testWebhookEndpoint", err)
+			}
+			res := NewTestWebhookEndpointResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "testWebhookEndpoint", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAPIKeysRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "listApiKeys" endpoint
+func (c *Client) BuildListAPIKeysRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAPIKeysZebrahookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "listApiKeys", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAPIKeysRequest returns an encoder for requests sent to the
+// Zebrahook listApiKeys server.
+func EncodeListAPIKeysRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ListAPIKeysPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "listApiKeys", "*zebrahook.ListAPIKeysPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListAPIKeysResponse returns a decoder for responses returned by the
+// Zebrahook listApiKeys endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeListAPIKeysResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAPIKeysResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "listApiKeys", err)
+			}
+			err = ValidateListAPIKeysResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "listApiKeys", err)
+			}
+			res := NewListAPIKeysResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "listApiKeys", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAPIKeyRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "getApiKey" endpoint
+func (c *Client) BuildGetAPIKeyRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.GetAPIKeyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getApiKey", "*zebrahook.GetAPIKeyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAPIKeyZebrahookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getApiKey", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAPIKeyRequest returns an encoder for requests sent to the Zebrahook
+// getApiKey server.
+func EncodeGetAPIKeyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetAPIKeyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getApiKey", "*zebrahook.GetAPIKeyPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetAPIKeyResponse returns a decoder for responses returned by the
+// Zebrahook getApiKey endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeGetAPIKeyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAPIKeyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getApiKey", err)
+			}
+			err = ValidateGetAPIKeyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getApiKey", err)
+			}
+			res := NewGetAPIKeyAPIKeyOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getApiKey", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateAPIKeyRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "updateApiKey" endpoint
+func (c *Client) BuildUpdateAPIKeyRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.UpdateAPIKeyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "updateApiKey", "*zebrahook.UpdateAPIKeyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateAPIKeyZebrahookPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "updateApiKey", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateAPIKeyRequest returns an encoder for requests sent to the
+// Zebrahook updateApiKey server.
+func EncodeUpdateAPIKeyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.UpdateAPIKeyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "updateApiKey", "*zebrahook.UpdateAPIKeyPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateAPIKeyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "updateApiKey", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateAPIKeyResponse returns a decoder for responses returned by the
+// Zebrahook updateApiKey endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeUpdateAPIKeyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateAPIKeyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "updateApiKey", err)
+			}
+			err = ValidateUpdateAPIKeyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "updateApiKey", err)
+			}
+			res := NewUpdateAPIKeyAPIKeyOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "updateApiKey", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeAPIKeyRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "revokeApiKey" endpoint
+func (c *Client) BuildRevokeAPIKeyRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.RevokeAPIKeyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "revokeApiKey", "*zebrahook.RevokeAPIKeyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeAPIKeyZebrahookPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "revokeApiKey", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeAPIKeyRequest returns an encoder for requests sent to the
+// Zebrahook revokeApiKey server.
+func EncodeRevokeAPIKeyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.RevokeAPIKeyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "revokeApiKey", "*zebrahook.RevokeAPIKeyPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeAPIKeyResponse returns a decoder for responses returned by the
+// Zebrahook revokeApiKey endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeRevokeAPIKeyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RevokeAPIKeyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "revokeApiKey", err)
+			}
+			res := NewRevokeAPIKeyResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "revokeApiKey", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRegisterEventTypeRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "registerEventType" endpoint
+func (c *Client) BuildRegisterEventTypeRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RegisterEventTypeZebrahookPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "registerEventType", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRegisterEventTypeRequest returns an encoder for requests sent to the
+// Zebrahook registerEventType server.
+func EncodeRegisterEventTypeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.RegisterEventTypePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "registerEventType", "*zebrahook.RegisterEventTypePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRegisterEventTypeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "registerEventType", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRegisterEventTypeResponse returns a decoder for responses returned by
+// the Zebrahook registerEventType endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeRegisterEventTypeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RegisterEventTypeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "registerEventType", err)
+			}
+			err = ValidateRegisterEventTypeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "registerEventType", err)
+			}
+			res := NewRegisterEventTypeEventTypeOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "registerEventType", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListEventTypesRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "listEventTypes" endpoint
+func (c *Client) BuildListEventTypesRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListEventTypesZebrahookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "listEventTypes", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListEventTypesRequest returns an encoder for requests sent to the
+// Zebrahook listEventTypes server.
+func EncodeListEventTypesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ListEventTypesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "listEventTypes", "*zebrahook.ListEventTypesPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Deprecated != nil {
+			values.Add("deprecated", fmt.Sprintf("%v", *p.Deprecated))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListEventTypesResponse returns a decoder for responses returned by the
+// Zebrahook listEventTypes endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeListEventTypesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListEventTypesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "listEventTypes", err)
+			}
+			err = ValidateListEventTypesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "listEventTypes", err)
+			}
+			res := NewListEventTypesResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "listEventTypes", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetEventTypeRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "getEventType" endpoint
+func (c *Client) BuildGetEventTypeRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*zebrahook.GetEventTypePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getEventType", "*zebrahook.GetEventTypePayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetEventTypeZebrahookPath(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getEventType", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetEventTypeRequest returns an encoder for requests sent to the
+// Zebrahook getEventType server.
+func EncodeGetEventTypeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetEventTypePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getEventType", "*zebrahook.GetEventTypePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetEventTypeResponse returns a decoder for responses returned by the
+// Zebrahook getEventType endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeGetEventTypeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetEventTypeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getEventType", err)
+			}
+			err = ValidateGetEventTypeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getEventType", err)
+			}
+			res := NewGetEventTypeEventTypeOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getEventType", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateEventTypeRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "updateEventType" endpoint
+func (c *Client) BuildUpdateEventTypeRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*zebrahook.UpdateEventTypePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "updateEventType", "*zebrahook.UpdateEventTypePayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateEventTypeZebrahookPath(name)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "updateEventType", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateEventTypeRequest returns an encoder for requests sent to the
+// Zebrahook updateEventType server.
+func EncodeUpdateEventTypeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.UpdateEventTypePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "updateEventType", "*zebrahook.UpdateEventTypePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateEventTypeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "updateEventType", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateEventTypeResponse returns a decoder for responses returned by
+// the Zebrahook updateEventType endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeUpdateEventTypeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateEventTypeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "updateEventType", err)
+			}
+			err = ValidateUpdateEventTypeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "updateEventType", err)
+			}
+			res := NewUpdateEventTypeEventTypeOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "updateEventType", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetEventTypesCatalogRequest instantiates a HTTP request object with
+// method and path set to call the "Zebrahook" service "getEventTypesCatalog"
+// endpoint
+func (c *Client) BuildGetEventTypesCatalogRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetEventTypesCatalogZebrahookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getEventTypesCatalog", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetEventTypesCatalogRequest returns an encoder for requests sent to
+// the Zebrahook getEventTypesCatalog server.
+func EncodeGetEventTypesCatalogRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetEventTypesCatalogPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getEventTypesCatalog", "*zebrahook.GetEventTypesCatalogPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetEventTypesCatalogResponse returns a decoder for responses returned
+// by the Zebrahook getEventTypesCatalog endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+func DecodeGetEventTypesCatalogResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType string
+				err         error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("Content-Type", "header"))
+			}
+			contentType = contentTypeRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getEventTypesCatalog", err)
+			}
+			res := NewGetEventTypesCatalogResultOK(contentType)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getEventTypesCatalog", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWebhookEndpointStatusHistoryRequest instantiates a HTTP request
+// object with method and path set to call the "Zebrahook" service
+// "getWebhookEndpointStatusHistory" endpoint
+func (c *Client) BuildGetWebhookEndpointStatusHistoryRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.GetWebhookEndpointStatusHistoryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getWebhookEndpointStatusHistory", "*zebrahook.GetWebhookEndpointStatusHistoryPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWebhookEndpointStatusHistoryZebrahookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getWebhookEndpointStatusHistory", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWebhookEndpointStatusHistoryRequest returns an encoder for requests
+// sent to the Zebrahook getWebhookEndpointStatusHistory server.
+func EncodeGetWebhookEndpointStatusHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetWebhookEndpointStatusHistoryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getWebhookEndpointStatusHistory", "*zebrahook.GetWebhookEndpointStatusHistoryPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.StartingAfter != nil {
+			values.Add("starting_after", fmt.Sprintf("%v", *p.StartingAfter))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetWebhookEndpointStatusHistoryResponse returns a decoder for
+// responses returned by the Zebrahook getWebhookEndpointStatusHistory
+// endpoint. restoreBody controls whether the response body should be restored
+// after having been read.
+func DecodeGetWebhookEndpointStatusHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWebhookEndpointStatusHistoryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getWebhookEndpointStatusHistory", err)
+			}
+			err = ValidateGetWebhookEndpointStatusHistoryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getWebhookEndpointStatusHistory", err)
+			}
+			res := NewGetWebhookEndpointStatusHistoryResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getWebhookEndpointStatusHistory", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetWebhookEndpointStatsRequest instantiates a HTTP request object with
+// method and path set to call the "Zebrahook" service
+// "getWebhookEndpointStats" endpoint
+func (c *Client) BuildGetWebhookEndpointStatsRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*zebrahook.GetWebhookEndpointStatsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getWebhookEndpointStats", "*zebrahook.GetWebhookEndpointStatsPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetWebhookEndpointStatsZebrahookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getWebhookEndpointStats", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetWebhookEndpointStatsRequest returns an encoder for requests sent to
+// the Zebrahook getWebhookEndpointStats server.
+func EncodeGetWebhookEndpointStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetWebhookEndpointStatsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getWebhookEndpointStats", "*zebrahook.GetWebhookEndpointStatsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("window", p.Window)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetWebhookEndpointStatsResponse returns a decoder for responses
+// returned by the Zebrahook getWebhookEndpointStats endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+func DecodeGetWebhookEndpointStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetWebhookEndpointStatsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getWebhookEndpointStats", err)
+			}
+			err = ValidateGetWebhookEndpointStatsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getWebhookEndpointStats", err)
+			}
+			res := NewGetWebhookEndpointStatsEndpointStatsOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getWebhookEndpointStats", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListDeadLettersRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "listDeadLetters" endpoint
+func (c *Client) BuildListDeadLettersRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDeadLettersZebrahookPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "listDeadLetters", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListDeadLettersRequest returns an encoder for requests sent to the
+// Zebrahook listDeadLetters server.
+func EncodeListDeadLettersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ListDeadLettersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "listDeadLetters", "*zebrahook.ListDeadLettersPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.StartingAfter != nil {
+			values.Add("starting_after", fmt.Sprintf("%v", *p.StartingAfter))
+		}
+		values.Add("status", p.Status)
+		if p.EndpointID != nil {
+			values.Add("endpoint_id", *p.EndpointID)
+		}
+		if p.EventType != nil {
+			values.Add("event_type", *p.EventType)
+		}
+		if p.CreatedAtGte != nil {
+			values.Add("createdAt.gte", fmt.Sprintf("%v", *p.CreatedAtGte))
+		}
+		if p.CreatedAtLt != nil {
+			values.Add("createdAt.lt", fmt.Sprintf("%v", *p.CreatedAtLt))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListDeadLettersResponse returns a decoder for responses returned by
+// the Zebrahook listDeadLetters endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeListDeadLettersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListDeadLettersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "listDeadLetters", err)
+			}
+			err = ValidateListDeadLettersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "listDeadLetters", err)
+			}
+			res := NewListDeadLettersResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "listDeadLetters", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetDeadLetterRequest instantiates a HTTP request object with method and
+// path set to call the "Zebrahook" service "getDeadLetter" endpoint
+func (c *Client) BuildGetDeadLetterRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.GetDeadLetterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "getDeadLetter", "*zebrahook.GetDeadLetterPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDeadLetterZebrahookPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "getDeadLetter", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetDeadLetterRequest returns an encoder for requests sent to the
+// Zebrahook getDeadLetter server.
+func EncodeGetDeadLetterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.GetDeadLetterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "getDeadLetter", "*zebrahook.GetDeadLetterPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetDeadLetterResponse returns a decoder for responses returned by the
+// Zebrahook getDeadLetter endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeGetDeadLetterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetDeadLetterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "getDeadLetter", err)
+			}
+			err = ValidateGetDeadLetterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "getDeadLetter", err)
+			}
+			res := NewGetDeadLetterDeadLetterDetailsOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "getDeadLetter", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReplayDeadLetterRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "replayDeadLetter" endpoint
+func (c *Client) BuildReplayDeadLetterRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.ReplayDeadLetterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "replayDeadLetter", "*zebrahook.ReplayDeadLetterPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReplayDeadLetterZebrahookPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "replayDeadLetter", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReplayDeadLetterRequest returns an encoder for requests sent to the
+// Zebrahook replayDeadLetter server.
+func EncodeReplayDeadLetterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ReplayDeadLetterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "replayDeadLetter", "*zebrahook.ReplayDeadLetterPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeReplayDeadLetterResponse returns a decoder for responses returned by
+// the Zebrahook replayDeadLetter endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeReplayDeadLetterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReplayDeadLetterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "replayDeadLetter", err)
+			}
+			err = ValidateReplayDeadLetterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "replayDeadLetter", err)
+			}
+			res := NewReplayDeadLetterDeadLetterOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "replayDeadLetter", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReplayDeadLettersRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "replayDeadLetters" endpoint
+func (c *Client) BuildReplayDeadLettersRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReplayDeadLettersZebrahookPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "replayDeadLetters", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReplayDeadLettersRequest returns an encoder for requests sent to the
+// Zebrahook replayDeadLetters server.
+func EncodeReplayDeadLettersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.ReplayDeadLettersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "replayDeadLetters", "*zebrahook.ReplayDeadLettersPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewReplayDeadLettersRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "replayDeadLetters", err)
+		}
+		return nil
+	}
+}
+
+// DecodeReplayDeadLettersResponse returns a decoder for responses returned by
+// the Zebrahook replayDeadLetters endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeReplayDeadLettersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReplayDeadLettersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "replayDeadLetters", err)
+			}
+			err = ValidateReplayDeadLettersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "replayDeadLetters", err)
+			}
+			res := NewReplayDeadLettersResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "replayDeadLetters", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteDeadLetterRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "deleteDeadLetter" endpoint
+func (c *Client) BuildDeleteDeadLetterRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*zebrahook.DeleteDeadLetterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("Zebrahook", "deleteDeadLetter", "*zebrahook.DeleteDeadLetterPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteDeadLetterZebrahookPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "deleteDeadLetter", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteDeadLetterRequest returns an encoder for requests sent to the
+// Zebrahook deleteDeadLetter server.
+func EncodeDeleteDeadLetterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.DeleteDeadLetterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "deleteDeadLetter", "*zebrahook.DeleteDeadLetterPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteDeadLetterResponse returns a decoder for responses returned by
+// the Zebrahook deleteDeadLetter endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeDeleteDeadLetterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteDeadLetterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "deleteDeadLetter", err)
+			}
+			res := NewDeleteDeadLetterResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "deleteDeadLetter", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPurgeDeadLettersRequest instantiates a HTTP request object with method
+// and path set to call the "Zebrahook" service "purgeDeadLetters" endpoint
+func (c *Client) BuildPurgeDeadLettersRequest(ctx context.Context, v interface{}) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PurgeDeadLettersZebrahookPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("Zebrahook", "purgeDeadLetters", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePurgeDeadLettersRequest returns an encoder for requests sent to the
+// Zebrahook purgeDeadLetters server.
+func EncodePurgeDeadLettersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, interface{}) error {
+	return func(req *http.Request, v interface{}) error {
+		p, ok := v.(*zebrahook.PurgeDeadLettersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("Zebrahook", "purgeDeadLetters", "*zebrahook.PurgeDeadLettersPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewPurgeDeadLettersRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("Zebrahook", "purgeDeadLetters", err)
+		}
+		return nil
+	}
+}
+
+// DecodePurgeDeadLettersResponse returns a decoder for responses returned by
+// the Zebrahook purgeDeadLetters endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodePurgeDeadLettersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (interface{}, error) {
+	return func(resp *http.Response) (interface{}, error) {
+		if restoreBody {
+			b, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PurgeDeadLettersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("Zebrahook", "purgeDeadLetters", err)
+			}
+			err = ValidatePurgeDeadLettersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("Zebrahook", "purgeDeadLetters", err)
+			}
+			res := NewPurgeDeadLettersResultOK(&body)
+			return res, nil
+		default:
+			body, _ := ioutil.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("Zebrahook", "purgeDeadLetters", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalZebrahookEventRequestToEventRequestRequestBody builds a value of type
+// *EventRequestRequestBody from a value of type *zebrahook.EventRequest.
+func marshalZebrahookEventRequestToEventRequestRequestBody(v *zebrahook.EventRequest) *EventRequestRequestBody {
+	res := &EventRequestRequestBody{
+		EventType:      v.EventType,
+		Priority:       v.Priority,
+		IdempotencyKey: v.IdempotencyKey,
+	}
+	if v.EventContent != nil {
+		res.EventContent = make(map[string]interface{}, len(v.EventContent))
+		for key, val := range v.EventContent {
+			tk := key
+			tv := val
+			res.EventContent[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalEventRequestRequestBodyToZebrahookEventRequest builds a value of type
+// *zebrahook.EventRequest from a value of type *EventRequestRequestBody.
+func marshalEventRequestRequestBodyToZebrahookEventRequest(v *EventRequestRequestBody) *zebrahook.EventRequest {
+	res := &zebrahook.EventRequest{
+		EventType:      v.EventType,
+		Priority:       v.Priority,
+		IdempotencyKey: v.IdempotencyKey,
+	}
+	if v.EventContent != nil {
+		res.EventContent = make(map[string]interface{}, len(v.EventContent))
+		for key, val := range v.EventContent {
+			tk := key
+			tv := val
+			res.EventContent[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalSubmittedEventResultResponseBodyToZebrahookSubmittedEventResult
+// builds a value of type *zebrahook.SubmittedEventResult from a value of type
+// *SubmittedEventResultResponseBody.
+func unmarshalSubmittedEventResultResponseBodyToZebrahookSubmittedEventResult(v *SubmittedEventResultResponseBody) *zebrahook.SubmittedEventResult {
+	res := &zebrahook.SubmittedEventResult{
+		Index:            *v.Index,
+		EventID:          v.EventID,
+		EventType:        *v.EventType,
+		MappingStatus:    v.MappingStatus,
+		EndpointsMatched: v.EndpointsMatched,
+		ErrorCode:        v.ErrorCode,
+		ErrorMessage:     v.ErrorMessage,
+	}
+
+	return res
+}
+
+// marshalZebrahookDeliveryPolicyToDeliveryPolicyRequestBody builds a value of
+// type *DeliveryPolicyRequestBody from a value of type
+// *zebrahook.DeliveryPolicy.
+func marshalZebrahookDeliveryPolicyToDeliveryPolicyRequestBody(v *zebrahook.DeliveryPolicy) *DeliveryPolicyRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &DeliveryPolicyRequestBody{
+		TimeoutSecs:     v.TimeoutSecs,
+		MaxAttempts:     v.MaxAttempts,
+		BackoffType:     v.BackoffType,
+		BackoffBaseSecs: v.BackoffBaseSecs,
+		MaxBackoffSecs:  v.MaxBackoffSecs,
+	}
+	if v.BackoffScheduleSecs != nil {
+		res.BackoffScheduleSecs = make([]uint, len(v.BackoffScheduleSecs))
+		for i, val := range v.BackoffScheduleSecs {
+			res.BackoffScheduleSecs[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy builds a value of
+// type *zebrahook.DeliveryPolicy from a value of type
+// *DeliveryPolicyRequestBody.
+func marshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy(v *DeliveryPolicyRequestBody) *zebrahook.DeliveryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &zebrahook.DeliveryPolicy{
+		TimeoutSecs:     v.TimeoutSecs,
+		MaxAttempts:     v.MaxAttempts,
+		BackoffType:     v.BackoffType,
+		BackoffBaseSecs: v.BackoffBaseSecs,
+		MaxBackoffSecs:  v.MaxBackoffSecs,
+	}
+	if v.BackoffScheduleSecs != nil {
+		res.BackoffScheduleSecs = make([]uint, len(v.BackoffScheduleSecs))
+		for i, val := range v.BackoffScheduleSecs {
+			res.BackoffScheduleSecs[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalWebhookEndpointWithoutSecretResponseBodyToZebrahookWebhookEndpointWithoutSecret
+// builds a value of type *zebrahook.WebhookEndpointWithoutSecret from a value
+// of type *WebhookEndpointWithoutSecretResponseBody.
+func unmarshalWebhookEndpointWithoutSecretResponseBodyToZebrahookWebhookEndpointWithoutSecret(v *WebhookEndpointWithoutSecretResponseBody) *zebrahook.WebhookEndpointWithoutSecret {
+	res := &zebrahook.WebhookEndpointWithoutSecret{
+		CreatedAt:       *v.CreatedAt,
+		UpdatedAt:       *v.UpdatedAt,
+		Status:          v.Status,
+		DisabledReason:  v.DisabledReason,
+		CircuitState:    v.CircuitState,
+		CircuitOpenedAt: v.CircuitOpenedAt,
+		URL:             *v.URL,
+		ID:              *v.ID,
+	}
+	res.EnabledEvents = make([]string, len(v.EnabledEvents))
+	for i, val := range v.EnabledEvents {
+		res.EnabledEvents[i] = val
+	}
+	if v.Metadata != nil {
+		res.Metadata = make(map[string]string, len(v.Metadata))
+		for key, val := range v.Metadata {
+			tk := key
+			tv := val
+			res.Metadata[tk] = tv
+		}
+	}
+	if v.Headers != nil {
+		res.Headers = make(map[string]string, len(v.Headers))
+		for key, val := range v.Headers {
+			tk := key
+			tv := val
+			res.Headers[tk] = tv
+		}
+	}
+	if v.DeliveryPolicy != nil {
+		res.DeliveryPolicy = unmarshalDeliveryPolicyResponseBodyToZebrahookDeliveryPolicy(v.DeliveryPolicy)
+	}
+
+	return res
+}
+
+// unmarshalDeliveryPolicyResponseBodyToZebrahookDeliveryPolicy builds a value
+// of type *zebrahook.DeliveryPolicy from a value of type
+// *DeliveryPolicyResponseBody.
+func unmarshalDeliveryPolicyResponseBodyToZebrahookDeliveryPolicy(v *DeliveryPolicyResponseBody) *zebrahook.DeliveryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &zebrahook.DeliveryPolicy{
+		TimeoutSecs:     v.TimeoutSecs,
+		MaxAttempts:     v.MaxAttempts,
+		BackoffType:     v.BackoffType,
+		BackoffBaseSecs: v.BackoffBaseSecs,
+		MaxBackoffSecs:  v.MaxBackoffSecs,
+	}
+	if v.BackoffScheduleSecs != nil {
+		res.BackoffScheduleSecs = make([]uint, len(v.BackoffScheduleSecs))
+		for i, val := range v.BackoffScheduleSecs {
+			res.BackoffScheduleSecs[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalEventResponseBodyToZebrahookEvent builds a value of type
+// *zebrahook.Event from a value of type *EventResponseBody.
+func unmarshalEventResponseBodyToZebrahookEvent(v *EventResponseBody) *zebrahook.Event {
+	res := &zebrahook.Event{
+		ID:        *v.ID,
+		EventType: *v.EventType,
+		Priority:  *v.Priority,
+		CreatedAt: *v.CreatedAt,
+	}
+	res.EventContent = make(map[string]interface{}, len(v.EventContent))
+	for key, val := range v.EventContent {
+		tk := key
+		tv := val
+		res.EventContent[tk] = tv
+	}
+
+	return res
+}
+
+// unmarshalEventDeliveryResponseBodyToZebrahookEventDelivery builds a value of
+// type *zebrahook.EventDelivery from a value of type
+// *EventDeliveryResponseBody.
+func unmarshalEventDeliveryResponseBodyToZebrahookEventDelivery(v *EventDeliveryResponseBody) *zebrahook.EventDelivery {
+	res := &zebrahook.EventDelivery{
+		ID:                     *v.ID,
+		EndpointID:             *v.EndpointID,
+		EndpointURL:            v.EndpointURL,
+		Status:                 *v.Status,
+		AttemptsCounter:        *v.AttemptsCounter,
+		AttemptsRemaining:      *v.AttemptsRemaining,
+		NextAttemptScheduledAt: v.NextAttemptScheduledAt,
+		CreatedAt:              *v.CreatedAt,
+		UpdatedAt:              *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalEventDeliveryAttemptResponseBodyToZebrahookEventDeliveryAttempt
+// builds a value of type *zebrahook.EventDeliveryAttempt from a value of type
+// *EventDeliveryAttemptResponseBody.
+func unmarshalEventDeliveryAttemptResponseBodyToZebrahookEventDeliveryAttempt(v *EventDeliveryAttemptResponseBody) *zebrahook.EventDeliveryAttempt {
+	res := &zebrahook.EventDeliveryAttempt{
+		ID:                   *v.ID,
+		EventDeliveryID:      *v.EventDeliveryID,
+		EventID:              *v.EventID,
+		EventType:            *v.EventType,
+		Status:               *v.Status,
+		AttemptMadeAt:        v.AttemptMadeAt,
+		HTTPStatusCode:       v.HTTPStatusCode,
+		HTTPBodyResponse:     v.HTTPBodyResponse,
+		HTTPResponseTimeSecs: v.HTTPResponseTimeSecs,
+		CreatedAt:            *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalAPIKeyResponseBodyToZebrahookAPIKey builds a value of type
+// *zebrahook.APIKey from a value of type *APIKeyResponseBody.
+func unmarshalAPIKeyResponseBodyToZebrahookAPIKey(v *APIKeyResponseBody) *zebrahook.APIKey {
+	res := &zebrahook.APIKey{
+		ID:          *v.ID,
+		Description: *v.Description,
+		Status:      *v.Status,
+		Tenant:      *v.Tenant,
+		ExpiresAt:   v.ExpiresAt,
+		LastUsedAt:  v.LastUsedAt,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+	}
+	res.Scopes = make([]string, len(v.Scopes))
+	for i, val := range v.Scopes {
+		res.Scopes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalEventTypeResponseBodyToZebrahookEventType builds a value of type
+// *zebrahook.EventType from a value of type *EventTypeResponseBody.
+func unmarshalEventTypeResponseBodyToZebrahookEventType(v *EventTypeResponseBody) *zebrahook.EventType {
+	res := &zebrahook.EventType{
+		Name:        *v.Name,
+		Description: v.Description,
+		Deprecated:  *v.Deprecated,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+	}
+	if v.Schema != nil {
+		res.Schema = make(map[string]interface{}, len(v.Schema))
+		for key, val := range v.Schema {
+			tk := key
+			tv := val
+			res.Schema[tk] = tv
+		}
+	}
+	if v.Example != nil {
+		res.Example = make(map[string]interface{}, len(v.Example))
+		for key, val := range v.Example {
+			tk := key
+			tv := val
+			res.Example[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalEndpointStatusEventResponseBodyToZebrahookEndpointStatusEvent
+// builds a value of type *zebrahook.EndpointStatusEvent from a value of type
+// *EndpointStatusEventResponseBody.
+func unmarshalEndpointStatusEventResponseBodyToZebrahookEndpointStatusEvent(v *EndpointStatusEventResponseBody) *zebrahook.EndpointStatusEvent {
+	res := &zebrahook.EndpointStatusEvent{
+		ID:            *v.ID,
+		OldStatus:     *v.OldStatus,
+		NewStatus:     *v.NewStatus,
+		Reason:        *v.Reason,
+		ActorAPIKeyID: v.ActorAPIKeyID,
+		ActorSubject:  v.ActorSubject,
+		CreatedAt:     *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalResponseTimePercentilesResponseBodyToZebrahookResponseTimePercentiles
+// builds a value of type *zebrahook.ResponseTimePercentiles from a value of
+// type *ResponseTimePercentilesResponseBody.
+func unmarshalResponseTimePercentilesResponseBodyToZebrahookResponseTimePercentiles(v *ResponseTimePercentilesResponseBody) *zebrahook.ResponseTimePercentiles {
+	if v == nil {
+		return nil
+	}
+	res := &zebrahook.ResponseTimePercentiles{
+		P50: *v.P50,
+		P95: *v.P95,
+		P99: *v.P99,
+	}
+
+	return res
+}
+
+// unmarshalDeadLetterResponseBodyToZebrahookDeadLetter builds a value of type
+// *zebrahook.DeadLetter from a value of type *DeadLetterResponseBody.
+func unmarshalDeadLetterResponseBodyToZebrahookDeadLetter(v *DeadLetterResponseBody) *zebrahook.DeadLetter {
+	res := &zebrahook.DeadLetter{
+		ID:                    *v.ID,
+		EventDeliveryID:       *v.EventDeliveryID,
+		EventID:               *v.EventID,
+		EventType:             *v.EventType,
+		EndpointID:            *v.EndpointID,
+		Reason:                *v.Reason,
+		AttemptsCounter:       *v.AttemptsCounter,
+		LastAttemptStatus:     *v.LastAttemptStatus,
+		LastHTTPStatusCode:    v.LastHTTPStatusCode,
+		LastAttemptMadeAt:     v.LastAttemptMadeAt,
+		Status:                *v.Status,
+		ReplayEventDeliveryID: v.ReplayEventDeliveryID,
+		ReplayedAt:            v.ReplayedAt,
+		CreatedAt:             *v.CreatedAt,
 	}
 
 	return res
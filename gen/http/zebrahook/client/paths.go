@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // HTTP request path constructors for the Zebrahook service.
 //
@@ -35,3 +35,133 @@ func ListWebhookEndpointZebrahookPath() string {
 func GetWebhookEndpointByIDZebrahookPath(id string) string {
 	return fmt.Sprintf("/v1/webhook/endpoints/%v", id)
 }
+
+// DeleteWebhookEndpointZebrahookPath returns the URL path to the Zebrahook service deleteWebhookEndpoint HTTP endpoint.
+func DeleteWebhookEndpointZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v", id)
+}
+
+// ListEventsZebrahookPath returns the URL path to the Zebrahook service listEvents HTTP endpoint.
+func ListEventsZebrahookPath() string {
+	return "/v1/webhook/events"
+}
+
+// GetEventByIDZebrahookPath returns the URL path to the Zebrahook service getEventById HTTP endpoint.
+func GetEventByIDZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/events/%v", id)
+}
+
+// ListWebhookEndpointAttemptsZebrahookPath returns the URL path to the Zebrahook service listWebhookEndpointAttempts HTTP endpoint.
+func ListWebhookEndpointAttemptsZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/attempts", id)
+}
+
+// RetryEventDeliveryZebrahookPath returns the URL path to the Zebrahook service retryEventDelivery HTTP endpoint.
+func RetryEventDeliveryZebrahookPath(id uint, endpointID string) string {
+	return fmt.Sprintf("/v1/webhook/events/%v/deliveries/%v/retry", id, endpointID)
+}
+
+// RecoverWebhookEndpointZebrahookPath returns the URL path to the Zebrahook service recoverWebhookEndpoint HTTP endpoint.
+func RecoverWebhookEndpointZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/recover", id)
+}
+
+// GetWebhookEndpointRecoveryZebrahookPath returns the URL path to the Zebrahook service getWebhookEndpointRecovery HTTP endpoint.
+func GetWebhookEndpointRecoveryZebrahookPath(id string, recoveryID string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/recover/%v", id, recoveryID)
+}
+
+// RotateWebhookEndpointSecretZebrahookPath returns the URL path to the Zebrahook service rotateWebhookEndpointSecret HTTP endpoint.
+func RotateWebhookEndpointSecretZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/rotate-secret", id)
+}
+
+// TestWebhookEndpointZebrahookPath returns the URL path to the Zebrahook service testWebhookEndpoint HTTP endpoint.
+func TestWebhookEndpointZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/test", id)
+}
+
+// ListAPIKeysZebrahookPath returns the URL path to the Zebrahook service listApiKeys HTTP endpoint.
+func ListAPIKeysZebrahookPath() string {
+	return "/v1/webhook/api-keys"
+}
+
+// GetAPIKeyZebrahookPath returns the URL path to the Zebrahook service getApiKey HTTP endpoint.
+func GetAPIKeyZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/api-keys/%v", id)
+}
+
+// UpdateAPIKeyZebrahookPath returns the URL path to the Zebrahook service updateApiKey HTTP endpoint.
+func UpdateAPIKeyZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/api-keys/%v", id)
+}
+
+// RevokeAPIKeyZebrahookPath returns the URL path to the Zebrahook service revokeApiKey HTTP endpoint.
+func RevokeAPIKeyZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/api-keys/%v/revoke", id)
+}
+
+// RegisterEventTypeZebrahookPath returns the URL path to the Zebrahook service registerEventType HTTP endpoint.
+func RegisterEventTypeZebrahookPath() string {
+	return "/v1/webhook/event-types"
+}
+
+// ListEventTypesZebrahookPath returns the URL path to the Zebrahook service listEventTypes HTTP endpoint.
+func ListEventTypesZebrahookPath() string {
+	return "/v1/webhook/event-types"
+}
+
+// GetEventTypeZebrahookPath returns the URL path to the Zebrahook service getEventType HTTP endpoint.
+func GetEventTypeZebrahookPath(name string) string {
+	return fmt.Sprintf("/v1/webhook/event-types/%v", name)
+}
+
+// UpdateEventTypeZebrahookPath returns the URL path to the Zebrahook service updateEventType HTTP endpoint.
+func UpdateEventTypeZebrahookPath(name string) string {
+	return fmt.Sprintf("/v1/webhook/event-types/%v", name)
+}
+
+// GetEventTypesCatalogZebrahookPath returns the URL path to the Zebrahook service getEventTypesCatalog HTTP endpoint.
+func GetEventTypesCatalogZebrahookPath() string {
+	return "/v1/webhook/event-types/catalog"
+}
+
+// GetWebhookEndpointStatusHistoryZebrahookPath returns the URL path to the Zebrahook service getWebhookEndpointStatusHistory HTTP endpoint.
+func GetWebhookEndpointStatusHistoryZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/status-history", id)
+}
+
+// GetWebhookEndpointStatsZebrahookPath returns the URL path to the Zebrahook service getWebhookEndpointStats HTTP endpoint.
+func GetWebhookEndpointStatsZebrahookPath(id string) string {
+	return fmt.Sprintf("/v1/webhook/endpoints/%v/stats", id)
+}
+
+// ListDeadLettersZebrahookPath returns the URL path to the Zebrahook service listDeadLetters HTTP endpoint.
+func ListDeadLettersZebrahookPath() string {
+	return "/v1/webhook/dead-letters"
+}
+
+// GetDeadLetterZebrahookPath returns the URL path to the Zebrahook service getDeadLetter HTTP endpoint.
+func GetDeadLetterZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/dead-letters/%v", id)
+}
+
+// ReplayDeadLetterZebrahookPath returns the URL path to the Zebrahook service replayDeadLetter HTTP endpoint.
+func ReplayDeadLetterZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/dead-letters/%v/replay", id)
+}
+
+// ReplayDeadLettersZebrahookPath returns the URL path to the Zebrahook service replayDeadLetters HTTP endpoint.
+func ReplayDeadLettersZebrahookPath() string {
+	return "/v1/webhook/dead-letters/replay"
+}
+
+// DeleteDeadLetterZebrahookPath returns the URL path to the Zebrahook service deleteDeadLetter HTTP endpoint.
+func DeleteDeadLetterZebrahookPath(id uint) string {
+	return fmt.Sprintf("/v1/webhook/dead-letters/%v", id)
+}
+
+// PurgeDeadLettersZebrahookPath returns the URL path to the Zebrahook service purgeDeadLetters HTTP endpoint.
+func PurgeDeadLettersZebrahookPath() string {
+	return "/v1/webhook/dead-letters/purge"
+}
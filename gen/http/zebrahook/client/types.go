@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP client types
 //
@@ -30,6 +30,14 @@ type RegisterRequestBody struct {
 	EnabledEvents []string `form:"enabled_events" json:"enabled_events" xml:"enabled_events"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicyRequestBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
 }
 
 // UpdateRequestBody is the type of the "Zebrahook" service "update" endpoint
@@ -45,12 +53,121 @@ type UpdateRequestBody struct {
 	EnabledEvents []string `form:"enabled_events,omitempty" json:"enabled_events,omitempty" xml:"enabled_events,omitempty"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Custom HTTP headers added to every webhook request, replaces the current
+	// ones (use an empty object to remove them)
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Delivery policy of this endpoint, replaces the current one (settings not
+	// provided fall back to the global configuration)
+	DeliveryPolicy *DeliveryPolicyRequestBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
+}
+
+// RecoverWebhookEndpointRequestBody is the type of the "Zebrahook" service
+// "recoverWebhookEndpoint" endpoint HTTP request body.
+type RecoverWebhookEndpointRequestBody struct {
+	// recover event deliveries created since this time (unix timestamp seconds)
+	Since int64 `form:"since" json:"since" xml:"since"`
+}
+
+// RotateWebhookEndpointSecretRequestBody is the type of the "Zebrahook"
+// service "rotateWebhookEndpointSecret" endpoint HTTP request body.
+type RotateWebhookEndpointSecretRequestBody struct {
+	// how many seconds the previous secret stays valid, if not provided the
+	// configured value is used
+	GracePeriodSecs *uint `form:"grace_period_secs,omitempty" json:"grace_period_secs,omitempty" xml:"grace_period_secs,omitempty"`
+}
+
+// TestWebhookEndpointRequestBody is the type of the "Zebrahook" service
+// "testWebhookEndpoint" endpoint HTTP request body.
+type TestWebhookEndpointRequestBody struct {
+	// event type set in the sample content (`zebrahook.test` by default), can't be
+	// used with `event_content` as deliveries send only the event content
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// content of the test event, sent as is like the content of a delivery, if not
+	// provided a sample content is sent
+	EventContent map[string]interface{} `form:"event_content,omitempty" json:"event_content,omitempty" xml:"event_content,omitempty"`
+}
+
+// UpdateAPIKeyRequestBody is the type of the "Zebrahook" service
+// "updateApiKey" endpoint HTTP request body.
+type UpdateAPIKeyRequestBody struct {
+	// description for internal use
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// when this api key expires (unix timestamp seconds), use 0 to remove the
+	// expiration
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+}
+
+// RegisterEventTypeRequestBody is the type of the "Zebrahook" service
+// "registerEventType" endpoint HTTP request body.
+type RegisterEventTypeRequestBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name string `form:"name" json:"name" xml:"name"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must
+	// satisfy, external references are not allowed
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`, must satisfy the schema
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool `form:"deprecated" json:"deprecated" xml:"deprecated"`
+}
+
+// UpdateEventTypeRequestBody is the type of the "Zebrahook" service
+// "updateEventType" endpoint HTTP request body.
+type UpdateEventTypeRequestBody struct {
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must
+	// satisfy, external references are not allowed
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`, must satisfy the schema
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+}
+
+// ReplayDeadLettersRequestBody is the type of the "Zebrahook" service
+// "replayDeadLetters" endpoint HTTP request body.
+type ReplayDeadLettersRequestBody struct {
+	// replay only these dead letters
+	Ids []uint `form:"ids,omitempty" json:"ids,omitempty" xml:"ids,omitempty"`
+	// replay only the dead letters of this webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// replay only the dead letters of this event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// replay only the dead letters created after (greater than or equal)
+	CreatedAtGte *uint64 `form:"createdAt.gte,omitempty" json:"createdAt.gte,omitempty" xml:"createdAt.gte,omitempty"`
+	// replay only the dead letters created before (less than)
+	CreatedAtLt *uint64 `form:"createdAt.lt,omitempty" json:"createdAt.lt,omitempty" xml:"createdAt.lt,omitempty"`
+	// limit how many dead letters to replay
+	Limit int32 `form:"limit" json:"limit" xml:"limit"`
+}
+
+// PurgeDeadLettersRequestBody is the type of the "Zebrahook" service
+// "purgeDeadLetters" endpoint HTTP request body.
+type PurgeDeadLettersRequestBody struct {
+	// purge only the dead letters with this status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// purge only the dead letters of this webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// purge only the dead letters of this event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// purge only the dead letters created before (less than)
+	CreatedAtLt *uint64 `form:"createdAt.lt,omitempty" json:"createdAt.lt,omitempty" xml:"createdAt.lt,omitempty"`
 }
 
 // SubmitNewEventsResponseBody is the type of the "Zebrahook" service
 // "submitNewEvents" endpoint HTTP response body.
 type SubmitNewEventsResponseBody struct {
+	// true if all the submitted events have been accepted
 	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// result for each submitted event, in the same order of the submitted events
+	Results []*SubmittedEventResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
 }
 
 // RegisterResponseBody is the type of the "Zebrahook" service "register"
@@ -84,6 +201,13 @@ type GetWebhookEndpointByIDResponseBody struct {
 	// status of current endpoint, enabled means that the webhook endpoint is
 	// eligible for receiving webhook events
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// why the endpoint was disabled, not set when enabled
+	DisabledReason *string `form:"disabled_reason,omitempty" json:"disabled_reason,omitempty" xml:"disabled_reason,omitempty"`
+	// circuit breaker state, deliveries are held while open and a single probe
+	// delivery is sent while half open
+	CircuitState *string `form:"circuit_state,omitempty" json:"circuit_state,omitempty" xml:"circuit_state,omitempty"`
+	// when the circuit was opened (unix timestamp seconds)
+	CircuitOpenedAt *int64 `form:"circuit_opened_at,omitempty" json:"circuit_opened_at,omitempty" xml:"circuit_opened_at,omitempty"`
 	// URL of the webhook that will be called on each `enabled_events`
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 	// Enabled events for this webhook URL, regex supported - use `["*"]` to listen
@@ -91,12 +215,414 @@ type GetWebhookEndpointByIDResponseBody struct {
 	EnabledEvents []string `form:"enabled_events,omitempty" json:"enabled_events,omitempty" xml:"enabled_events,omitempty"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicyResponseBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
+	// identifier of the webhook
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// secret to be used by the webhook to verify the events
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+}
+
+// DeleteWebhookEndpointResponseBody is the type of the "Zebrahook" service
+// "deleteWebhookEndpoint" endpoint HTTP response body.
+type DeleteWebhookEndpointResponseBody struct {
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// ListEventsResponseBody is the type of the "Zebrahook" service "listEvents"
+// endpoint HTTP response body.
+type ListEventsResponseBody struct {
+	Result []*EventResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+	// true if there are more events after this page, use the last identifier as
+	// `starting_after`
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+}
+
+// GetEventByIDResponseBody is the type of the "Zebrahook" service
+// "getEventById" endpoint HTTP response body.
+type GetEventByIDResponseBody struct {
+	// deliveries of this event, one for each webhook endpoint subscribed
+	Deliveries []*EventDeliveryResponseBody `form:"deliveries,omitempty" json:"deliveries,omitempty" xml:"deliveries,omitempty"`
+	// identifier of the event
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Event type of the `event_content`
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// event content dispatched to the webhook endpoints
+	EventContent map[string]interface{} `form:"event_content,omitempty" json:"event_content,omitempty" xml:"event_content,omitempty"`
+	// priority of this event
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// when this event was submitted (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// ListWebhookEndpointAttemptsResponseBody is the type of the "Zebrahook"
+// service "listWebhookEndpointAttempts" endpoint HTTP response body.
+type ListWebhookEndpointAttemptsResponseBody struct {
+	Result []*EventDeliveryAttemptResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+	// true if there are more attempts after this page, use the last identifier as
+	// `starting_after`
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+}
+
+// RetryEventDeliveryResponseBody is the type of the "Zebrahook" service
+// "retryEventDelivery" endpoint HTTP response body.
+type RetryEventDeliveryResponseBody struct {
+	// identifier of the event delivery
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// URL of the webhook endpoint
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// status of this delivery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// how many attempts have been made so far
+	AttemptsCounter *uint `form:"attempts_counter,omitempty" json:"attempts_counter,omitempty" xml:"attempts_counter,omitempty"`
+	// how many attempts are remaining
+	AttemptsRemaining *uint `form:"attempts_remaining,omitempty" json:"attempts_remaining,omitempty" xml:"attempts_remaining,omitempty"`
+	// when the next attempt is scheduled (unix timestamp seconds)
+	NextAttemptScheduledAt *uint `form:"next_attempt_scheduled_at,omitempty" json:"next_attempt_scheduled_at,omitempty" xml:"next_attempt_scheduled_at,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// RecoverWebhookEndpointResponseBody is the type of the "Zebrahook" service
+// "recoverWebhookEndpoint" endpoint HTTP response body.
+type RecoverWebhookEndpointResponseBody struct {
+	// identifier of the recovery
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// event deliveries created since this time are recovered (unix timestamp
+	// seconds)
+	Since *int64 `form:"since,omitempty" json:"since,omitempty" xml:"since,omitempty"`
+	// status of the recovery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// how many event deliveries will be delivered again
+	DeliveriesFound *uint `form:"deliveries_found,omitempty" json:"deliveries_found,omitempty" xml:"deliveries_found,omitempty"`
+	// how many event deliveries have been enqueued so far
+	DeliveriesEnqueued *uint `form:"deliveries_enqueued,omitempty" json:"deliveries_enqueued,omitempty" xml:"deliveries_enqueued,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// GetWebhookEndpointRecoveryResponseBody is the type of the "Zebrahook"
+// service "getWebhookEndpointRecovery" endpoint HTTP response body.
+type GetWebhookEndpointRecoveryResponseBody struct {
+	// identifier of the recovery
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// event deliveries created since this time are recovered (unix timestamp
+	// seconds)
+	Since *int64 `form:"since,omitempty" json:"since,omitempty" xml:"since,omitempty"`
+	// status of the recovery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// how many event deliveries will be delivered again
+	DeliveriesFound *uint `form:"deliveries_found,omitempty" json:"deliveries_found,omitempty" xml:"deliveries_found,omitempty"`
+	// how many event deliveries have been enqueued so far
+	DeliveriesEnqueued *uint `form:"deliveries_enqueued,omitempty" json:"deliveries_enqueued,omitempty" xml:"deliveries_enqueued,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// RotateWebhookEndpointSecretResponseBody is the type of the "Zebrahook"
+// service "rotateWebhookEndpointSecret" endpoint HTTP response body.
+type RotateWebhookEndpointSecretResponseBody struct {
+	// until when the previous secret is used to sign events (unix timestamp
+	// seconds)
+	PreviousSecretExpiresAt *int64 `form:"previous_secret_expires_at,omitempty" json:"previous_secret_expires_at,omitempty" xml:"previous_secret_expires_at,omitempty"`
 	// identifier of the webhook
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 	// secret to be used by the webhook to verify the events
 	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
 }
 
+// TestWebhookEndpointResponseBody is the type of the "Zebrahook" service
+// "testWebhookEndpoint" endpoint HTTP response body.
+type TestWebhookEndpointResponseBody struct {
+	// outcome of the request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// http status code of the response (if any)
+	HTTPStatusCode *int `form:"http_status_code,omitempty" json:"http_status_code,omitempty" xml:"http_status_code,omitempty"`
+	// response body returned from the endpoint, truncated (if any)
+	HTTPBodyResponse *string `form:"http_body_response,omitempty" json:"http_body_response,omitempty" xml:"http_body_response,omitempty"`
+	// response time in seconds
+	HTTPResponseTimeSecs *float64 `form:"http_response_time_secs,omitempty" json:"http_response_time_secs,omitempty" xml:"http_response_time_secs,omitempty"`
+}
+
+// ListAPIKeysResponseBody is the type of the "Zebrahook" service "listApiKeys"
+// endpoint HTTP response body.
+type ListAPIKeysResponseBody struct {
+	Result []*APIKeyResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+}
+
+// GetAPIKeyResponseBody is the type of the "Zebrahook" service "getApiKey"
+// endpoint HTTP response body.
+type GetAPIKeyResponseBody struct {
+	// identifier of the api key
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// description for internal use
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// status of the api key, disabled keys are rejected
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant *string `form:"tenant,omitempty" json:"tenant,omitempty" xml:"tenant,omitempty"`
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64 `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// UpdateAPIKeyResponseBody is the type of the "Zebrahook" service
+// "updateApiKey" endpoint HTTP response body.
+type UpdateAPIKeyResponseBody struct {
+	// identifier of the api key
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// description for internal use
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// status of the api key, disabled keys are rejected
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant *string `form:"tenant,omitempty" json:"tenant,omitempty" xml:"tenant,omitempty"`
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64 `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// RevokeAPIKeyResponseBody is the type of the "Zebrahook" service
+// "revokeApiKey" endpoint HTTP response body.
+type RevokeAPIKeyResponseBody struct {
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// RegisterEventTypeResponseBody is the type of the "Zebrahook" service
+// "registerEventType" endpoint HTTP response body.
+type RegisterEventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// ListEventTypesResponseBody is the type of the "Zebrahook" service
+// "listEventTypes" endpoint HTTP response body.
+type ListEventTypesResponseBody struct {
+	Result []*EventTypeResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+}
+
+// GetEventTypeResponseBody is the type of the "Zebrahook" service
+// "getEventType" endpoint HTTP response body.
+type GetEventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// UpdateEventTypeResponseBody is the type of the "Zebrahook" service
+// "updateEventType" endpoint HTTP response body.
+type UpdateEventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// GetWebhookEndpointStatusHistoryResponseBody is the type of the "Zebrahook"
+// service "getWebhookEndpointStatusHistory" endpoint HTTP response body.
+type GetWebhookEndpointStatusHistoryResponseBody struct {
+	Result []*EndpointStatusEventResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+	// true if there are more status changes after this page, use the last
+	// identifier as `starting_after`
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+}
+
+// GetWebhookEndpointStatsResponseBody is the type of the "Zebrahook" service
+// "getWebhookEndpointStats" endpoint HTTP response body.
+type GetWebhookEndpointStatsResponseBody struct {
+	// webhook endpoint identifier
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// time window of the stats
+	Window *string `form:"window,omitempty" json:"window,omitempty" xml:"window,omitempty"`
+	// start of the window (unix timestamp seconds), aligned to the 5 minutes stats
+	// buckets
+	From *int64 `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// end of the window (unix timestamp seconds)
+	To *int64 `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// delivery attempts made in the window
+	Attempts *uint `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// share of successful attempts (0-1), not set without attempts
+	SuccessRate *float64 `form:"success_rate,omitempty" json:"success_rate,omitempty" xml:"success_rate,omitempty"`
+	// attempts per attempt status
+	StatusCounts map[string]uint `form:"status_counts,omitempty" json:"status_counts,omitempty" xml:"status_counts,omitempty"`
+	// attempts per http response status code
+	HTTPStatusCodes map[string]uint `form:"http_status_codes,omitempty" json:"http_status_codes,omitempty" xml:"http_status_codes,omitempty"`
+	// response time percentiles, estimated from a histogram (linear interpolation
+	// within the bucket holding the percentile), not set without responses
+	ResponseTimeSecs *ResponseTimePercentilesResponseBody `form:"response_time_secs,omitempty" json:"response_time_secs,omitempty" xml:"response_time_secs,omitempty"`
+}
+
+// ListDeadLettersResponseBody is the type of the "Zebrahook" service
+// "listDeadLetters" endpoint HTTP response body.
+type ListDeadLettersResponseBody struct {
+	Result []*DeadLetterResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+	// true if there are more dead letters after this page, use the last identifier
+	// as `starting_after`
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+}
+
+// GetDeadLetterResponseBody is the type of the "Zebrahook" service
+// "getDeadLetter" endpoint HTTP response body.
+type GetDeadLetterResponseBody struct {
+	// body of the last response (if any)
+	LastHTTPBodyResponse *string `form:"last_http_body_response,omitempty" json:"last_http_body_response,omitempty" xml:"last_http_body_response,omitempty"`
+	// event that was not delivered
+	Event *EventResponseBody `form:"event,omitempty" json:"event,omitempty" xml:"event,omitempty"`
+	// identifier of the dead letter
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the failed event delivery
+	EventDeliveryID *uint `form:"event_delivery_id,omitempty" json:"event_delivery_id,omitempty" xml:"event_delivery_id,omitempty"`
+	// identifier of the event
+	EventID *uint `form:"event_id,omitempty" json:"event_id,omitempty" xml:"event_id,omitempty"`
+	// event type of the event
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// why the delivery failed
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// how many attempts have been made
+	AttemptsCounter *uint `form:"attempts_counter,omitempty" json:"attempts_counter,omitempty" xml:"attempts_counter,omitempty"`
+	// outcome of the last attempt
+	LastAttemptStatus *string `form:"last_attempt_status,omitempty" json:"last_attempt_status,omitempty" xml:"last_attempt_status,omitempty"`
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int `form:"last_http_status_code,omitempty" json:"last_http_status_code,omitempty" xml:"last_http_status_code,omitempty"`
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64 `form:"last_attempt_made_at,omitempty" json:"last_attempt_made_at,omitempty" xml:"last_attempt_made_at,omitempty"`
+	// dead until the event is delivered again
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint `form:"replay_event_delivery_id,omitempty" json:"replay_event_delivery_id,omitempty" xml:"replay_event_delivery_id,omitempty"`
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64 `form:"replayed_at,omitempty" json:"replayed_at,omitempty" xml:"replayed_at,omitempty"`
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// ReplayDeadLetterResponseBody is the type of the "Zebrahook" service
+// "replayDeadLetter" endpoint HTTP response body.
+type ReplayDeadLetterResponseBody struct {
+	// identifier of the dead letter
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the failed event delivery
+	EventDeliveryID *uint `form:"event_delivery_id,omitempty" json:"event_delivery_id,omitempty" xml:"event_delivery_id,omitempty"`
+	// identifier of the event
+	EventID *uint `form:"event_id,omitempty" json:"event_id,omitempty" xml:"event_id,omitempty"`
+	// event type of the event
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// why the delivery failed
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// how many attempts have been made
+	AttemptsCounter *uint `form:"attempts_counter,omitempty" json:"attempts_counter,omitempty" xml:"attempts_counter,omitempty"`
+	// outcome of the last attempt
+	LastAttemptStatus *string `form:"last_attempt_status,omitempty" json:"last_attempt_status,omitempty" xml:"last_attempt_status,omitempty"`
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int `form:"last_http_status_code,omitempty" json:"last_http_status_code,omitempty" xml:"last_http_status_code,omitempty"`
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64 `form:"last_attempt_made_at,omitempty" json:"last_attempt_made_at,omitempty" xml:"last_attempt_made_at,omitempty"`
+	// dead until the event is delivered again
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint `form:"replay_event_delivery_id,omitempty" json:"replay_event_delivery_id,omitempty" xml:"replay_event_delivery_id,omitempty"`
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64 `form:"replayed_at,omitempty" json:"replayed_at,omitempty" xml:"replayed_at,omitempty"`
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// ReplayDeadLettersResponseBody is the type of the "Zebrahook" service
+// "replayDeadLetters" endpoint HTTP response body.
+type ReplayDeadLettersResponseBody struct {
+	// replayed dead letters
+	Result []*DeadLetterResponseBody `form:"result,omitempty" json:"result,omitempty" xml:"result,omitempty"`
+	// true if there are more dead letters to replay matching the filters
+	HasMore *bool `form:"has_more,omitempty" json:"has_more,omitempty" xml:"has_more,omitempty"`
+}
+
+// DeleteDeadLetterResponseBody is the type of the "Zebrahook" service
+// "deleteDeadLetter" endpoint HTTP response body.
+type DeleteDeadLetterResponseBody struct {
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// PurgeDeadLettersResponseBody is the type of the "Zebrahook" service
+// "purgeDeadLetters" endpoint HTTP response body.
+type PurgeDeadLettersResponseBody struct {
+	// how many dead letters have been removed
+	Purged *int64 `form:"purged,omitempty" json:"purged,omitempty" xml:"purged,omitempty"`
+}
+
 // EventRequestRequestBody is used to define fields on request body types.
 type EventRequestRequestBody struct {
 	// Event type of the `event_content`
@@ -106,6 +632,47 @@ type EventRequestRequestBody struct {
 	// Optional priority for this event, an higher number will make this event
 	// delivered before other ones
 	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Optional idempotency key for this event, if an event with the same key was
+	// already submitted the event is not created again
+	IdempotencyKey *string `form:"idempotency_key,omitempty" json:"idempotency_key,omitempty" xml:"idempotency_key,omitempty"`
+}
+
+// SubmittedEventResultResponseBody is used to define fields on response body
+// types.
+type SubmittedEventResultResponseBody struct {
+	// position of the event in the submitted events
+	Index *int `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// identifier of the event, not present if the event was rejected
+	EventID *uint `form:"event_id,omitempty" json:"event_id,omitempty" xml:"event_id,omitempty"`
+	// normalized event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// `pending_mapping` until the event is mapped to the subscribed webhook
+	// endpoints
+	MappingStatus *string `form:"mapping_status,omitempty" json:"mapping_status,omitempty" xml:"mapping_status,omitempty"`
+	// how many webhook endpoints are subscribed to this event, present once mapped
+	EndpointsMatched *uint `form:"endpoints_matched,omitempty" json:"endpoints_matched,omitempty" xml:"endpoints_matched,omitempty"`
+	// reason why the event was rejected
+	ErrorCode *string `form:"error_code,omitempty" json:"error_code,omitempty" xml:"error_code,omitempty"`
+	// human readable reason why the event was rejected
+	ErrorMessage *string `form:"error_message,omitempty" json:"error_message,omitempty" xml:"error_message,omitempty"`
+}
+
+// DeliveryPolicyRequestBody is used to define fields on request body types.
+type DeliveryPolicyRequestBody struct {
+	// HTTP request timeout in seconds
+	TimeoutSecs *uint `form:"timeout_secs,omitempty" json:"timeout_secs,omitempty" xml:"timeout_secs,omitempty"`
+	// maximum number of delivery attempts of each event
+	MaxAttempts *uint `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// backoff strategy between two attempts: exponential (with full jitter),
+	// linear, fixed or schedule
+	BackoffType *string `form:"backoff_type,omitempty" json:"backoff_type,omitempty" xml:"backoff_type,omitempty"`
+	// base seconds used to calculate the backoff
+	BackoffBaseSecs *uint `form:"backoff_base_secs,omitempty" json:"backoff_base_secs,omitempty" xml:"backoff_base_secs,omitempty"`
+	// maximum seconds between two attempts
+	MaxBackoffSecs *uint `form:"max_backoff_secs,omitempty" json:"max_backoff_secs,omitempty" xml:"max_backoff_secs,omitempty"`
+	// seconds to wait before each retry with the schedule backoff type, the last
+	// one is used for the remaining attempts
+	BackoffScheduleSecs []uint `form:"backoff_schedule_secs,omitempty" json:"backoff_schedule_secs,omitempty" xml:"backoff_schedule_secs,omitempty"`
 }
 
 // WebhookEndpointWithoutSecretResponseBody is used to define fields on
@@ -118,6 +685,13 @@ type WebhookEndpointWithoutSecretResponseBody struct {
 	// status of current endpoint, enabled means that the webhook endpoint is
 	// eligible for receiving webhook events
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// why the endpoint was disabled, not set when enabled
+	DisabledReason *string `form:"disabled_reason,omitempty" json:"disabled_reason,omitempty" xml:"disabled_reason,omitempty"`
+	// circuit breaker state, deliveries are held while open and a single probe
+	// delivery is sent while half open
+	CircuitState *string `form:"circuit_state,omitempty" json:"circuit_state,omitempty" xml:"circuit_state,omitempty"`
+	// when the circuit was opened (unix timestamp seconds)
+	CircuitOpenedAt *int64 `form:"circuit_opened_at,omitempty" json:"circuit_opened_at,omitempty" xml:"circuit_opened_at,omitempty"`
 	// URL of the webhook that will be called on each `enabled_events`
 	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
 	// Enabled events for this webhook URL, regex supported - use `["*"]` to listen
@@ -125,10 +699,201 @@ type WebhookEndpointWithoutSecretResponseBody struct {
 	EnabledEvents []string `form:"enabled_events,omitempty" json:"enabled_events,omitempty" xml:"enabled_events,omitempty"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicyResponseBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
 	// identifier of the webhook
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 }
 
+// DeliveryPolicyResponseBody is used to define fields on response body types.
+type DeliveryPolicyResponseBody struct {
+	// HTTP request timeout in seconds
+	TimeoutSecs *uint `form:"timeout_secs,omitempty" json:"timeout_secs,omitempty" xml:"timeout_secs,omitempty"`
+	// maximum number of delivery attempts of each event
+	MaxAttempts *uint `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// backoff strategy between two attempts: exponential (with full jitter),
+	// linear, fixed or schedule
+	BackoffType *string `form:"backoff_type,omitempty" json:"backoff_type,omitempty" xml:"backoff_type,omitempty"`
+	// base seconds used to calculate the backoff
+	BackoffBaseSecs *uint `form:"backoff_base_secs,omitempty" json:"backoff_base_secs,omitempty" xml:"backoff_base_secs,omitempty"`
+	// maximum seconds between two attempts
+	MaxBackoffSecs *uint `form:"max_backoff_secs,omitempty" json:"max_backoff_secs,omitempty" xml:"max_backoff_secs,omitempty"`
+	// seconds to wait before each retry with the schedule backoff type, the last
+	// one is used for the remaining attempts
+	BackoffScheduleSecs []uint `form:"backoff_schedule_secs,omitempty" json:"backoff_schedule_secs,omitempty" xml:"backoff_schedule_secs,omitempty"`
+}
+
+// EventResponseBody is used to define fields on response body types.
+type EventResponseBody struct {
+	// identifier of the event
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Event type of the `event_content`
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// event content dispatched to the webhook endpoints
+	EventContent map[string]interface{} `form:"event_content,omitempty" json:"event_content,omitempty" xml:"event_content,omitempty"`
+	// priority of this event
+	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// when this event was submitted (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// EventDeliveryResponseBody is used to define fields on response body types.
+type EventDeliveryResponseBody struct {
+	// identifier of the event delivery
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// URL of the webhook endpoint
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// status of this delivery
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// how many attempts have been made so far
+	AttemptsCounter *uint `form:"attempts_counter,omitempty" json:"attempts_counter,omitempty" xml:"attempts_counter,omitempty"`
+	// how many attempts are remaining
+	AttemptsRemaining *uint `form:"attempts_remaining,omitempty" json:"attempts_remaining,omitempty" xml:"attempts_remaining,omitempty"`
+	// when the next attempt is scheduled (unix timestamp seconds)
+	NextAttemptScheduledAt *uint `form:"next_attempt_scheduled_at,omitempty" json:"next_attempt_scheduled_at,omitempty" xml:"next_attempt_scheduled_at,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// EventDeliveryAttemptResponseBody is used to define fields on response body
+// types.
+type EventDeliveryAttemptResponseBody struct {
+	// identifier of the attempt
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the event delivery
+	EventDeliveryID *uint `form:"event_delivery_id,omitempty" json:"event_delivery_id,omitempty" xml:"event_delivery_id,omitempty"`
+	// identifier of the event
+	EventID *uint `form:"event_id,omitempty" json:"event_id,omitempty" xml:"event_id,omitempty"`
+	// event type of the event delivered
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// outcome of this attempt
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// when the request was sent (unix timestamp seconds)
+	AttemptMadeAt *int64 `form:"attempt_made_at,omitempty" json:"attempt_made_at,omitempty" xml:"attempt_made_at,omitempty"`
+	// http status code of the response (if any)
+	HTTPStatusCode *int `form:"http_status_code,omitempty" json:"http_status_code,omitempty" xml:"http_status_code,omitempty"`
+	// response body returned from the endpoint, truncated (if any)
+	HTTPBodyResponse *string `form:"http_body_response,omitempty" json:"http_body_response,omitempty" xml:"http_body_response,omitempty"`
+	// response time in seconds
+	HTTPResponseTimeSecs *float32 `form:"http_response_time_secs,omitempty" json:"http_response_time_secs,omitempty" xml:"http_response_time_secs,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// APIKeyResponseBody is used to define fields on response body types.
+type APIKeyResponseBody struct {
+	// identifier of the api key
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// description for internal use
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// status of the api key, disabled keys are rejected
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant *string `form:"tenant,omitempty" json:"tenant,omitempty" xml:"tenant,omitempty"`
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64 `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// EventTypeResponseBody is used to define fields on response body types.
+type EventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt *int64 `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// EndpointStatusEventResponseBody is used to define fields on response body
+// types.
+type EndpointStatusEventResponseBody struct {
+	// identifier of the status change
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// status before the change
+	OldStatus *string `form:"old_status,omitempty" json:"old_status,omitempty" xml:"old_status,omitempty"`
+	// status after the change
+	NewStatus *string `form:"new_status,omitempty" json:"new_status,omitempty" xml:"new_status,omitempty"`
+	// why the status changed
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// api key that made the change, not set for automatic changes
+	ActorAPIKeyID *uint `form:"actor_api_key_id,omitempty" json:"actor_api_key_id,omitempty" xml:"actor_api_key_id,omitempty"`
+	// jwt subject that made the change, not set for automatic changes
+	ActorSubject *string `form:"actor_subject,omitempty" json:"actor_subject,omitempty" xml:"actor_subject,omitempty"`
+	// when the status changed (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
+// ResponseTimePercentilesResponseBody is used to define fields on response
+// body types.
+type ResponseTimePercentilesResponseBody struct {
+	// median response time in seconds
+	P50 *float64 `form:"p50,omitempty" json:"p50,omitempty" xml:"p50,omitempty"`
+	// 95th percentile of the response time in seconds
+	P95 *float64 `form:"p95,omitempty" json:"p95,omitempty" xml:"p95,omitempty"`
+	// 99th percentile of the response time in seconds
+	P99 *float64 `form:"p99,omitempty" json:"p99,omitempty" xml:"p99,omitempty"`
+}
+
+// DeadLetterResponseBody is used to define fields on response body types.
+type DeadLetterResponseBody struct {
+	// identifier of the dead letter
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// identifier of the failed event delivery
+	EventDeliveryID *uint `form:"event_delivery_id,omitempty" json:"event_delivery_id,omitempty" xml:"event_delivery_id,omitempty"`
+	// identifier of the event
+	EventID *uint `form:"event_id,omitempty" json:"event_id,omitempty" xml:"event_id,omitempty"`
+	// event type of the event
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// identifier of the webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// why the delivery failed
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// how many attempts have been made
+	AttemptsCounter *uint `form:"attempts_counter,omitempty" json:"attempts_counter,omitempty" xml:"attempts_counter,omitempty"`
+	// outcome of the last attempt
+	LastAttemptStatus *string `form:"last_attempt_status,omitempty" json:"last_attempt_status,omitempty" xml:"last_attempt_status,omitempty"`
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int `form:"last_http_status_code,omitempty" json:"last_http_status_code,omitempty" xml:"last_http_status_code,omitempty"`
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64 `form:"last_attempt_made_at,omitempty" json:"last_attempt_made_at,omitempty" xml:"last_attempt_made_at,omitempty"`
+	// dead until the event is delivered again
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint `form:"replay_event_delivery_id,omitempty" json:"replay_event_delivery_id,omitempty" xml:"replay_event_delivery_id,omitempty"`
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64 `form:"replayed_at,omitempty" json:"replayed_at,omitempty" xml:"replayed_at,omitempty"`
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt *int64 `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
 // NewSubmitNewEventsRequestBody builds the HTTP request body from the payload
 // of the "submitNewEvents" endpoint of the "Zebrahook" service.
 func NewSubmitNewEventsRequestBody(p *zebrahook.SubmitNewEventsPayload) *SubmitNewEventsRequestBody {
@@ -162,6 +927,17 @@ func NewRegisterRequestBody(p *zebrahook.RegisterPayload) *RegisterRequestBody {
 			body.Metadata[tk] = tv
 		}
 	}
+	if p.Headers != nil {
+		body.Headers = make(map[string]string, len(p.Headers))
+		for key, val := range p.Headers {
+			tk := key
+			tv := val
+			body.Headers[tk] = tv
+		}
+	}
+	if p.DeliveryPolicy != nil {
+		body.DeliveryPolicy = marshalZebrahookDeliveryPolicyToDeliveryPolicyRequestBody(p.DeliveryPolicy)
+	}
 	return body
 }
 
@@ -186,6 +962,165 @@ func NewUpdateRequestBody(p *zebrahook.UpdatePayload) *UpdateRequestBody {
 			body.Metadata[tk] = tv
 		}
 	}
+	if p.Headers != nil {
+		body.Headers = make(map[string]string, len(p.Headers))
+		for key, val := range p.Headers {
+			tk := key
+			tv := val
+			body.Headers[tk] = tv
+		}
+	}
+	if p.DeliveryPolicy != nil {
+		body.DeliveryPolicy = marshalZebrahookDeliveryPolicyToDeliveryPolicyRequestBody(p.DeliveryPolicy)
+	}
+	return body
+}
+
+// NewRecoverWebhookEndpointRequestBody builds the HTTP request body from the
+// payload of the "recoverWebhookEndpoint" endpoint of the "Zebrahook" service.
+func NewRecoverWebhookEndpointRequestBody(p *zebrahook.RecoverWebhookEndpointPayload) *RecoverWebhookEndpointRequestBody {
+	body := &RecoverWebhookEndpointRequestBody{
+		Since: p.Since,
+	}
+	return body
+}
+
+// NewRotateWebhookEndpointSecretRequestBody builds the HTTP request body from
+// the payload of the "rotateWebhookEndpointSecret" endpoint of the "Zebrahook"
+// service.
+func NewRotateWebhookEndpointSecretRequestBody(p *zebrahook.RotateWebhookEndpointSecretPayload) *RotateWebhookEndpointSecretRequestBody {
+	body := &RotateWebhookEndpointSecretRequestBody{
+		GracePeriodSecs: p.GracePeriodSecs,
+	}
+	return body
+}
+
+// NewTestWebhookEndpointRequestBody builds the HTTP request body from the
+// payload of the "testWebhookEndpoint" endpoint of the "Zebrahook" service.
+func NewTestWebhookEndpointRequestBody(p *zebrahook.TestWebhookEndpointPayload) *TestWebhookEndpointRequestBody {
+	body := &TestWebhookEndpointRequestBody{
+		EventType: p.EventType,
+	}
+	if p.EventContent != nil {
+		body.EventContent = make(map[string]interface{}, len(p.EventContent))
+		for key, val := range p.EventContent {
+			tk := key
+			tv := val
+			body.EventContent[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewUpdateAPIKeyRequestBody builds the HTTP request body from the payload of
+// the "updateApiKey" endpoint of the "Zebrahook" service.
+func NewUpdateAPIKeyRequestBody(p *zebrahook.UpdateAPIKeyPayload) *UpdateAPIKeyRequestBody {
+	body := &UpdateAPIKeyRequestBody{
+		Description: p.Description,
+		ExpiresAt:   p.ExpiresAt,
+	}
+	if p.Scopes != nil {
+		body.Scopes = make([]string, len(p.Scopes))
+		for i, val := range p.Scopes {
+			body.Scopes[i] = val
+		}
+	}
+	return body
+}
+
+// NewRegisterEventTypeRequestBody builds the HTTP request body from the
+// payload of the "registerEventType" endpoint of the "Zebrahook" service.
+func NewRegisterEventTypeRequestBody(p *zebrahook.RegisterEventTypePayload) *RegisterEventTypeRequestBody {
+	body := &RegisterEventTypeRequestBody{
+		Name:        p.Name,
+		Description: p.Description,
+		Deprecated:  p.Deprecated,
+	}
+	if p.Schema != nil {
+		body.Schema = make(map[string]interface{}, len(p.Schema))
+		for key, val := range p.Schema {
+			tk := key
+			tv := val
+			body.Schema[tk] = tv
+		}
+	}
+	if p.Example != nil {
+		body.Example = make(map[string]interface{}, len(p.Example))
+		for key, val := range p.Example {
+			tk := key
+			tv := val
+			body.Example[tk] = tv
+		}
+	}
+	{
+		var zero bool
+		if body.Deprecated == zero {
+			body.Deprecated = false
+		}
+	}
+	return body
+}
+
+// NewUpdateEventTypeRequestBody builds the HTTP request body from the payload
+// of the "updateEventType" endpoint of the "Zebrahook" service.
+func NewUpdateEventTypeRequestBody(p *zebrahook.UpdateEventTypePayload) *UpdateEventTypeRequestBody {
+	body := &UpdateEventTypeRequestBody{
+		Description: p.Description,
+		Deprecated:  p.Deprecated,
+	}
+	if p.Schema != nil {
+		body.Schema = make(map[string]interface{}, len(p.Schema))
+		for key, val := range p.Schema {
+			tk := key
+			tv := val
+			body.Schema[tk] = tv
+		}
+	}
+	if p.Example != nil {
+		body.Example = make(map[string]interface{}, len(p.Example))
+		for key, val := range p.Example {
+			tk := key
+			tv := val
+			body.Example[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewReplayDeadLettersRequestBody builds the HTTP request body from the
+// payload of the "replayDeadLetters" endpoint of the "Zebrahook" service.
+func NewReplayDeadLettersRequestBody(p *zebrahook.ReplayDeadLettersPayload) *ReplayDeadLettersRequestBody {
+	body := &ReplayDeadLettersRequestBody{
+		EndpointID:   p.EndpointID,
+		EventType:    p.EventType,
+		CreatedAtGte: p.CreatedAtGte,
+		CreatedAtLt:  p.CreatedAtLt,
+		Limit:        p.Limit,
+	}
+	if p.Ids != nil {
+		body.Ids = make([]uint, len(p.Ids))
+		for i, val := range p.Ids {
+			body.Ids[i] = val
+		}
+	}
+	{
+		var zero int32
+		if body.Limit == zero {
+			body.Limit = 100
+		}
+	}
+	return body
+}
+
+// NewPurgeDeadLettersRequestBody builds the HTTP request body from the payload
+// of the "purgeDeadLetters" endpoint of the "Zebrahook" service.
+func NewPurgeDeadLettersRequestBody(p *zebrahook.PurgeDeadLettersPayload) *PurgeDeadLettersRequestBody {
+	body := &PurgeDeadLettersRequestBody{
+		Status:      p.Status,
+		EndpointID:  p.EndpointID,
+		EventType:   p.EventType,
+		CreatedAtLt: p.CreatedAtLt,
+	}
 	return body
 }
 
@@ -193,7 +1128,11 @@ func NewUpdateRequestBody(p *zebrahook.UpdatePayload) *UpdateRequestBody {
 // endpoint result from a HTTP "OK" response.
 func NewSubmitNewEventsResultOK(body *SubmitNewEventsResponseBody) *zebrahook.SubmitNewEventsResult {
 	v := &zebrahook.SubmitNewEventsResult{
-		Success: body.Success,
+		Success: *body.Success,
+	}
+	v.Results = make([]*zebrahook.SubmittedEventResult, len(body.Results))
+	for i, val := range body.Results {
+		v.Results[i] = unmarshalSubmittedEventResultResponseBodyToZebrahookSubmittedEventResult(val)
 	}
 
 	return v
@@ -236,12 +1175,15 @@ func NewListWebhookEndpointResultOK(body *ListWebhookEndpointResponseBody) *zebr
 // "getWebhookEndpointById" endpoint result from a HTTP "OK" response.
 func NewGetWebhookEndpointByIDWebhookEndpointOK(body *GetWebhookEndpointByIDResponseBody) *zebrahook.WebhookEndpoint {
 	v := &zebrahook.WebhookEndpoint{
-		CreatedAt: *body.CreatedAt,
-		UpdatedAt: *body.UpdatedAt,
-		Status:    body.Status,
-		URL:       *body.URL,
-		ID:        *body.ID,
-		Secret:    *body.Secret,
+		CreatedAt:       *body.CreatedAt,
+		UpdatedAt:       *body.UpdatedAt,
+		Status:          body.Status,
+		DisabledReason:  body.DisabledReason,
+		CircuitState:    body.CircuitState,
+		CircuitOpenedAt: body.CircuitOpenedAt,
+		URL:             *body.URL,
+		ID:              *body.ID,
+		Secret:          *body.Secret,
 	}
 	v.EnabledEvents = make([]string, len(body.EnabledEvents))
 	for i, val := range body.EnabledEvents {
@@ -255,18 +1197,501 @@ func NewGetWebhookEndpointByIDWebhookEndpointOK(body *GetWebhookEndpointByIDResp
 			v.Metadata[tk] = tv
 		}
 	}
+	if body.Headers != nil {
+		v.Headers = make(map[string]string, len(body.Headers))
+		for key, val := range body.Headers {
+			tk := key
+			tv := val
+			v.Headers[tk] = tv
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		v.DeliveryPolicy = unmarshalDeliveryPolicyResponseBodyToZebrahookDeliveryPolicy(body.DeliveryPolicy)
+	}
 
 	return v
 }
 
-// ValidateRegisterResponseBody runs the validations defined on
-// RegisterResponseBody
-func ValidateRegisterResponseBody(body *RegisterResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+// NewDeleteWebhookEndpointResultOK builds a "Zebrahook" service
+// "deleteWebhookEndpoint" endpoint result from a HTTP "OK" response.
+func NewDeleteWebhookEndpointResultOK(body *DeleteWebhookEndpointResponseBody) *zebrahook.DeleteWebhookEndpointResult {
+	v := &zebrahook.DeleteWebhookEndpointResult{
+		Success: body.Success,
 	}
-	if body.Secret == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("secret", "body"))
+
+	return v
+}
+
+// NewListEventsResultOK builds a "Zebrahook" service "listEvents" endpoint
+// result from a HTTP "OK" response.
+func NewListEventsResultOK(body *ListEventsResponseBody) *zebrahook.ListEventsResult {
+	v := &zebrahook.ListEventsResult{
+		HasMore: *body.HasMore,
+	}
+	v.Result = make([]*zebrahook.Event, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalEventResponseBodyToZebrahookEvent(val)
+	}
+
+	return v
+}
+
+// NewGetEventByIDEventWithDeliveriesOK builds a "Zebrahook" service
+// "getEventById" endpoint result from a HTTP "OK" response.
+func NewGetEventByIDEventWithDeliveriesOK(body *GetEventByIDResponseBody) *zebrahook.EventWithDeliveries {
+	v := &zebrahook.EventWithDeliveries{
+		ID:        *body.ID,
+		EventType: *body.EventType,
+		Priority:  *body.Priority,
+		CreatedAt: *body.CreatedAt,
+	}
+	v.Deliveries = make([]*zebrahook.EventDelivery, len(body.Deliveries))
+	for i, val := range body.Deliveries {
+		v.Deliveries[i] = unmarshalEventDeliveryResponseBodyToZebrahookEventDelivery(val)
+	}
+	v.EventContent = make(map[string]interface{}, len(body.EventContent))
+	for key, val := range body.EventContent {
+		tk := key
+		tv := val
+		v.EventContent[tk] = tv
+	}
+
+	return v
+}
+
+// NewListWebhookEndpointAttemptsResultOK builds a "Zebrahook" service
+// "listWebhookEndpointAttempts" endpoint result from a HTTP "OK" response.
+func NewListWebhookEndpointAttemptsResultOK(body *ListWebhookEndpointAttemptsResponseBody) *zebrahook.ListWebhookEndpointAttemptsResult {
+	v := &zebrahook.ListWebhookEndpointAttemptsResult{
+		HasMore: *body.HasMore,
+	}
+	v.Result = make([]*zebrahook.EventDeliveryAttempt, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalEventDeliveryAttemptResponseBodyToZebrahookEventDeliveryAttempt(val)
+	}
+
+	return v
+}
+
+// NewRetryEventDeliveryEventDeliveryOK builds a "Zebrahook" service
+// "retryEventDelivery" endpoint result from a HTTP "OK" response.
+func NewRetryEventDeliveryEventDeliveryOK(body *RetryEventDeliveryResponseBody) *zebrahook.EventDelivery {
+	v := &zebrahook.EventDelivery{
+		ID:                     *body.ID,
+		EndpointID:             *body.EndpointID,
+		EndpointURL:            body.EndpointURL,
+		Status:                 *body.Status,
+		AttemptsCounter:        *body.AttemptsCounter,
+		AttemptsRemaining:      *body.AttemptsRemaining,
+		NextAttemptScheduledAt: body.NextAttemptScheduledAt,
+		CreatedAt:              *body.CreatedAt,
+		UpdatedAt:              *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewRecoverWebhookEndpointEndpointRecoveryOK builds a "Zebrahook" service
+// "recoverWebhookEndpoint" endpoint result from a HTTP "OK" response.
+func NewRecoverWebhookEndpointEndpointRecoveryOK(body *RecoverWebhookEndpointResponseBody) *zebrahook.EndpointRecovery {
+	v := &zebrahook.EndpointRecovery{
+		ID:                 *body.ID,
+		EndpointID:         *body.EndpointID,
+		Since:              *body.Since,
+		Status:             *body.Status,
+		DeliveriesFound:    *body.DeliveriesFound,
+		DeliveriesEnqueued: *body.DeliveriesEnqueued,
+		CreatedAt:          *body.CreatedAt,
+		UpdatedAt:          *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewGetWebhookEndpointRecoveryEndpointRecoveryOK builds a "Zebrahook" service
+// "getWebhookEndpointRecovery" endpoint result from a HTTP "OK" response.
+func NewGetWebhookEndpointRecoveryEndpointRecoveryOK(body *GetWebhookEndpointRecoveryResponseBody) *zebrahook.EndpointRecovery {
+	v := &zebrahook.EndpointRecovery{
+		ID:                 *body.ID,
+		EndpointID:         *body.EndpointID,
+		Since:              *body.Since,
+		Status:             *body.Status,
+		DeliveriesFound:    *body.DeliveriesFound,
+		DeliveriesEnqueued: *body.DeliveriesEnqueued,
+		CreatedAt:          *body.CreatedAt,
+		UpdatedAt:          *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewRotateWebhookEndpointSecretResultOK builds a "Zebrahook" service
+// "rotateWebhookEndpointSecret" endpoint result from a HTTP "OK" response.
+func NewRotateWebhookEndpointSecretResultOK(body *RotateWebhookEndpointSecretResponseBody) *zebrahook.RotateWebhookEndpointSecretResult {
+	v := &zebrahook.RotateWebhookEndpointSecretResult{
+		PreviousSecretExpiresAt: *body.PreviousSecretExpiresAt,
+		ID:                      *body.ID,
+		Secret:                  *body.Secret,
+	}
+
+	return v
+}
+
+// NewTestWebhookEndpointResultOK builds a "Zebrahook" service
+// "testWebhookEndpoint" endpoint result from a HTTP "OK" response.
+func NewTestWebhookEndpointResultOK(body *TestWebhookEndpointResponseBody) *zebrahook.TestWebhookEndpointResult {
+	v := &zebrahook.TestWebhookEndpointResult{
+		Status:               *body.Status,
+		HTTPStatusCode:       body.HTTPStatusCode,
+		HTTPBodyResponse:     body.HTTPBodyResponse,
+		HTTPResponseTimeSecs: *body.HTTPResponseTimeSecs,
+	}
+
+	return v
+}
+
+// NewListAPIKeysResultOK builds a "Zebrahook" service "listApiKeys" endpoint
+// result from a HTTP "OK" response.
+func NewListAPIKeysResultOK(body *ListAPIKeysResponseBody) *zebrahook.ListAPIKeysResult {
+	v := &zebrahook.ListAPIKeysResult{}
+	v.Result = make([]*zebrahook.APIKey, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalAPIKeyResponseBodyToZebrahookAPIKey(val)
+	}
+
+	return v
+}
+
+// NewGetAPIKeyAPIKeyOK builds a "Zebrahook" service "getApiKey" endpoint
+// result from a HTTP "OK" response.
+func NewGetAPIKeyAPIKeyOK(body *GetAPIKeyResponseBody) *zebrahook.APIKey {
+	v := &zebrahook.APIKey{
+		ID:          *body.ID,
+		Description: *body.Description,
+		Status:      *body.Status,
+		Tenant:      *body.Tenant,
+		ExpiresAt:   body.ExpiresAt,
+		LastUsedAt:  body.LastUsedAt,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewUpdateAPIKeyAPIKeyOK builds a "Zebrahook" service "updateApiKey" endpoint
+// result from a HTTP "OK" response.
+func NewUpdateAPIKeyAPIKeyOK(body *UpdateAPIKeyResponseBody) *zebrahook.APIKey {
+	v := &zebrahook.APIKey{
+		ID:          *body.ID,
+		Description: *body.Description,
+		Status:      *body.Status,
+		Tenant:      *body.Tenant,
+		ExpiresAt:   body.ExpiresAt,
+		LastUsedAt:  body.LastUsedAt,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+	v.Scopes = make([]string, len(body.Scopes))
+	for i, val := range body.Scopes {
+		v.Scopes[i] = val
+	}
+
+	return v
+}
+
+// NewRevokeAPIKeyResultOK builds a "Zebrahook" service "revokeApiKey" endpoint
+// result from a HTTP "OK" response.
+func NewRevokeAPIKeyResultOK(body *RevokeAPIKeyResponseBody) *zebrahook.RevokeAPIKeyResult {
+	v := &zebrahook.RevokeAPIKeyResult{
+		Success: body.Success,
+	}
+
+	return v
+}
+
+// NewRegisterEventTypeEventTypeOK builds a "Zebrahook" service
+// "registerEventType" endpoint result from a HTTP "OK" response.
+func NewRegisterEventTypeEventTypeOK(body *RegisterEventTypeResponseBody) *zebrahook.EventType {
+	v := &zebrahook.EventType{
+		Name:        *body.Name,
+		Description: body.Description,
+		Deprecated:  *body.Deprecated,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewListEventTypesResultOK builds a "Zebrahook" service "listEventTypes"
+// endpoint result from a HTTP "OK" response.
+func NewListEventTypesResultOK(body *ListEventTypesResponseBody) *zebrahook.ListEventTypesResult {
+	v := &zebrahook.ListEventTypesResult{}
+	v.Result = make([]*zebrahook.EventType, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalEventTypeResponseBodyToZebrahookEventType(val)
+	}
+
+	return v
+}
+
+// NewGetEventTypeEventTypeOK builds a "Zebrahook" service "getEventType"
+// endpoint result from a HTTP "OK" response.
+func NewGetEventTypeEventTypeOK(body *GetEventTypeResponseBody) *zebrahook.EventType {
+	v := &zebrahook.EventType{
+		Name:        *body.Name,
+		Description: body.Description,
+		Deprecated:  *body.Deprecated,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewUpdateEventTypeEventTypeOK builds a "Zebrahook" service "updateEventType"
+// endpoint result from a HTTP "OK" response.
+func NewUpdateEventTypeEventTypeOK(body *UpdateEventTypeResponseBody) *zebrahook.EventType {
+	v := &zebrahook.EventType{
+		Name:        *body.Name,
+		Description: body.Description,
+		Deprecated:  *body.Deprecated,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetEventTypesCatalogResultOK builds a "Zebrahook" service
+// "getEventTypesCatalog" endpoint result from a HTTP "OK" response.
+func NewGetEventTypesCatalogResultOK(contentType string) *zebrahook.GetEventTypesCatalogResult {
+	v := &zebrahook.GetEventTypesCatalogResult{}
+	v.ContentType = contentType
+
+	return v
+}
+
+// NewGetWebhookEndpointStatusHistoryResultOK builds a "Zebrahook" service
+// "getWebhookEndpointStatusHistory" endpoint result from a HTTP "OK" response.
+func NewGetWebhookEndpointStatusHistoryResultOK(body *GetWebhookEndpointStatusHistoryResponseBody) *zebrahook.GetWebhookEndpointStatusHistoryResult {
+	v := &zebrahook.GetWebhookEndpointStatusHistoryResult{
+		HasMore: *body.HasMore,
+	}
+	v.Result = make([]*zebrahook.EndpointStatusEvent, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalEndpointStatusEventResponseBodyToZebrahookEndpointStatusEvent(val)
+	}
+
+	return v
+}
+
+// NewGetWebhookEndpointStatsEndpointStatsOK builds a "Zebrahook" service
+// "getWebhookEndpointStats" endpoint result from a HTTP "OK" response.
+func NewGetWebhookEndpointStatsEndpointStatsOK(body *GetWebhookEndpointStatsResponseBody) *zebrahook.EndpointStats {
+	v := &zebrahook.EndpointStats{
+		EndpointID:  *body.EndpointID,
+		Window:      *body.Window,
+		From:        *body.From,
+		To:          *body.To,
+		Attempts:    *body.Attempts,
+		SuccessRate: body.SuccessRate,
+	}
+	v.StatusCounts = make(map[string]uint, len(body.StatusCounts))
+	for key, val := range body.StatusCounts {
+		tk := key
+		tv := val
+		v.StatusCounts[tk] = tv
+	}
+	v.HTTPStatusCodes = make(map[string]uint, len(body.HTTPStatusCodes))
+	for key, val := range body.HTTPStatusCodes {
+		tk := key
+		tv := val
+		v.HTTPStatusCodes[tk] = tv
+	}
+	if body.ResponseTimeSecs != nil {
+		v.ResponseTimeSecs = unmarshalResponseTimePercentilesResponseBodyToZebrahookResponseTimePercentiles(body.ResponseTimeSecs)
+	}
+
+	return v
+}
+
+// NewListDeadLettersResultOK builds a "Zebrahook" service "listDeadLetters"
+// endpoint result from a HTTP "OK" response.
+func NewListDeadLettersResultOK(body *ListDeadLettersResponseBody) *zebrahook.ListDeadLettersResult {
+	v := &zebrahook.ListDeadLettersResult{
+		HasMore: *body.HasMore,
+	}
+	v.Result = make([]*zebrahook.DeadLetter, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalDeadLetterResponseBodyToZebrahookDeadLetter(val)
+	}
+
+	return v
+}
+
+// NewGetDeadLetterDeadLetterDetailsOK builds a "Zebrahook" service
+// "getDeadLetter" endpoint result from a HTTP "OK" response.
+func NewGetDeadLetterDeadLetterDetailsOK(body *GetDeadLetterResponseBody) *zebrahook.DeadLetterDetails {
+	v := &zebrahook.DeadLetterDetails{
+		LastHTTPBodyResponse:  body.LastHTTPBodyResponse,
+		ID:                    *body.ID,
+		EventDeliveryID:       *body.EventDeliveryID,
+		EventID:               *body.EventID,
+		EventType:             *body.EventType,
+		EndpointID:            *body.EndpointID,
+		Reason:                *body.Reason,
+		AttemptsCounter:       *body.AttemptsCounter,
+		LastAttemptStatus:     *body.LastAttemptStatus,
+		LastHTTPStatusCode:    body.LastHTTPStatusCode,
+		LastAttemptMadeAt:     body.LastAttemptMadeAt,
+		Status:                *body.Status,
+		ReplayEventDeliveryID: body.ReplayEventDeliveryID,
+		ReplayedAt:            body.ReplayedAt,
+		CreatedAt:             *body.CreatedAt,
+	}
+	v.Event = unmarshalEventResponseBodyToZebrahookEvent(body.Event)
+
+	return v
+}
+
+// NewReplayDeadLetterDeadLetterOK builds a "Zebrahook" service
+// "replayDeadLetter" endpoint result from a HTTP "OK" response.
+func NewReplayDeadLetterDeadLetterOK(body *ReplayDeadLetterResponseBody) *zebrahook.DeadLetter {
+	v := &zebrahook.DeadLetter{
+		ID:                    *body.ID,
+		EventDeliveryID:       *body.EventDeliveryID,
+		EventID:               *body.EventID,
+		EventType:             *body.EventType,
+		EndpointID:            *body.EndpointID,
+		Reason:                *body.Reason,
+		AttemptsCounter:       *body.AttemptsCounter,
+		LastAttemptStatus:     *body.LastAttemptStatus,
+		LastHTTPStatusCode:    body.LastHTTPStatusCode,
+		LastAttemptMadeAt:     body.LastAttemptMadeAt,
+		Status:                *body.Status,
+		ReplayEventDeliveryID: body.ReplayEventDeliveryID,
+		ReplayedAt:            body.ReplayedAt,
+		CreatedAt:             *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewReplayDeadLettersResultOK builds a "Zebrahook" service
+// "replayDeadLetters" endpoint result from a HTTP "OK" response.
+func NewReplayDeadLettersResultOK(body *ReplayDeadLettersResponseBody) *zebrahook.ReplayDeadLettersResult {
+	v := &zebrahook.ReplayDeadLettersResult{
+		HasMore: *body.HasMore,
+	}
+	v.Result = make([]*zebrahook.DeadLetter, len(body.Result))
+	for i, val := range body.Result {
+		v.Result[i] = unmarshalDeadLetterResponseBodyToZebrahookDeadLetter(val)
+	}
+
+	return v
+}
+
+// NewDeleteDeadLetterResultOK builds a "Zebrahook" service "deleteDeadLetter"
+// endpoint result from a HTTP "OK" response.
+func NewDeleteDeadLetterResultOK(body *DeleteDeadLetterResponseBody) *zebrahook.DeleteDeadLetterResult {
+	v := &zebrahook.DeleteDeadLetterResult{
+		Success: body.Success,
+	}
+
+	return v
+}
+
+// NewPurgeDeadLettersResultOK builds a "Zebrahook" service "purgeDeadLetters"
+// endpoint result from a HTTP "OK" response.
+func NewPurgeDeadLettersResultOK(body *PurgeDeadLettersResponseBody) *zebrahook.PurgeDeadLettersResult {
+	v := &zebrahook.PurgeDeadLettersResult{
+		Purged: *body.Purged,
+	}
+
+	return v
+}
+
+// ValidateSubmitNewEventsResponseBody runs the validations defined on
+// SubmitNewEventsResponseBody
+func ValidateSubmitNewEventsResponseBody(body *SubmitNewEventsResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateSubmittedEventResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRegisterResponseBody runs the validations defined on
+// RegisterResponseBody
+func ValidateRegisterResponseBody(body *RegisterResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Secret == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secret", "body"))
 	}
 	return
 }
@@ -313,6 +1738,16 @@ func ValidateGetWebhookEndpointByIDResponseBody(body *GetWebhookEndpointByIDResp
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"enabled", "disabled"}))
 		}
 	}
+	if body.DisabledReason != nil {
+		if !(*body.DisabledReason == "manual" || *body.DisabledReason == "max_attempts_exceeded" || *body.DisabledReason == "http_410_gone") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.disabled_reason", *body.DisabledReason, []interface{}{"manual", "max_attempts_exceeded", "http_410_gone"}))
+		}
+	}
+	if body.CircuitState != nil {
+		if !(*body.CircuitState == "closed" || *body.CircuitState == "open" || *body.CircuitState == "half_open") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.circuit_state", *body.CircuitState, []interface{}{"closed", "open", "half_open"}))
+		}
+	}
 	if body.URL != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
 	}
@@ -327,54 +1762,1024 @@ func ValidateGetWebhookEndpointByIDResponseBody(body *GetWebhookEndpointByIDResp
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata[key]", v, utf8.RuneCountInString(v), 1, true))
 		}
 	}
+	for k, v := range body.Headers {
+		if utf8.RuneCountInString(k) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers.key", k, utf8.RuneCountInString(k), 1, true))
+		}
+		if utf8.RuneCountInString(v) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers[key]", v, utf8.RuneCountInString(v), 1, true))
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		if err2 := ValidateDeliveryPolicyResponseBody(body.DeliveryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
-// ValidateEventRequestRequestBody runs the validations defined on
-// EventRequestRequestBody
-func ValidateEventRequestRequestBody(body *EventRequestRequestBody) (err error) {
-	if body.EventContent == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("event_content", "body"))
+// ValidateListEventsResponseBody runs the validations defined on
+// ListEventsResponseBody
+func ValidateListEventsResponseBody(body *ListEventsResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	if body.HasMore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
+	}
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateEventResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
 	return
 }
 
-// ValidateWebhookEndpointWithoutSecretResponseBody runs the validations
-// defined on WebhookEndpointWithoutSecretResponseBody
-func ValidateWebhookEndpointWithoutSecretResponseBody(body *WebhookEndpointWithoutSecretResponseBody) (err error) {
+// ValidateGetEventByIDResponseBody runs the validations defined on
+// GetEventByIdResponseBody
+func ValidateGetEventByIDResponseBody(body *GetEventByIDResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.URL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
 	}
-	if body.EnabledEvents == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("enabled_events", "body"))
+	if body.EventContent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_content", "body"))
+	}
+	if body.Priority == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("priority", "body"))
 	}
 	if body.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	if body.Deliveries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries", "body"))
 	}
-	if body.Status != nil {
-		if !(*body.Status == "enabled" || *body.Status == "disabled") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"enabled", "disabled"}))
+	for _, e := range body.Deliveries {
+		if e != nil {
+			if err2 := ValidateEventDeliveryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
 	}
-	if body.URL != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	return
+}
+
+// ValidateListWebhookEndpointAttemptsResponseBody runs the validations defined
+// on ListWebhookEndpointAttemptsResponseBody
+func ValidateListWebhookEndpointAttemptsResponseBody(body *ListWebhookEndpointAttemptsResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
 	}
-	if len(body.EnabledEvents) < 1 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError("body.enabled_events", body.EnabledEvents, len(body.EnabledEvents), 1, true))
+	if body.HasMore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
 	}
-	for k, v := range body.Metadata {
-		if utf8.RuneCountInString(k) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata.key", k, utf8.RuneCountInString(k), 1, true))
-		}
-		if utf8.RuneCountInString(v) < 1 {
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateEventDeliveryAttemptResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRetryEventDeliveryResponseBody runs the validations defined on
+// RetryEventDeliveryResponseBody
+func ValidateRetryEventDeliveryResponseBody(body *RetryEventDeliveryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.AttemptsCounter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_counter", "body"))
+	}
+	if body.AttemptsRemaining == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_remaining", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "success" || *body.Status == "failed" || *body.Status == "cancelled" || *body.Status == "held") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"pending", "success", "failed", "cancelled", "held"}))
+		}
+	}
+	return
+}
+
+// ValidateRecoverWebhookEndpointResponseBody runs the validations defined on
+// RecoverWebhookEndpointResponseBody
+func ValidateRecoverWebhookEndpointResponseBody(body *RecoverWebhookEndpointResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Since == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("since", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.DeliveriesFound == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries_found", "body"))
+	}
+	if body.DeliveriesEnqueued == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries_enqueued", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "running" || *body.Status == "completed" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"pending", "running", "completed", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateGetWebhookEndpointRecoveryResponseBody runs the validations defined
+// on GetWebhookEndpointRecoveryResponseBody
+func ValidateGetWebhookEndpointRecoveryResponseBody(body *GetWebhookEndpointRecoveryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Since == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("since", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.DeliveriesFound == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries_found", "body"))
+	}
+	if body.DeliveriesEnqueued == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries_enqueued", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "running" || *body.Status == "completed" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"pending", "running", "completed", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateRotateWebhookEndpointSecretResponseBody runs the validations defined
+// on RotateWebhookEndpointSecretResponseBody
+func ValidateRotateWebhookEndpointSecretResponseBody(body *RotateWebhookEndpointSecretResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Secret == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secret", "body"))
+	}
+	if body.PreviousSecretExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("previous_secret_expires_at", "body"))
+	}
+	return
+}
+
+// ValidateTestWebhookEndpointResponseBody runs the validations defined on
+// TestWebhookEndpointResponseBody
+func ValidateTestWebhookEndpointResponseBody(body *TestWebhookEndpointResponseBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.HTTPResponseTimeSecs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("http_response_time_secs", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "success" || *body.Status == "error_timeout" || *body.Status == "error_response" || *body.Status == "error_network" || *body.Status == "error_blocked" || *body.Status == "error_internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal"}))
+		}
+	}
+	return
+}
+
+// ValidateListAPIKeysResponseBody runs the validations defined on
+// ListApiKeysResponseBody
+func ValidateListAPIKeysResponseBody(body *ListAPIKeysResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateAPIKeyResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetAPIKeyResponseBody runs the validations defined on
+// GetApiKeyResponseBody
+func ValidateGetAPIKeyResponseBody(body *GetAPIKeyResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.Tenant == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tenant", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "enabled" || *body.Status == "disabled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"enabled", "disabled"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateAPIKeyResponseBody runs the validations defined on
+// UpdateApiKeyResponseBody
+func ValidateUpdateAPIKeyResponseBody(body *UpdateAPIKeyResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.Tenant == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tenant", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "enabled" || *body.Status == "disabled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"enabled", "disabled"}))
+		}
+	}
+	return
+}
+
+// ValidateRegisterEventTypeResponseBody runs the validations defined on
+// RegisterEventTypeResponseBody
+func ValidateRegisterEventTypeResponseBody(body *RegisterEventTypeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Deprecated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deprecated", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	return
+}
+
+// ValidateListEventTypesResponseBody runs the validations defined on
+// ListEventTypesResponseBody
+func ValidateListEventTypesResponseBody(body *ListEventTypesResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateEventTypeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetEventTypeResponseBody runs the validations defined on
+// GetEventTypeResponseBody
+func ValidateGetEventTypeResponseBody(body *GetEventTypeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Deprecated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deprecated", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	return
+}
+
+// ValidateUpdateEventTypeResponseBody runs the validations defined on
+// UpdateEventTypeResponseBody
+func ValidateUpdateEventTypeResponseBody(body *UpdateEventTypeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Deprecated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deprecated", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	return
+}
+
+// ValidateGetWebhookEndpointStatusHistoryResponseBody runs the validations
+// defined on GetWebhookEndpointStatusHistoryResponseBody
+func ValidateGetWebhookEndpointStatusHistoryResponseBody(body *GetWebhookEndpointStatusHistoryResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	if body.HasMore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
+	}
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateEndpointStatusEventResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetWebhookEndpointStatsResponseBody runs the validations defined on
+// GetWebhookEndpointStatsResponseBody
+func ValidateGetWebhookEndpointStatsResponseBody(body *GetWebhookEndpointStatsResponseBody) (err error) {
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Window == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window", "body"))
+	}
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.StatusCounts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status_counts", "body"))
+	}
+	if body.HTTPStatusCodes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("http_status_codes", "body"))
+	}
+	if body.Window != nil {
+		if !(*body.Window == "1h" || *body.Window == "24h" || *body.Window == "7d") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window", *body.Window, []interface{}{"1h", "24h", "7d"}))
+		}
+	}
+	if body.ResponseTimeSecs != nil {
+		if err2 := ValidateResponseTimePercentilesResponseBody(body.ResponseTimeSecs); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListDeadLettersResponseBody runs the validations defined on
+// ListDeadLettersResponseBody
+func ValidateListDeadLettersResponseBody(body *ListDeadLettersResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	if body.HasMore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
+	}
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateDeadLetterResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetDeadLetterResponseBody runs the validations defined on
+// GetDeadLetterResponseBody
+func ValidateGetDeadLetterResponseBody(body *GetDeadLetterResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EventDeliveryID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_delivery_id", "body"))
+	}
+	if body.EventID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.AttemptsCounter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_counter", "body"))
+	}
+	if body.LastAttemptStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("last_attempt_status", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Event == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event", "body"))
+	}
+	if body.Event != nil {
+		if err2 := ValidateEventResponseBody(body.Event); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "max_attempts_exceeded" || *body.Reason == "http_410_gone") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []interface{}{"max_attempts_exceeded", "http_410_gone"}))
+		}
+	}
+	if body.LastAttemptStatus != nil {
+		if !(*body.LastAttemptStatus == "error_timeout" || *body.LastAttemptStatus == "error_response" || *body.LastAttemptStatus == "error_network" || *body.LastAttemptStatus == "error_blocked" || *body.LastAttemptStatus == "error_internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.last_attempt_status", *body.LastAttemptStatus, []interface{}{"error_timeout", "error_response", "error_network", "error_blocked", "error_internal"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "dead" || *body.Status == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"dead", "replayed"}))
+		}
+	}
+	return
+}
+
+// ValidateReplayDeadLetterResponseBody runs the validations defined on
+// ReplayDeadLetterResponseBody
+func ValidateReplayDeadLetterResponseBody(body *ReplayDeadLetterResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EventDeliveryID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_delivery_id", "body"))
+	}
+	if body.EventID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.AttemptsCounter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_counter", "body"))
+	}
+	if body.LastAttemptStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("last_attempt_status", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "max_attempts_exceeded" || *body.Reason == "http_410_gone") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []interface{}{"max_attempts_exceeded", "http_410_gone"}))
+		}
+	}
+	if body.LastAttemptStatus != nil {
+		if !(*body.LastAttemptStatus == "error_timeout" || *body.LastAttemptStatus == "error_response" || *body.LastAttemptStatus == "error_network" || *body.LastAttemptStatus == "error_blocked" || *body.LastAttemptStatus == "error_internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.last_attempt_status", *body.LastAttemptStatus, []interface{}{"error_timeout", "error_response", "error_network", "error_blocked", "error_internal"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "dead" || *body.Status == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"dead", "replayed"}))
+		}
+	}
+	return
+}
+
+// ValidateReplayDeadLettersResponseBody runs the validations defined on
+// ReplayDeadLettersResponseBody
+func ValidateReplayDeadLettersResponseBody(body *ReplayDeadLettersResponseBody) (err error) {
+	if body.Result == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("result", "body"))
+	}
+	if body.HasMore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_more", "body"))
+	}
+	for _, e := range body.Result {
+		if e != nil {
+			if err2 := ValidateDeadLetterResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePurgeDeadLettersResponseBody runs the validations defined on
+// PurgeDeadLettersResponseBody
+func ValidatePurgeDeadLettersResponseBody(body *PurgeDeadLettersResponseBody) (err error) {
+	if body.Purged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("purged", "body"))
+	}
+	return
+}
+
+// ValidateEventRequestRequestBody runs the validations defined on
+// EventRequestRequestBody
+func ValidateEventRequestRequestBody(body *EventRequestRequestBody) (err error) {
+	if body.EventContent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_content", "body"))
+	}
+	if body.IdempotencyKey != nil {
+		if utf8.RuneCountInString(*body.IdempotencyKey) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.idempotency_key", *body.IdempotencyKey, utf8.RuneCountInString(*body.IdempotencyKey), 1, true))
+		}
+	}
+	if body.IdempotencyKey != nil {
+		if utf8.RuneCountInString(*body.IdempotencyKey) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.idempotency_key", *body.IdempotencyKey, utf8.RuneCountInString(*body.IdempotencyKey), 255, false))
+		}
+	}
+	return
+}
+
+// ValidateSubmittedEventResultResponseBody runs the validations defined on
+// SubmittedEventResultResponseBody
+func ValidateSubmittedEventResultResponseBody(body *SubmittedEventResultResponseBody) (err error) {
+	if body.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.MappingStatus != nil {
+		if !(*body.MappingStatus == "pending_mapping" || *body.MappingStatus == "mapped") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.mapping_status", *body.MappingStatus, []interface{}{"pending_mapping", "mapped"}))
+		}
+	}
+	if body.ErrorCode != nil {
+		if !(*body.ErrorCode == "invalid_event_type" || *body.ErrorCode == "invalid_event_content") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.error_code", *body.ErrorCode, []interface{}{"invalid_event_type", "invalid_event_content"}))
+		}
+	}
+	return
+}
+
+// ValidateDeliveryPolicyRequestBody runs the validations defined on
+// DeliveryPolicyRequestBody
+func ValidateDeliveryPolicyRequestBody(body *DeliveryPolicyRequestBody) (err error) {
+	if body.TimeoutSecs != nil {
+		if *body.TimeoutSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_secs", *body.TimeoutSecs, 1, true))
+		}
+	}
+	if body.TimeoutSecs != nil {
+		if *body.TimeoutSecs > 300 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_secs", *body.TimeoutSecs, 300, false))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 100, false))
+		}
+	}
+	if body.BackoffType != nil {
+		if !(*body.BackoffType == "exponential" || *body.BackoffType == "linear" || *body.BackoffType == "fixed" || *body.BackoffType == "schedule") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.backoff_type", *body.BackoffType, []interface{}{"exponential", "linear", "fixed", "schedule"}))
+		}
+	}
+	if body.BackoffBaseSecs != nil {
+		if *body.BackoffBaseSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.backoff_base_secs", *body.BackoffBaseSecs, 1, true))
+		}
+	}
+	if body.MaxBackoffSecs != nil {
+		if *body.MaxBackoffSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_backoff_secs", *body.MaxBackoffSecs, 1, true))
+		}
+	}
+	if len(body.BackoffScheduleSecs) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.backoff_schedule_secs", body.BackoffScheduleSecs, len(body.BackoffScheduleSecs), 1, true))
+	}
+	if len(body.BackoffScheduleSecs) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.backoff_schedule_secs", body.BackoffScheduleSecs, len(body.BackoffScheduleSecs), 100, false))
+	}
+	for _, e := range body.BackoffScheduleSecs {
+		if e < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.backoff_schedule_secs[*]", e, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateWebhookEndpointWithoutSecretResponseBody runs the validations
+// defined on WebhookEndpointWithoutSecretResponseBody
+func ValidateWebhookEndpointWithoutSecretResponseBody(body *WebhookEndpointWithoutSecretResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.EnabledEvents == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled_events", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "enabled" || *body.Status == "disabled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"enabled", "disabled"}))
+		}
+	}
+	if body.DisabledReason != nil {
+		if !(*body.DisabledReason == "manual" || *body.DisabledReason == "max_attempts_exceeded" || *body.DisabledReason == "http_410_gone") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.disabled_reason", *body.DisabledReason, []interface{}{"manual", "max_attempts_exceeded", "http_410_gone"}))
+		}
+	}
+	if body.CircuitState != nil {
+		if !(*body.CircuitState == "closed" || *body.CircuitState == "open" || *body.CircuitState == "half_open") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.circuit_state", *body.CircuitState, []interface{}{"closed", "open", "half_open"}))
+		}
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.url", *body.URL, goa.FormatURI))
+	}
+	if len(body.EnabledEvents) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.enabled_events", body.EnabledEvents, len(body.EnabledEvents), 1, true))
+	}
+	for k, v := range body.Metadata {
+		if utf8.RuneCountInString(k) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata.key", k, utf8.RuneCountInString(k), 1, true))
+		}
+		if utf8.RuneCountInString(v) < 1 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata[key]", v, utf8.RuneCountInString(v), 1, true))
 		}
 	}
+	for k, v := range body.Headers {
+		if utf8.RuneCountInString(k) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers.key", k, utf8.RuneCountInString(k), 1, true))
+		}
+		if utf8.RuneCountInString(v) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers[key]", v, utf8.RuneCountInString(v), 1, true))
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		if err2 := ValidateDeliveryPolicyResponseBody(body.DeliveryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateDeliveryPolicyResponseBody runs the validations defined on
+// DeliveryPolicyResponseBody
+func ValidateDeliveryPolicyResponseBody(body *DeliveryPolicyResponseBody) (err error) {
+	if body.TimeoutSecs != nil {
+		if *body.TimeoutSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_secs", *body.TimeoutSecs, 1, true))
+		}
+	}
+	if body.TimeoutSecs != nil {
+		if *body.TimeoutSecs > 300 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_secs", *body.TimeoutSecs, 300, false))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 100, false))
+		}
+	}
+	if body.BackoffType != nil {
+		if !(*body.BackoffType == "exponential" || *body.BackoffType == "linear" || *body.BackoffType == "fixed" || *body.BackoffType == "schedule") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.backoff_type", *body.BackoffType, []interface{}{"exponential", "linear", "fixed", "schedule"}))
+		}
+	}
+	if body.BackoffBaseSecs != nil {
+		if *body.BackoffBaseSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.backoff_base_secs", *body.BackoffBaseSecs, 1, true))
+		}
+	}
+	if body.MaxBackoffSecs != nil {
+		if *body.MaxBackoffSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_backoff_secs", *body.MaxBackoffSecs, 1, true))
+		}
+	}
+	if len(body.BackoffScheduleSecs) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.backoff_schedule_secs", body.BackoffScheduleSecs, len(body.BackoffScheduleSecs), 1, true))
+	}
+	if len(body.BackoffScheduleSecs) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.backoff_schedule_secs", body.BackoffScheduleSecs, len(body.BackoffScheduleSecs), 100, false))
+	}
+	for _, e := range body.BackoffScheduleSecs {
+		if e < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.backoff_schedule_secs[*]", e, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateEventResponseBody runs the validations defined on EventResponseBody
+func ValidateEventResponseBody(body *EventResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.EventContent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_content", "body"))
+	}
+	if body.Priority == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("priority", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	return
+}
+
+// ValidateEventDeliveryResponseBody runs the validations defined on
+// EventDeliveryResponseBody
+func ValidateEventDeliveryResponseBody(body *EventDeliveryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.AttemptsCounter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_counter", "body"))
+	}
+	if body.AttemptsRemaining == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_remaining", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "success" || *body.Status == "failed" || *body.Status == "cancelled" || *body.Status == "held") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"pending", "success", "failed", "cancelled", "held"}))
+		}
+	}
+	return
+}
+
+// ValidateEventDeliveryAttemptResponseBody runs the validations defined on
+// EventDeliveryAttemptResponseBody
+func ValidateEventDeliveryAttemptResponseBody(body *EventDeliveryAttemptResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EventDeliveryID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_delivery_id", "body"))
+	}
+	if body.EventID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "success" || *body.Status == "error_timeout" || *body.Status == "error_response" || *body.Status == "error_network" || *body.Status == "error_blocked" || *body.Status == "error_internal" || *body.Status == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"pending", "success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal", "cancelled"}))
+		}
+	}
+	return
+}
+
+// ValidateAPIKeyResponseBody runs the validations defined on ApiKeyResponseBody
+func ValidateAPIKeyResponseBody(body *APIKeyResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.Tenant == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tenant", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "enabled" || *body.Status == "disabled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"enabled", "disabled"}))
+		}
+	}
+	return
+}
+
+// ValidateEventTypeResponseBody runs the validations defined on
+// EventTypeResponseBody
+func ValidateEventTypeResponseBody(body *EventTypeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Deprecated == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deprecated", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	return
+}
+
+// ValidateEndpointStatusEventResponseBody runs the validations defined on
+// EndpointStatusEventResponseBody
+func ValidateEndpointStatusEventResponseBody(body *EndpointStatusEventResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.OldStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("old_status", "body"))
+	}
+	if body.NewStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("new_status", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.OldStatus != nil {
+		if !(*body.OldStatus == "enabled" || *body.OldStatus == "disabled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.old_status", *body.OldStatus, []interface{}{"enabled", "disabled"}))
+		}
+	}
+	if body.NewStatus != nil {
+		if !(*body.NewStatus == "enabled" || *body.NewStatus == "disabled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.new_status", *body.NewStatus, []interface{}{"enabled", "disabled"}))
+		}
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "manual" || *body.Reason == "recovery" || *body.Reason == "max_attempts_exceeded" || *body.Reason == "http_410_gone") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []interface{}{"manual", "recovery", "max_attempts_exceeded", "http_410_gone"}))
+		}
+	}
+	return
+}
+
+// ValidateResponseTimePercentilesResponseBody runs the validations defined on
+// ResponseTimePercentilesResponseBody
+func ValidateResponseTimePercentilesResponseBody(body *ResponseTimePercentilesResponseBody) (err error) {
+	if body.P50 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p50", "body"))
+	}
+	if body.P95 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p95", "body"))
+	}
+	if body.P99 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("p99", "body"))
+	}
+	return
+}
+
+// ValidateDeadLetterResponseBody runs the validations defined on
+// DeadLetterResponseBody
+func ValidateDeadLetterResponseBody(body *DeadLetterResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.EventDeliveryID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_delivery_id", "body"))
+	}
+	if body.EventID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.EndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_id", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.AttemptsCounter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts_counter", "body"))
+	}
+	if body.LastAttemptStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("last_attempt_status", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "max_attempts_exceeded" || *body.Reason == "http_410_gone") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []interface{}{"max_attempts_exceeded", "http_410_gone"}))
+		}
+	}
+	if body.LastAttemptStatus != nil {
+		if !(*body.LastAttemptStatus == "error_timeout" || *body.LastAttemptStatus == "error_response" || *body.LastAttemptStatus == "error_network" || *body.LastAttemptStatus == "error_blocked" || *body.LastAttemptStatus == "error_internal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.last_attempt_status", *body.LastAttemptStatus, []interface{}{"error_timeout", "error_response", "error_network", "error_blocked", "error_internal"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "dead" || *body.Status == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"dead", "replayed"}))
+		}
+	}
 	return
 }
@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP server encoders and decoders
 //
@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 	zebrahook "zebrahook/gen/zebrahook"
 
 	goahttp "goa.design/goa/v3/http"
@@ -52,8 +53,23 @@ func DecodeSubmitNewEventsRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		}
 
 		var (
-			token string
+			idempotencyKey *string
+			token          string
 		)
+		idempotencyKeyRaw := r.Header.Get("Idempotency-Key")
+		if idempotencyKeyRaw != "" {
+			idempotencyKey = &idempotencyKeyRaw
+		}
+		if idempotencyKey != nil {
+			if utf8.RuneCountInString(*idempotencyKey) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 1, true))
+			}
+		}
+		if idempotencyKey != nil {
+			if utf8.RuneCountInString(*idempotencyKey) > 255 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("idempotencyKey", *idempotencyKey, utf8.RuneCountInString(*idempotencyKey), 255, false))
+			}
+		}
 		token = r.Header.Get("Authorization")
 		if token == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
@@ -61,7 +77,7 @@ func DecodeSubmitNewEventsRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if err != nil {
 			return nil, err
 		}
-		payload := NewSubmitNewEventsPayload(&body, token)
+		payload := NewSubmitNewEventsPayload(&body, idempotencyKey, token)
 		if strings.Contains(payload.Token, " ") {
 			// Remove authorization scheme prefix (e.g. "Bearer")
 			cred := strings.SplitN(payload.Token, " ", 2)[1]
@@ -338,47 +354,1836 @@ func DecodeGetWebhookEndpointByIDRequest(mux goahttp.Muxer, decoder func(*http.R
 	}
 }
 
-// unmarshalEventRequestRequestBodyToZebrahookEventRequest builds a value of
-// type *zebrahook.EventRequest from a value of type *EventRequestRequestBody.
-func unmarshalEventRequestRequestBodyToZebrahookEventRequest(v *EventRequestRequestBody) *zebrahook.EventRequest {
-	res := &zebrahook.EventRequest{
-		EventType: *v.EventType,
-		Priority:  v.Priority,
+// EncodeDeleteWebhookEndpointResponse returns an encoder for responses
+// returned by the Zebrahook deleteWebhookEndpoint endpoint.
+func EncodeDeleteWebhookEndpointResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.DeleteWebhookEndpointResult)
+		enc := encoder(ctx, w)
+		body := NewDeleteWebhookEndpointResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
 	}
-	res.EventContent = make(map[string]interface{}, len(v.EventContent))
-	for key, val := range v.EventContent {
-		tk := key
-		tv := val
-		res.EventContent[tk] = tv
+}
+
+// DecodeDeleteWebhookEndpointRequest returns a decoder for requests sent to
+// the Zebrahook deleteWebhookEndpoint endpoint.
+func DecodeDeleteWebhookEndpointRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteWebhookEndpointPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
 	}
+}
 
-	return res
+// EncodeListEventsResponse returns an encoder for responses returned by the
+// Zebrahook listEvents endpoint.
+func EncodeListEventsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.ListEventsResult)
+		enc := encoder(ctx, w)
+		body := NewListEventsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
 }
 
-// marshalZebrahookWebhookEndpointWithoutSecretToWebhookEndpointWithoutSecretResponseBody
-// builds a value of type *WebhookEndpointWithoutSecretResponseBody from a
-// value of type *zebrahook.WebhookEndpointWithoutSecret.
-func marshalZebrahookWebhookEndpointWithoutSecretToWebhookEndpointWithoutSecretResponseBody(v *zebrahook.WebhookEndpointWithoutSecret) *WebhookEndpointWithoutSecretResponseBody {
-	res := &WebhookEndpointWithoutSecretResponseBody{
-		CreatedAt: v.CreatedAt,
-		UpdatedAt: v.UpdatedAt,
-		Status:    v.Status,
-		URL:       v.URL,
-		ID:        v.ID,
+// DecodeListEventsRequest returns a decoder for requests sent to the Zebrahook
+// listEvents endpoint.
+func DecodeListEventsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			limit         int32
+			startingAfter *uint
+			eventType     *string
+			priority      *int
+			createdAtGte  *uint64
+			createdAtLt   *uint64
+			token         string
+			err           error
+		)
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int32(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		{
+			startingAfterRaw := r.URL.Query().Get("starting_after")
+			if startingAfterRaw != "" {
+				v, err2 := strconv.ParseUint(startingAfterRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startingAfter", startingAfterRaw, "unsigned integer"))
+				}
+				pv := uint(v)
+				startingAfter = &pv
+			}
+		}
+		eventTypeRaw := r.URL.Query().Get("event_type")
+		if eventTypeRaw != "" {
+			eventType = &eventTypeRaw
+		}
+		{
+			priorityRaw := r.URL.Query().Get("priority")
+			if priorityRaw != "" {
+				v, err2 := strconv.ParseInt(priorityRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("priority", priorityRaw, "integer"))
+				}
+				pv := int(v)
+				priority = &pv
+			}
+		}
+		{
+			createdAtGteRaw := r.URL.Query().Get("createdAt.gte")
+			if createdAtGteRaw != "" {
+				v, err2 := strconv.ParseUint(createdAtGteRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("createdAtGte", createdAtGteRaw, "unsigned integer"))
+				}
+				createdAtGte = &v
+			}
+		}
+		if createdAtGte != nil {
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
+			}
+		}
+		{
+			createdAtLtRaw := r.URL.Query().Get("createdAt.lt")
+			if createdAtLtRaw != "" {
+				v, err2 := strconv.ParseUint(createdAtLtRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("createdAtLt", createdAtLtRaw, "unsigned integer"))
+				}
+				createdAtLt = &v
+			}
+		}
+		if createdAtLt != nil {
+			if *createdAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtLt", *createdAtLt, 0, true))
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListEventsPayload(limit, startingAfter, eventType, priority, createdAtGte, createdAtLt, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
 	}
-	if v.EnabledEvents != nil {
-		res.EnabledEvents = make([]string, len(v.EnabledEvents))
-		for i, val := range v.EnabledEvents {
-			res.EnabledEvents[i] = val
+}
+
+// EncodeGetEventByIDResponse returns an encoder for responses returned by the
+// Zebrahook getEventById endpoint.
+func EncodeGetEventByIDResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EventWithDeliveries)
+		enc := encoder(ctx, w)
+		body := NewGetEventByIDResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetEventByIDRequest returns a decoder for requests sent to the
+// Zebrahook getEventById endpoint.
+func DecodeGetEventByIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
 		}
+		payload := NewGetEventByIDPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
 	}
-	if v.Metadata != nil {
-		res.Metadata = make(map[string]string, len(v.Metadata))
-		for key, val := range v.Metadata {
-			tk := key
-			tv := val
-			res.Metadata[tk] = tv
+}
+
+// EncodeListWebhookEndpointAttemptsResponse returns an encoder for responses
+// returned by the Zebrahook listWebhookEndpointAttempts endpoint.
+func EncodeListWebhookEndpointAttemptsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.ListWebhookEndpointAttemptsResult)
+		enc := encoder(ctx, w)
+		body := NewListWebhookEndpointAttemptsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListWebhookEndpointAttemptsRequest returns a decoder for requests sent
+// to the Zebrahook listWebhookEndpointAttempts endpoint.
+func DecodeListWebhookEndpointAttemptsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id            string
+			limit         int32
+			startingAfter *uint
+			status        *string
+			eventType     *string
+			createdAtGte  *uint64
+			createdAtLt   *uint64
+			token         string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int32(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		{
+			startingAfterRaw := r.URL.Query().Get("starting_after")
+			if startingAfterRaw != "" {
+				v, err2 := strconv.ParseUint(startingAfterRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startingAfter", startingAfterRaw, "unsigned integer"))
+				}
+				pv := uint(v)
+				startingAfter = &pv
+			}
+		}
+		statusRaw := r.URL.Query().Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "pending" || *status == "success" || *status == "error_timeout" || *status == "error_response" || *status == "error_network" || *status == "error_blocked" || *status == "error_internal" || *status == "cancelled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []interface{}{"pending", "success", "error_timeout", "error_response", "error_network", "error_blocked", "error_internal", "cancelled"}))
+			}
+		}
+		eventTypeRaw := r.URL.Query().Get("event_type")
+		if eventTypeRaw != "" {
+			eventType = &eventTypeRaw
+		}
+		{
+			createdAtGteRaw := r.URL.Query().Get("createdAt.gte")
+			if createdAtGteRaw != "" {
+				v, err2 := strconv.ParseUint(createdAtGteRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("createdAtGte", createdAtGteRaw, "unsigned integer"))
+				}
+				createdAtGte = &v
+			}
+		}
+		if createdAtGte != nil {
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
+			}
+		}
+		{
+			createdAtLtRaw := r.URL.Query().Get("createdAt.lt")
+			if createdAtLtRaw != "" {
+				v, err2 := strconv.ParseUint(createdAtLtRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("createdAtLt", createdAtLtRaw, "unsigned integer"))
+				}
+				createdAtLt = &v
+			}
+		}
+		if createdAtLt != nil {
+			if *createdAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtLt", *createdAtLt, 0, true))
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListWebhookEndpointAttemptsPayload(id, limit, startingAfter, status, eventType, createdAtGte, createdAtLt, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRetryEventDeliveryResponse returns an encoder for responses returned
+// by the Zebrahook retryEventDelivery endpoint.
+func EncodeRetryEventDeliveryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EventDelivery)
+		enc := encoder(ctx, w)
+		body := NewRetryEventDeliveryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRetryEventDeliveryRequest returns a decoder for requests sent to the
+// Zebrahook retryEventDelivery endpoint.
+func DecodeRetryEventDeliveryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id         uint
+			endpointID string
+			token      string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
 		}
+		endpointID = params["endpoint_id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRetryEventDeliveryPayload(id, endpointID, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRecoverWebhookEndpointResponse returns an encoder for responses
+// returned by the Zebrahook recoverWebhookEndpoint endpoint.
+func EncodeRecoverWebhookEndpointResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EndpointRecovery)
+		enc := encoder(ctx, w)
+		body := NewRecoverWebhookEndpointResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRecoverWebhookEndpointRequest returns a decoder for requests sent to
+// the Zebrahook recoverWebhookEndpoint endpoint.
+func DecodeRecoverWebhookEndpointRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body RecoverWebhookEndpointRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRecoverWebhookEndpointRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRecoverWebhookEndpointPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetWebhookEndpointRecoveryResponse returns an encoder for responses
+// returned by the Zebrahook getWebhookEndpointRecovery endpoint.
+func EncodeGetWebhookEndpointRecoveryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EndpointRecovery)
+		enc := encoder(ctx, w)
+		body := NewGetWebhookEndpointRecoveryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetWebhookEndpointRecoveryRequest returns a decoder for requests sent
+// to the Zebrahook getWebhookEndpointRecovery endpoint.
+func DecodeGetWebhookEndpointRecoveryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id         string
+			recoveryID string
+			token      string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		recoveryID = params["recovery_id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetWebhookEndpointRecoveryPayload(id, recoveryID, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRotateWebhookEndpointSecretResponse returns an encoder for responses
+// returned by the Zebrahook rotateWebhookEndpointSecret endpoint.
+func EncodeRotateWebhookEndpointSecretResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.RotateWebhookEndpointSecretResult)
+		enc := encoder(ctx, w)
+		body := NewRotateWebhookEndpointSecretResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRotateWebhookEndpointSecretRequest returns a decoder for requests sent
+// to the Zebrahook rotateWebhookEndpointSecret endpoint.
+func DecodeRotateWebhookEndpointSecretRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body RotateWebhookEndpointSecretRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRotateWebhookEndpointSecretPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeTestWebhookEndpointResponse returns an encoder for responses returned
+// by the Zebrahook testWebhookEndpoint endpoint.
+func EncodeTestWebhookEndpointResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.TestWebhookEndpointResult)
+		enc := encoder(ctx, w)
+		body := NewTestWebhookEndpointResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeTestWebhookEndpointRequest returns a decoder for requests sent to the
+// Zebrahook testWebhookEndpoint endpoint.
+func DecodeTestWebhookEndpointRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body TestWebhookEndpointRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewTestWebhookEndpointPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListAPIKeysResponse returns an encoder for responses returned by the
+// Zebrahook listApiKeys endpoint.
+func EncodeListAPIKeysResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.ListAPIKeysResult)
+		enc := encoder(ctx, w)
+		body := NewListAPIKeysResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListAPIKeysRequest returns a decoder for requests sent to the
+// Zebrahook listApiKeys endpoint.
+func DecodeListAPIKeysRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			status *string
+			token  string
+			err    error
+		)
+		statusRaw := r.URL.Query().Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "enabled" || *status == "disabled") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []interface{}{"enabled", "disabled"}))
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListAPIKeysPayload(status, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAPIKeyResponse returns an encoder for responses returned by the
+// Zebrahook getApiKey endpoint.
+func EncodeGetAPIKeyResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.APIKey)
+		enc := encoder(ctx, w)
+		body := NewGetAPIKeyResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAPIKeyRequest returns a decoder for requests sent to the Zebrahook
+// getApiKey endpoint.
+func DecodeGetAPIKeyRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAPIKeyPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateAPIKeyResponse returns an encoder for responses returned by the
+// Zebrahook updateApiKey endpoint.
+func EncodeUpdateAPIKeyResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.APIKey)
+		enc := encoder(ctx, w)
+		body := NewUpdateAPIKeyResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateAPIKeyRequest returns a decoder for requests sent to the
+// Zebrahook updateApiKey endpoint.
+func DecodeUpdateAPIKeyRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body UpdateAPIKeyRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateAPIKeyRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    uint
+			token string
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateAPIKeyPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRevokeAPIKeyResponse returns an encoder for responses returned by the
+// Zebrahook revokeApiKey endpoint.
+func EncodeRevokeAPIKeyResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.RevokeAPIKeyResult)
+		enc := encoder(ctx, w)
+		body := NewRevokeAPIKeyResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRevokeAPIKeyRequest returns a decoder for requests sent to the
+// Zebrahook revokeApiKey endpoint.
+func DecodeRevokeAPIKeyRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRevokeAPIKeyPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeRegisterEventTypeResponse returns an encoder for responses returned by
+// the Zebrahook registerEventType endpoint.
+func EncodeRegisterEventTypeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EventType)
+		enc := encoder(ctx, w)
+		body := NewRegisterEventTypeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRegisterEventTypeRequest returns a decoder for requests sent to the
+// Zebrahook registerEventType endpoint.
+func DecodeRegisterEventTypeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body RegisterEventTypeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRegisterEventTypeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRegisterEventTypePayload(&body, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListEventTypesResponse returns an encoder for responses returned by
+// the Zebrahook listEventTypes endpoint.
+func EncodeListEventTypesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.ListEventTypesResult)
+		enc := encoder(ctx, w)
+		body := NewListEventTypesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListEventTypesRequest returns a decoder for requests sent to the
+// Zebrahook listEventTypes endpoint.
+func DecodeListEventTypesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			deprecated *bool
+			token      string
+			err        error
+		)
+		{
+			deprecatedRaw := r.URL.Query().Get("deprecated")
+			if deprecatedRaw != "" {
+				v, err2 := strconv.ParseBool(deprecatedRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("deprecated", deprecatedRaw, "boolean"))
+				}
+				deprecated = &v
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListEventTypesPayload(deprecated, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetEventTypeResponse returns an encoder for responses returned by the
+// Zebrahook getEventType endpoint.
+func EncodeGetEventTypeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EventType)
+		enc := encoder(ctx, w)
+		body := NewGetEventTypeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetEventTypeRequest returns a decoder for requests sent to the
+// Zebrahook getEventType endpoint.
+func DecodeGetEventTypeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			name  string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetEventTypePayload(name, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateEventTypeResponse returns an encoder for responses returned by
+// the Zebrahook updateEventType endpoint.
+func EncodeUpdateEventTypeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EventType)
+		enc := encoder(ctx, w)
+		body := NewUpdateEventTypeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateEventTypeRequest returns a decoder for requests sent to the
+// Zebrahook updateEventType endpoint.
+func DecodeUpdateEventTypeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body UpdateEventTypeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+
+		var (
+			name  string
+			token string
+
+			params = mux.Vars(r)
+		)
+		name = params["name"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateEventTypePayload(&body, name, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetEventTypesCatalogResponse returns an encoder for responses returned
+// by the Zebrahook getEventTypesCatalog endpoint.
+func EncodeGetEventTypesCatalogResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.GetEventTypesCatalogResult)
+		w.Header().Set("Content-Type", res.ContentType)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeGetEventTypesCatalogRequest returns a decoder for requests sent to the
+// Zebrahook getEventTypesCatalog endpoint.
+func DecodeGetEventTypesCatalogRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			format string
+			token  string
+			err    error
+		)
+		formatRaw := r.URL.Query().Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "asyncapi"
+		}
+		if !(format == "asyncapi" || format == "markdown" || format == "html") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []interface{}{"asyncapi", "markdown", "html"}))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetEventTypesCatalogPayload(format, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetWebhookEndpointStatusHistoryResponse returns an encoder for
+// responses returned by the Zebrahook getWebhookEndpointStatusHistory endpoint.
+func EncodeGetWebhookEndpointStatusHistoryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.GetWebhookEndpointStatusHistoryResult)
+		enc := encoder(ctx, w)
+		body := NewGetWebhookEndpointStatusHistoryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetWebhookEndpointStatusHistoryRequest returns a decoder for requests
+// sent to the Zebrahook getWebhookEndpointStatusHistory endpoint.
+func DecodeGetWebhookEndpointStatusHistoryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id            string
+			limit         int32
+			startingAfter *uint
+			token         string
+			err           error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int32(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		{
+			startingAfterRaw := r.URL.Query().Get("starting_after")
+			if startingAfterRaw != "" {
+				v, err2 := strconv.ParseUint(startingAfterRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startingAfter", startingAfterRaw, "unsigned integer"))
+				}
+				pv := uint(v)
+				startingAfter = &pv
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetWebhookEndpointStatusHistoryPayload(id, limit, startingAfter, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetWebhookEndpointStatsResponse returns an encoder for responses
+// returned by the Zebrahook getWebhookEndpointStats endpoint.
+func EncodeGetWebhookEndpointStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.EndpointStats)
+		enc := encoder(ctx, w)
+		body := NewGetWebhookEndpointStatsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetWebhookEndpointStatsRequest returns a decoder for requests sent to
+// the Zebrahook getWebhookEndpointStats endpoint.
+func DecodeGetWebhookEndpointStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id     string
+			window string
+			token  string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		windowRaw := r.URL.Query().Get("window")
+		if windowRaw != "" {
+			window = windowRaw
+		} else {
+			window = "24h"
+		}
+		if !(window == "1h" || window == "24h" || window == "7d") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("window", window, []interface{}{"1h", "24h", "7d"}))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetWebhookEndpointStatsPayload(id, window, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListDeadLettersResponse returns an encoder for responses returned by
+// the Zebrahook listDeadLetters endpoint.
+func EncodeListDeadLettersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.ListDeadLettersResult)
+		enc := encoder(ctx, w)
+		body := NewListDeadLettersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListDeadLettersRequest returns a decoder for requests sent to the
+// Zebrahook listDeadLetters endpoint.
+func DecodeListDeadLettersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			limit         int32
+			startingAfter *uint
+			status        string
+			endpointID    *string
+			eventType     *string
+			createdAtGte  *uint64
+			createdAtLt   *uint64
+			token         string
+			err           error
+		)
+		{
+			limitRaw := r.URL.Query().Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, 32)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int32(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+		}
+		{
+			startingAfterRaw := r.URL.Query().Get("starting_after")
+			if startingAfterRaw != "" {
+				v, err2 := strconv.ParseUint(startingAfterRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startingAfter", startingAfterRaw, "unsigned integer"))
+				}
+				pv := uint(v)
+				startingAfter = &pv
+			}
+		}
+		statusRaw := r.URL.Query().Get("status")
+		if statusRaw != "" {
+			status = statusRaw
+		} else {
+			status = "dead"
+		}
+		if !(status == "dead" || status == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", status, []interface{}{"dead", "replayed"}))
+		}
+		endpointIDRaw := r.URL.Query().Get("endpoint_id")
+		if endpointIDRaw != "" {
+			endpointID = &endpointIDRaw
+		}
+		eventTypeRaw := r.URL.Query().Get("event_type")
+		if eventTypeRaw != "" {
+			eventType = &eventTypeRaw
+		}
+		{
+			createdAtGteRaw := r.URL.Query().Get("createdAt.gte")
+			if createdAtGteRaw != "" {
+				v, err2 := strconv.ParseUint(createdAtGteRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("createdAtGte", createdAtGteRaw, "unsigned integer"))
+				}
+				createdAtGte = &v
+			}
+		}
+		if createdAtGte != nil {
+			if *createdAtGte < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtGte", *createdAtGte, 0, true))
+			}
+		}
+		{
+			createdAtLtRaw := r.URL.Query().Get("createdAt.lt")
+			if createdAtLtRaw != "" {
+				v, err2 := strconv.ParseUint(createdAtLtRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("createdAtLt", createdAtLtRaw, "unsigned integer"))
+				}
+				createdAtLt = &v
+			}
+		}
+		if createdAtLt != nil {
+			if *createdAtLt < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("createdAtLt", *createdAtLt, 0, true))
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListDeadLettersPayload(limit, startingAfter, status, endpointID, eventType, createdAtGte, createdAtLt, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetDeadLetterResponse returns an encoder for responses returned by the
+// Zebrahook getDeadLetter endpoint.
+func EncodeGetDeadLetterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.DeadLetterDetails)
+		enc := encoder(ctx, w)
+		body := NewGetDeadLetterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetDeadLetterRequest returns a decoder for requests sent to the
+// Zebrahook getDeadLetter endpoint.
+func DecodeGetDeadLetterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetDeadLetterPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeReplayDeadLetterResponse returns an encoder for responses returned by
+// the Zebrahook replayDeadLetter endpoint.
+func EncodeReplayDeadLetterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.DeadLetter)
+		enc := encoder(ctx, w)
+		body := NewReplayDeadLetterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeReplayDeadLetterRequest returns a decoder for requests sent to the
+// Zebrahook replayDeadLetter endpoint.
+func DecodeReplayDeadLetterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewReplayDeadLetterPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeReplayDeadLettersResponse returns an encoder for responses returned by
+// the Zebrahook replayDeadLetters endpoint.
+func EncodeReplayDeadLettersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.ReplayDeadLettersResult)
+		enc := encoder(ctx, w)
+		body := NewReplayDeadLettersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeReplayDeadLettersRequest returns a decoder for requests sent to the
+// Zebrahook replayDeadLetters endpoint.
+func DecodeReplayDeadLettersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body ReplayDeadLettersRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateReplayDeadLettersRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewReplayDeadLettersPayload(&body, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteDeadLetterResponse returns an encoder for responses returned by
+// the Zebrahook deleteDeadLetter endpoint.
+func EncodeDeleteDeadLetterResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.DeleteDeadLetterResult)
+		enc := encoder(ctx, w)
+		body := NewDeleteDeadLetterResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteDeadLetterRequest returns a decoder for requests sent to the
+// Zebrahook deleteDeadLetter endpoint.
+func DecodeDeleteDeadLetterRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			id    uint
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteDeadLetterPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePurgeDeadLettersResponse returns an encoder for responses returned by
+// the Zebrahook purgeDeadLetters endpoint.
+func EncodePurgeDeadLettersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, interface{}) error {
+	return func(ctx context.Context, w http.ResponseWriter, v interface{}) error {
+		res, _ := v.(*zebrahook.PurgeDeadLettersResult)
+		enc := encoder(ctx, w)
+		body := NewPurgeDeadLettersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePurgeDeadLettersRequest returns a decoder for requests sent to the
+// Zebrahook purgeDeadLetters endpoint.
+func DecodePurgeDeadLettersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (interface{}, error) {
+	return func(r *http.Request) (interface{}, error) {
+		var (
+			body PurgeDeadLettersRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePurgeDeadLettersRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("Authorization", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPurgeDeadLettersPayload(&body, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// unmarshalEventRequestRequestBodyToZebrahookEventRequest builds a value of
+// type *zebrahook.EventRequest from a value of type *EventRequestRequestBody.
+func unmarshalEventRequestRequestBodyToZebrahookEventRequest(v *EventRequestRequestBody) *zebrahook.EventRequest {
+	res := &zebrahook.EventRequest{
+		EventType:      *v.EventType,
+		Priority:       v.Priority,
+		IdempotencyKey: v.IdempotencyKey,
+	}
+	res.EventContent = make(map[string]interface{}, len(v.EventContent))
+	for key, val := range v.EventContent {
+		tk := key
+		tv := val
+		res.EventContent[tk] = tv
+	}
+
+	return res
+}
+
+// marshalZebrahookSubmittedEventResultToSubmittedEventResultResponseBody
+// builds a value of type *SubmittedEventResultResponseBody from a value of
+// type *zebrahook.SubmittedEventResult.
+func marshalZebrahookSubmittedEventResultToSubmittedEventResultResponseBody(v *zebrahook.SubmittedEventResult) *SubmittedEventResultResponseBody {
+	res := &SubmittedEventResultResponseBody{
+		Index:            v.Index,
+		EventID:          v.EventID,
+		EventType:        v.EventType,
+		MappingStatus:    v.MappingStatus,
+		EndpointsMatched: v.EndpointsMatched,
+		ErrorCode:        v.ErrorCode,
+		ErrorMessage:     v.ErrorMessage,
+	}
+
+	return res
+}
+
+// unmarshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy builds a value
+// of type *zebrahook.DeliveryPolicy from a value of type
+// *DeliveryPolicyRequestBody.
+func unmarshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy(v *DeliveryPolicyRequestBody) *zebrahook.DeliveryPolicy {
+	if v == nil {
+		return nil
+	}
+	res := &zebrahook.DeliveryPolicy{
+		TimeoutSecs:     v.TimeoutSecs,
+		MaxAttempts:     v.MaxAttempts,
+		BackoffType:     v.BackoffType,
+		BackoffBaseSecs: v.BackoffBaseSecs,
+		MaxBackoffSecs:  v.MaxBackoffSecs,
+	}
+	if v.BackoffScheduleSecs != nil {
+		res.BackoffScheduleSecs = make([]uint, len(v.BackoffScheduleSecs))
+		for i, val := range v.BackoffScheduleSecs {
+			res.BackoffScheduleSecs[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalZebrahookWebhookEndpointWithoutSecretToWebhookEndpointWithoutSecretResponseBody
+// builds a value of type *WebhookEndpointWithoutSecretResponseBody from a
+// value of type *zebrahook.WebhookEndpointWithoutSecret.
+func marshalZebrahookWebhookEndpointWithoutSecretToWebhookEndpointWithoutSecretResponseBody(v *zebrahook.WebhookEndpointWithoutSecret) *WebhookEndpointWithoutSecretResponseBody {
+	res := &WebhookEndpointWithoutSecretResponseBody{
+		CreatedAt:       v.CreatedAt,
+		UpdatedAt:       v.UpdatedAt,
+		Status:          v.Status,
+		DisabledReason:  v.DisabledReason,
+		CircuitState:    v.CircuitState,
+		CircuitOpenedAt: v.CircuitOpenedAt,
+		URL:             v.URL,
+		ID:              v.ID,
+	}
+	if v.EnabledEvents != nil {
+		res.EnabledEvents = make([]string, len(v.EnabledEvents))
+		for i, val := range v.EnabledEvents {
+			res.EnabledEvents[i] = val
+		}
+	}
+	if v.Metadata != nil {
+		res.Metadata = make(map[string]string, len(v.Metadata))
+		for key, val := range v.Metadata {
+			tk := key
+			tv := val
+			res.Metadata[tk] = tv
+		}
+	}
+	if v.Headers != nil {
+		res.Headers = make(map[string]string, len(v.Headers))
+		for key, val := range v.Headers {
+			tk := key
+			tv := val
+			res.Headers[tk] = tv
+		}
+	}
+	if v.DeliveryPolicy != nil {
+		res.DeliveryPolicy = marshalZebrahookDeliveryPolicyToDeliveryPolicyResponseBody(v.DeliveryPolicy)
+	}
+
+	return res
+}
+
+// marshalZebrahookDeliveryPolicyToDeliveryPolicyResponseBody builds a value of
+// type *DeliveryPolicyResponseBody from a value of type
+// *zebrahook.DeliveryPolicy.
+func marshalZebrahookDeliveryPolicyToDeliveryPolicyResponseBody(v *zebrahook.DeliveryPolicy) *DeliveryPolicyResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &DeliveryPolicyResponseBody{
+		TimeoutSecs:     v.TimeoutSecs,
+		MaxAttempts:     v.MaxAttempts,
+		BackoffType:     v.BackoffType,
+		BackoffBaseSecs: v.BackoffBaseSecs,
+		MaxBackoffSecs:  v.MaxBackoffSecs,
+	}
+	if v.BackoffScheduleSecs != nil {
+		res.BackoffScheduleSecs = make([]uint, len(v.BackoffScheduleSecs))
+		for i, val := range v.BackoffScheduleSecs {
+			res.BackoffScheduleSecs[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalZebrahookEventToEventResponseBody builds a value of type
+// *EventResponseBody from a value of type *zebrahook.Event.
+func marshalZebrahookEventToEventResponseBody(v *zebrahook.Event) *EventResponseBody {
+	res := &EventResponseBody{
+		ID:        v.ID,
+		EventType: v.EventType,
+		Priority:  v.Priority,
+		CreatedAt: v.CreatedAt,
+	}
+	if v.EventContent != nil {
+		res.EventContent = make(map[string]interface{}, len(v.EventContent))
+		for key, val := range v.EventContent {
+			tk := key
+			tv := val
+			res.EventContent[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalZebrahookEventDeliveryToEventDeliveryResponseBody builds a value of
+// type *EventDeliveryResponseBody from a value of type
+// *zebrahook.EventDelivery.
+func marshalZebrahookEventDeliveryToEventDeliveryResponseBody(v *zebrahook.EventDelivery) *EventDeliveryResponseBody {
+	res := &EventDeliveryResponseBody{
+		ID:                     v.ID,
+		EndpointID:             v.EndpointID,
+		EndpointURL:            v.EndpointURL,
+		Status:                 v.Status,
+		AttemptsCounter:        v.AttemptsCounter,
+		AttemptsRemaining:      v.AttemptsRemaining,
+		NextAttemptScheduledAt: v.NextAttemptScheduledAt,
+		CreatedAt:              v.CreatedAt,
+		UpdatedAt:              v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalZebrahookEventDeliveryAttemptToEventDeliveryAttemptResponseBody
+// builds a value of type *EventDeliveryAttemptResponseBody from a value of
+// type *zebrahook.EventDeliveryAttempt.
+func marshalZebrahookEventDeliveryAttemptToEventDeliveryAttemptResponseBody(v *zebrahook.EventDeliveryAttempt) *EventDeliveryAttemptResponseBody {
+	res := &EventDeliveryAttemptResponseBody{
+		ID:                   v.ID,
+		EventDeliveryID:      v.EventDeliveryID,
+		EventID:              v.EventID,
+		EventType:            v.EventType,
+		Status:               v.Status,
+		AttemptMadeAt:        v.AttemptMadeAt,
+		HTTPStatusCode:       v.HTTPStatusCode,
+		HTTPBodyResponse:     v.HTTPBodyResponse,
+		HTTPResponseTimeSecs: v.HTTPResponseTimeSecs,
+		CreatedAt:            v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalZebrahookAPIKeyToAPIKeyResponseBody builds a value of type
+// *APIKeyResponseBody from a value of type *zebrahook.APIKey.
+func marshalZebrahookAPIKeyToAPIKeyResponseBody(v *zebrahook.APIKey) *APIKeyResponseBody {
+	res := &APIKeyResponseBody{
+		ID:          v.ID,
+		Description: v.Description,
+		Status:      v.Status,
+		Tenant:      v.Tenant,
+		ExpiresAt:   v.ExpiresAt,
+		LastUsedAt:  v.LastUsedAt,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalZebrahookEventTypeToEventTypeResponseBody builds a value of type
+// *EventTypeResponseBody from a value of type *zebrahook.EventType.
+func marshalZebrahookEventTypeToEventTypeResponseBody(v *zebrahook.EventType) *EventTypeResponseBody {
+	res := &EventTypeResponseBody{
+		Name:        v.Name,
+		Description: v.Description,
+		Deprecated:  v.Deprecated,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+	}
+	if v.Schema != nil {
+		res.Schema = make(map[string]interface{}, len(v.Schema))
+		for key, val := range v.Schema {
+			tk := key
+			tv := val
+			res.Schema[tk] = tv
+		}
+	}
+	if v.Example != nil {
+		res.Example = make(map[string]interface{}, len(v.Example))
+		for key, val := range v.Example {
+			tk := key
+			tv := val
+			res.Example[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalZebrahookEndpointStatusEventToEndpointStatusEventResponseBody builds
+// a value of type *EndpointStatusEventResponseBody from a value of type
+// *zebrahook.EndpointStatusEvent.
+func marshalZebrahookEndpointStatusEventToEndpointStatusEventResponseBody(v *zebrahook.EndpointStatusEvent) *EndpointStatusEventResponseBody {
+	res := &EndpointStatusEventResponseBody{
+		ID:            v.ID,
+		OldStatus:     v.OldStatus,
+		NewStatus:     v.NewStatus,
+		Reason:        v.Reason,
+		ActorAPIKeyID: v.ActorAPIKeyID,
+		ActorSubject:  v.ActorSubject,
+		CreatedAt:     v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalZebrahookResponseTimePercentilesToResponseTimePercentilesResponseBody
+// builds a value of type *ResponseTimePercentilesResponseBody from a value of
+// type *zebrahook.ResponseTimePercentiles.
+func marshalZebrahookResponseTimePercentilesToResponseTimePercentilesResponseBody(v *zebrahook.ResponseTimePercentiles) *ResponseTimePercentilesResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ResponseTimePercentilesResponseBody{
+		P50: v.P50,
+		P95: v.P95,
+		P99: v.P99,
+	}
+
+	return res
+}
+
+// marshalZebrahookDeadLetterToDeadLetterResponseBody builds a value of type
+// *DeadLetterResponseBody from a value of type *zebrahook.DeadLetter.
+func marshalZebrahookDeadLetterToDeadLetterResponseBody(v *zebrahook.DeadLetter) *DeadLetterResponseBody {
+	res := &DeadLetterResponseBody{
+		ID:                    v.ID,
+		EventDeliveryID:       v.EventDeliveryID,
+		EventID:               v.EventID,
+		EventType:             v.EventType,
+		EndpointID:            v.EndpointID,
+		Reason:                v.Reason,
+		AttemptsCounter:       v.AttemptsCounter,
+		LastAttemptStatus:     v.LastAttemptStatus,
+		LastHTTPStatusCode:    v.LastHTTPStatusCode,
+		LastAttemptMadeAt:     v.LastAttemptMadeAt,
+		Status:                v.Status,
+		ReplayEventDeliveryID: v.ReplayEventDeliveryID,
+		ReplayedAt:            v.ReplayedAt,
+		CreatedAt:             v.CreatedAt,
 	}
 
 	return res
@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP server
 //
@@ -9,6 +9,7 @@ package server
 
 import (
 	"context"
+	"io"
 	"net/http"
 	zebrahook "zebrahook/gen/zebrahook"
 
@@ -18,12 +19,38 @@ import (
 
 // Server lists the Zebrahook service endpoint HTTP handlers.
 type Server struct {
-	Mounts                 []*MountPoint
-	SubmitNewEvents        http.Handler
-	Register               http.Handler
-	Update                 http.Handler
-	ListWebhookEndpoint    http.Handler
-	GetWebhookEndpointByID http.Handler
+	Mounts                          []*MountPoint
+	SubmitNewEvents                 http.Handler
+	Register                        http.Handler
+	Update                          http.Handler
+	ListWebhookEndpoint             http.Handler
+	GetWebhookEndpointByID          http.Handler
+	DeleteWebhookEndpoint           http.Handler
+	ListEvents                      http.Handler
+	GetEventByID                    http.Handler
+	ListWebhookEndpointAttempts     http.Handler
+	RetryEventDelivery              http.Handler
+	RecoverWebhookEndpoint          http.Handler
+	GetWebhookEndpointRecovery      http.Handler
+	RotateWebhookEndpointSecret     http.Handler
+	TestWebhookEndpoint             http.Handler
+	ListAPIKeys                     http.Handler
+	GetAPIKey                       http.Handler
+	UpdateAPIKey                    http.Handler
+	RevokeAPIKey                    http.Handler
+	RegisterEventType               http.Handler
+	ListEventTypes                  http.Handler
+	GetEventType                    http.Handler
+	UpdateEventType                 http.Handler
+	GetEventTypesCatalog            http.Handler
+	GetWebhookEndpointStatusHistory http.Handler
+	GetWebhookEndpointStats         http.Handler
+	ListDeadLetters                 http.Handler
+	GetDeadLetter                   http.Handler
+	ReplayDeadLetter                http.Handler
+	ReplayDeadLetters               http.Handler
+	DeleteDeadLetter                http.Handler
+	PurgeDeadLetters                http.Handler
 }
 
 // ErrorNamer is an interface implemented by generated error structs that
@@ -64,12 +91,64 @@ func New(
 			{"Update", "PUT", "/v1/webhook/endpoints/{id}"},
 			{"ListWebhookEndpoint", "GET", "/v1/webhook/endpoints/"},
 			{"GetWebhookEndpointByID", "GET", "/v1/webhook/endpoints/{id}"},
+			{"DeleteWebhookEndpoint", "DELETE", "/v1/webhook/endpoints/{id}"},
+			{"ListEvents", "GET", "/v1/webhook/events"},
+			{"GetEventByID", "GET", "/v1/webhook/events/{id}"},
+			{"ListWebhookEndpointAttempts", "GET", "/v1/webhook/endpoints/{id}/attempts"},
+			{"RetryEventDelivery", "POST", "/v1/webhook/events/{id}/deliveries/{endpoint_id}/retry"},
+			{"RecoverWebhookEndpoint", "POST", "/v1/webhook/endpoints/{id}/recover"},
+			{"GetWebhookEndpointRecovery", "GET", "/v1/webhook/endpoints/{id}/recover/{recovery_id}"},
+			{"RotateWebhookEndpointSecret", "POST", "/v1/webhook/endpoints/{id}/rotate-secret"},
+			{"TestWebhookEndpoint", "POST", "/v1/webhook/endpoints/{id}/test"},
+			{"ListAPIKeys", "GET", "/v1/webhook/api-keys"},
+			{"GetAPIKey", "GET", "/v1/webhook/api-keys/{id}"},
+			{"UpdateAPIKey", "PUT", "/v1/webhook/api-keys/{id}"},
+			{"RevokeAPIKey", "POST", "/v1/webhook/api-keys/{id}/revoke"},
+			{"RegisterEventType", "POST", "/v1/webhook/event-types"},
+			{"ListEventTypes", "GET", "/v1/webhook/event-types"},
+			{"GetEventType", "GET", "/v1/webhook/event-types/{name}"},
+			{"UpdateEventType", "PUT", "/v1/webhook/event-types/{name}"},
+			{"GetEventTypesCatalog", "GET", "/v1/webhook/event-types/catalog"},
+			{"GetWebhookEndpointStatusHistory", "GET", "/v1/webhook/endpoints/{id}/status-history"},
+			{"GetWebhookEndpointStats", "GET", "/v1/webhook/endpoints/{id}/stats"},
+			{"ListDeadLetters", "GET", "/v1/webhook/dead-letters"},
+			{"GetDeadLetter", "GET", "/v1/webhook/dead-letters/{id}"},
+			{"ReplayDeadLetter", "POST", "/v1/webhook/dead-letters/{id}/replay"},
+			{"ReplayDeadLetters", "POST", "/v1/webhook/dead-letters/replay"},
+			{"DeleteDeadLetter", "DELETE", "/v1/webhook/dead-letters/{id}"},
+			{"PurgeDeadLetters", "POST", "/v1/webhook/dead-letters/purge"},
 		},
-		SubmitNewEvents:        NewSubmitNewEventsHandler(e.SubmitNewEvents, mux, decoder, encoder, errhandler, formatter),
-		Register:               NewRegisterHandler(e.Register, mux, decoder, encoder, errhandler, formatter),
-		Update:                 NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
-		ListWebhookEndpoint:    NewListWebhookEndpointHandler(e.ListWebhookEndpoint, mux, decoder, encoder, errhandler, formatter),
-		GetWebhookEndpointByID: NewGetWebhookEndpointByIDHandler(e.GetWebhookEndpointByID, mux, decoder, encoder, errhandler, formatter),
+		SubmitNewEvents:                 NewSubmitNewEventsHandler(e.SubmitNewEvents, mux, decoder, encoder, errhandler, formatter),
+		Register:                        NewRegisterHandler(e.Register, mux, decoder, encoder, errhandler, formatter),
+		Update:                          NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookEndpoint:             NewListWebhookEndpointHandler(e.ListWebhookEndpoint, mux, decoder, encoder, errhandler, formatter),
+		GetWebhookEndpointByID:          NewGetWebhookEndpointByIDHandler(e.GetWebhookEndpointByID, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhookEndpoint:           NewDeleteWebhookEndpointHandler(e.DeleteWebhookEndpoint, mux, decoder, encoder, errhandler, formatter),
+		ListEvents:                      NewListEventsHandler(e.ListEvents, mux, decoder, encoder, errhandler, formatter),
+		GetEventByID:                    NewGetEventByIDHandler(e.GetEventByID, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookEndpointAttempts:     NewListWebhookEndpointAttemptsHandler(e.ListWebhookEndpointAttempts, mux, decoder, encoder, errhandler, formatter),
+		RetryEventDelivery:              NewRetryEventDeliveryHandler(e.RetryEventDelivery, mux, decoder, encoder, errhandler, formatter),
+		RecoverWebhookEndpoint:          NewRecoverWebhookEndpointHandler(e.RecoverWebhookEndpoint, mux, decoder, encoder, errhandler, formatter),
+		GetWebhookEndpointRecovery:      NewGetWebhookEndpointRecoveryHandler(e.GetWebhookEndpointRecovery, mux, decoder, encoder, errhandler, formatter),
+		RotateWebhookEndpointSecret:     NewRotateWebhookEndpointSecretHandler(e.RotateWebhookEndpointSecret, mux, decoder, encoder, errhandler, formatter),
+		TestWebhookEndpoint:             NewTestWebhookEndpointHandler(e.TestWebhookEndpoint, mux, decoder, encoder, errhandler, formatter),
+		ListAPIKeys:                     NewListAPIKeysHandler(e.ListAPIKeys, mux, decoder, encoder, errhandler, formatter),
+		GetAPIKey:                       NewGetAPIKeyHandler(e.GetAPIKey, mux, decoder, encoder, errhandler, formatter),
+		UpdateAPIKey:                    NewUpdateAPIKeyHandler(e.UpdateAPIKey, mux, decoder, encoder, errhandler, formatter),
+		RevokeAPIKey:                    NewRevokeAPIKeyHandler(e.RevokeAPIKey, mux, decoder, encoder, errhandler, formatter),
+		RegisterEventType:               NewRegisterEventTypeHandler(e.RegisterEventType, mux, decoder, encoder, errhandler, formatter),
+		ListEventTypes:                  NewListEventTypesHandler(e.ListEventTypes, mux, decoder, encoder, errhandler, formatter),
+		GetEventType:                    NewGetEventTypeHandler(e.GetEventType, mux, decoder, encoder, errhandler, formatter),
+		UpdateEventType:                 NewUpdateEventTypeHandler(e.UpdateEventType, mux, decoder, encoder, errhandler, formatter),
+		GetEventTypesCatalog:            NewGetEventTypesCatalogHandler(e.GetEventTypesCatalog, mux, decoder, encoder, errhandler, formatter),
+		GetWebhookEndpointStatusHistory: NewGetWebhookEndpointStatusHistoryHandler(e.GetWebhookEndpointStatusHistory, mux, decoder, encoder, errhandler, formatter),
+		GetWebhookEndpointStats:         NewGetWebhookEndpointStatsHandler(e.GetWebhookEndpointStats, mux, decoder, encoder, errhandler, formatter),
+		ListDeadLetters:                 NewListDeadLettersHandler(e.ListDeadLetters, mux, decoder, encoder, errhandler, formatter),
+		GetDeadLetter:                   NewGetDeadLetterHandler(e.GetDeadLetter, mux, decoder, encoder, errhandler, formatter),
+		ReplayDeadLetter:                NewReplayDeadLetterHandler(e.ReplayDeadLetter, mux, decoder, encoder, errhandler, formatter),
+		ReplayDeadLetters:               NewReplayDeadLettersHandler(e.ReplayDeadLetters, mux, decoder, encoder, errhandler, formatter),
+		DeleteDeadLetter:                NewDeleteDeadLetterHandler(e.DeleteDeadLetter, mux, decoder, encoder, errhandler, formatter),
+		PurgeDeadLetters:                NewPurgeDeadLettersHandler(e.PurgeDeadLetters, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -83,6 +162,32 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Update = m(s.Update)
 	s.ListWebhookEndpoint = m(s.ListWebhookEndpoint)
 	s.GetWebhookEndpointByID = m(s.GetWebhookEndpointByID)
+	s.DeleteWebhookEndpoint = m(s.DeleteWebhookEndpoint)
+	s.ListEvents = m(s.ListEvents)
+	s.GetEventByID = m(s.GetEventByID)
+	s.ListWebhookEndpointAttempts = m(s.ListWebhookEndpointAttempts)
+	s.RetryEventDelivery = m(s.RetryEventDelivery)
+	s.RecoverWebhookEndpoint = m(s.RecoverWebhookEndpoint)
+	s.GetWebhookEndpointRecovery = m(s.GetWebhookEndpointRecovery)
+	s.RotateWebhookEndpointSecret = m(s.RotateWebhookEndpointSecret)
+	s.TestWebhookEndpoint = m(s.TestWebhookEndpoint)
+	s.ListAPIKeys = m(s.ListAPIKeys)
+	s.GetAPIKey = m(s.GetAPIKey)
+	s.UpdateAPIKey = m(s.UpdateAPIKey)
+	s.RevokeAPIKey = m(s.RevokeAPIKey)
+	s.RegisterEventType = m(s.RegisterEventType)
+	s.ListEventTypes = m(s.ListEventTypes)
+	s.GetEventType = m(s.GetEventType)
+	s.UpdateEventType = m(s.UpdateEventType)
+	s.GetEventTypesCatalog = m(s.GetEventTypesCatalog)
+	s.GetWebhookEndpointStatusHistory = m(s.GetWebhookEndpointStatusHistory)
+	s.GetWebhookEndpointStats = m(s.GetWebhookEndpointStats)
+	s.ListDeadLetters = m(s.ListDeadLetters)
+	s.GetDeadLetter = m(s.GetDeadLetter)
+	s.ReplayDeadLetter = m(s.ReplayDeadLetter)
+	s.ReplayDeadLetters = m(s.ReplayDeadLetters)
+	s.DeleteDeadLetter = m(s.DeleteDeadLetter)
+	s.PurgeDeadLetters = m(s.PurgeDeadLetters)
 }
 
 // Mount configures the mux to serve the Zebrahook endpoints.
@@ -92,6 +197,32 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountUpdateHandler(mux, h.Update)
 	MountListWebhookEndpointHandler(mux, h.ListWebhookEndpoint)
 	MountGetWebhookEndpointByIDHandler(mux, h.GetWebhookEndpointByID)
+	MountDeleteWebhookEndpointHandler(mux, h.DeleteWebhookEndpoint)
+	MountListEventsHandler(mux, h.ListEvents)
+	MountGetEventByIDHandler(mux, h.GetEventByID)
+	MountListWebhookEndpointAttemptsHandler(mux, h.ListWebhookEndpointAttempts)
+	MountRetryEventDeliveryHandler(mux, h.RetryEventDelivery)
+	MountRecoverWebhookEndpointHandler(mux, h.RecoverWebhookEndpoint)
+	MountGetWebhookEndpointRecoveryHandler(mux, h.GetWebhookEndpointRecovery)
+	MountRotateWebhookEndpointSecretHandler(mux, h.RotateWebhookEndpointSecret)
+	MountTestWebhookEndpointHandler(mux, h.TestWebhookEndpoint)
+	MountListAPIKeysHandler(mux, h.ListAPIKeys)
+	MountGetAPIKeyHandler(mux, h.GetAPIKey)
+	MountUpdateAPIKeyHandler(mux, h.UpdateAPIKey)
+	MountRevokeAPIKeyHandler(mux, h.RevokeAPIKey)
+	MountRegisterEventTypeHandler(mux, h.RegisterEventType)
+	MountListEventTypesHandler(mux, h.ListEventTypes)
+	MountGetEventTypeHandler(mux, h.GetEventType)
+	MountUpdateEventTypeHandler(mux, h.UpdateEventType)
+	MountGetEventTypesCatalogHandler(mux, h.GetEventTypesCatalog)
+	MountGetWebhookEndpointStatusHistoryHandler(mux, h.GetWebhookEndpointStatusHistory)
+	MountGetWebhookEndpointStatsHandler(mux, h.GetWebhookEndpointStats)
+	MountListDeadLettersHandler(mux, h.ListDeadLetters)
+	MountGetDeadLetterHandler(mux, h.GetDeadLetter)
+	MountReplayDeadLetterHandler(mux, h.ReplayDeadLetter)
+	MountReplayDeadLettersHandler(mux, h.ReplayDeadLetters)
+	MountDeleteDeadLetterHandler(mux, h.DeleteDeadLetter)
+	MountPurgeDeadLettersHandler(mux, h.PurgeDeadLetters)
 }
 
 // Mount configures the mux to serve the Zebrahook endpoints.
@@ -353,3 +484,1342 @@ func NewGetWebhookEndpointByIDHandler(
 		}
 	})
 }
+
+// MountDeleteWebhookEndpointHandler configures the mux to serve the
+// "Zebrahook" service "deleteWebhookEndpoint" endpoint.
+func MountDeleteWebhookEndpointHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/webhook/endpoints/{id}", f)
+}
+
+// NewDeleteWebhookEndpointHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "deleteWebhookEndpoint" endpoint.
+func NewDeleteWebhookEndpointHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteWebhookEndpointRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookEndpointResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "deleteWebhookEndpoint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListEventsHandler configures the mux to serve the "Zebrahook" service
+// "listEvents" endpoint.
+func MountListEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/events", f)
+}
+
+// NewListEventsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "Zebrahook" service "listEvents" endpoint.
+func NewListEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListEventsRequest(mux, decoder)
+		encodeResponse = EncodeListEventsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listEvents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetEventByIDHandler configures the mux to serve the "Zebrahook" service
+// "getEventById" endpoint.
+func MountGetEventByIDHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/events/{id}", f)
+}
+
+// NewGetEventByIDHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "getEventById" endpoint.
+func NewGetEventByIDHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetEventByIDRequest(mux, decoder)
+		encodeResponse = EncodeGetEventByIDResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getEventById")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListWebhookEndpointAttemptsHandler configures the mux to serve the
+// "Zebrahook" service "listWebhookEndpointAttempts" endpoint.
+func MountListWebhookEndpointAttemptsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/endpoints/{id}/attempts", f)
+}
+
+// NewListWebhookEndpointAttemptsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Zebrahook" service "listWebhookEndpointAttempts"
+// endpoint.
+func NewListWebhookEndpointAttemptsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhookEndpointAttemptsRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookEndpointAttemptsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listWebhookEndpointAttempts")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRetryEventDeliveryHandler configures the mux to serve the "Zebrahook"
+// service "retryEventDelivery" endpoint.
+func MountRetryEventDeliveryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/events/{id}/deliveries/{endpoint_id}/retry", f)
+}
+
+// NewRetryEventDeliveryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "retryEventDelivery" endpoint.
+func NewRetryEventDeliveryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRetryEventDeliveryRequest(mux, decoder)
+		encodeResponse = EncodeRetryEventDeliveryResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "retryEventDelivery")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRecoverWebhookEndpointHandler configures the mux to serve the
+// "Zebrahook" service "recoverWebhookEndpoint" endpoint.
+func MountRecoverWebhookEndpointHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/endpoints/{id}/recover", f)
+}
+
+// NewRecoverWebhookEndpointHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "recoverWebhookEndpoint" endpoint.
+func NewRecoverWebhookEndpointHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRecoverWebhookEndpointRequest(mux, decoder)
+		encodeResponse = EncodeRecoverWebhookEndpointResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "recoverWebhookEndpoint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetWebhookEndpointRecoveryHandler configures the mux to serve the
+// "Zebrahook" service "getWebhookEndpointRecovery" endpoint.
+func MountGetWebhookEndpointRecoveryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/endpoints/{id}/recover/{recovery_id}", f)
+}
+
+// NewGetWebhookEndpointRecoveryHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Zebrahook" service "getWebhookEndpointRecovery"
+// endpoint.
+func NewGetWebhookEndpointRecoveryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetWebhookEndpointRecoveryRequest(mux, decoder)
+		encodeResponse = EncodeGetWebhookEndpointRecoveryResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getWebhookEndpointRecovery")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRotateWebhookEndpointSecretHandler configures the mux to serve the
+// "Zebrahook" service "rotateWebhookEndpointSecret" endpoint.
+func MountRotateWebhookEndpointSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/endpoints/{id}/rotate-secret", f)
+}
+
+// NewRotateWebhookEndpointSecretHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Zebrahook" service "rotateWebhookEndpointSecret"
+// endpoint.
+func NewRotateWebhookEndpointSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRotateWebhookEndpointSecretRequest(mux, decoder)
+		encodeResponse = EncodeRotateWebhookEndpointSecretResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "rotateWebhookEndpointSecret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountTestWebhookEndpointHandler configures the mux to serve the "Zebrahook"
+// service "testWebhookEndpoint" endpoint.
+func MountTestWebhookEndpointHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/endpoints/{id}/test", f)
+}
+
+// NewTestWebhookEndpointHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "testWebhookEndpoint" endpoint.
+func NewTestWebhookEndpointHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTestWebhookEndpointRequest(mux, decoder)
+		encodeResponse = EncodeTestWebhookEndpointResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "testWebhookEndpoint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListAPIKeysHandler configures the mux to serve the "Zebrahook" service
+// "listApiKeys" endpoint.
+func MountListAPIKeysHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/api-keys", f)
+}
+
+// NewListAPIKeysHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "listApiKeys" endpoint.
+func NewListAPIKeysHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAPIKeysRequest(mux, decoder)
+		encodeResponse = EncodeListAPIKeysResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listApiKeys")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAPIKeyHandler configures the mux to serve the "Zebrahook" service
+// "getApiKey" endpoint.
+func MountGetAPIKeyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/api-keys/{id}", f)
+}
+
+// NewGetAPIKeyHandler creates a HTTP handler which loads the HTTP request and
+// calls the "Zebrahook" service "getApiKey" endpoint.
+func NewGetAPIKeyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAPIKeyRequest(mux, decoder)
+		encodeResponse = EncodeGetAPIKeyResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getApiKey")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateAPIKeyHandler configures the mux to serve the "Zebrahook" service
+// "updateApiKey" endpoint.
+func MountUpdateAPIKeyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/webhook/api-keys/{id}", f)
+}
+
+// NewUpdateAPIKeyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "updateApiKey" endpoint.
+func NewUpdateAPIKeyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateAPIKeyRequest(mux, decoder)
+		encodeResponse = EncodeUpdateAPIKeyResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "updateApiKey")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeAPIKeyHandler configures the mux to serve the "Zebrahook" service
+// "revokeApiKey" endpoint.
+func MountRevokeAPIKeyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/api-keys/{id}/revoke", f)
+}
+
+// NewRevokeAPIKeyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "revokeApiKey" endpoint.
+func NewRevokeAPIKeyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeAPIKeyRequest(mux, decoder)
+		encodeResponse = EncodeRevokeAPIKeyResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revokeApiKey")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRegisterEventTypeHandler configures the mux to serve the "Zebrahook"
+// service "registerEventType" endpoint.
+func MountRegisterEventTypeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/event-types", f)
+}
+
+// NewRegisterEventTypeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "registerEventType" endpoint.
+func NewRegisterEventTypeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRegisterEventTypeRequest(mux, decoder)
+		encodeResponse = EncodeRegisterEventTypeResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "registerEventType")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListEventTypesHandler configures the mux to serve the "Zebrahook"
+// service "listEventTypes" endpoint.
+func MountListEventTypesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/event-types", f)
+}
+
+// NewListEventTypesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "listEventTypes" endpoint.
+func NewListEventTypesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListEventTypesRequest(mux, decoder)
+		encodeResponse = EncodeListEventTypesResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listEventTypes")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetEventTypeHandler configures the mux to serve the "Zebrahook" service
+// "getEventType" endpoint.
+func MountGetEventTypeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/event-types/{name}", f)
+}
+
+// NewGetEventTypeHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "getEventType" endpoint.
+func NewGetEventTypeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetEventTypeRequest(mux, decoder)
+		encodeResponse = EncodeGetEventTypeResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getEventType")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateEventTypeHandler configures the mux to serve the "Zebrahook"
+// service "updateEventType" endpoint.
+func MountUpdateEventTypeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/webhook/event-types/{name}", f)
+}
+
+// NewUpdateEventTypeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "updateEventType" endpoint.
+func NewUpdateEventTypeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateEventTypeRequest(mux, decoder)
+		encodeResponse = EncodeUpdateEventTypeResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "updateEventType")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetEventTypesCatalogHandler configures the mux to serve the "Zebrahook"
+// service "getEventTypesCatalog" endpoint.
+func MountGetEventTypesCatalogHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/event-types/catalog", f)
+}
+
+// NewGetEventTypesCatalogHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "getEventTypesCatalog" endpoint.
+func NewGetEventTypesCatalogHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetEventTypesCatalogRequest(mux, decoder)
+		encodeResponse = EncodeGetEventTypesCatalogResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getEventTypesCatalog")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*zebrahook.GetEventTypesCatalogResponseData)
+		defer o.Body.Close()
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			errhandler(ctx, w, err)
+			return
+		}
+		if _, err := io.Copy(w, o.Body); err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetWebhookEndpointStatusHistoryHandler configures the mux to serve the
+// "Zebrahook" service "getWebhookEndpointStatusHistory" endpoint.
+func MountGetWebhookEndpointStatusHistoryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/endpoints/{id}/status-history", f)
+}
+
+// NewGetWebhookEndpointStatusHistoryHandler creates a HTTP handler which loads
+// the HTTP request and calls the "Zebrahook" service
+// "getWebhookEndpointStatusHistory" endpoint.
+func NewGetWebhookEndpointStatusHistoryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetWebhookEndpointStatusHistoryRequest(mux, decoder)
+		encodeResponse = EncodeGetWebhookEndpointStatusHistoryResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getWebhookEndpointStatusHistory")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetWebhookEndpointStatsHandler configures the mux to serve the
+// "Zebrahook" service "getWebhookEndpointStats" endpoint.
+func MountGetWebhookEndpointStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/endpoints/{id}/stats", f)
+}
+
+// NewGetWebhookEndpointStatsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "Zebrahook" service "getWebhookEndpointStats"
+// endpoint.
+func NewGetWebhookEndpointStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetWebhookEndpointStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetWebhookEndpointStatsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getWebhookEndpointStats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListDeadLettersHandler configures the mux to serve the "Zebrahook"
+// service "listDeadLetters" endpoint.
+func MountListDeadLettersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/dead-letters", f)
+}
+
+// NewListDeadLettersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "listDeadLetters" endpoint.
+func NewListDeadLettersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDeadLettersRequest(mux, decoder)
+		encodeResponse = EncodeListDeadLettersResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listDeadLetters")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetDeadLetterHandler configures the mux to serve the "Zebrahook"
+// service "getDeadLetter" endpoint.
+func MountGetDeadLetterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/webhook/dead-letters/{id}", f)
+}
+
+// NewGetDeadLetterHandler creates a HTTP handler which loads the HTTP request
+// and calls the "Zebrahook" service "getDeadLetter" endpoint.
+func NewGetDeadLetterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetDeadLetterRequest(mux, decoder)
+		encodeResponse = EncodeGetDeadLetterResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getDeadLetter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountReplayDeadLetterHandler configures the mux to serve the "Zebrahook"
+// service "replayDeadLetter" endpoint.
+func MountReplayDeadLetterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/dead-letters/{id}/replay", f)
+}
+
+// NewReplayDeadLetterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "replayDeadLetter" endpoint.
+func NewReplayDeadLetterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReplayDeadLetterRequest(mux, decoder)
+		encodeResponse = EncodeReplayDeadLetterResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "replayDeadLetter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountReplayDeadLettersHandler configures the mux to serve the "Zebrahook"
+// service "replayDeadLetters" endpoint.
+func MountReplayDeadLettersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/dead-letters/replay", f)
+}
+
+// NewReplayDeadLettersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "replayDeadLetters" endpoint.
+func NewReplayDeadLettersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReplayDeadLettersRequest(mux, decoder)
+		encodeResponse = EncodeReplayDeadLettersResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "replayDeadLetters")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteDeadLetterHandler configures the mux to serve the "Zebrahook"
+// service "deleteDeadLetter" endpoint.
+func MountDeleteDeadLetterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/webhook/dead-letters/{id}", f)
+}
+
+// NewDeleteDeadLetterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "deleteDeadLetter" endpoint.
+func NewDeleteDeadLetterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteDeadLetterRequest(mux, decoder)
+		encodeResponse = EncodeDeleteDeadLetterResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "deleteDeadLetter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountPurgeDeadLettersHandler configures the mux to serve the "Zebrahook"
+// service "purgeDeadLetters" endpoint.
+func MountPurgeDeadLettersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/webhook/dead-letters/purge", f)
+}
+
+// NewPurgeDeadLettersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "Zebrahook" service "purgeDeadLetters" endpoint.
+func NewPurgeDeadLettersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePurgeDeadLettersRequest(mux, decoder)
+		encodeResponse = EncodePurgeDeadLettersResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "purgeDeadLetters")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "Zebrahook")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
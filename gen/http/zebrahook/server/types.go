@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook HTTP server types
 //
@@ -30,6 +30,14 @@ type RegisterRequestBody struct {
 	EnabledEvents []string `form:"enabled_events,omitempty" json:"enabled_events,omitempty" xml:"enabled_events,omitempty"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicyRequestBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
 }
 
 // UpdateRequestBody is the type of the "Zebrahook" service "update" endpoint
@@ -45,12 +53,121 @@ type UpdateRequestBody struct {
 	EnabledEvents []string `form:"enabled_events,omitempty" json:"enabled_events,omitempty" xml:"enabled_events,omitempty"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Custom HTTP headers added to every webhook request, replaces the current
+	// ones (use an empty object to remove them)
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Delivery policy of this endpoint, replaces the current one (settings not
+	// provided fall back to the global configuration)
+	DeliveryPolicy *DeliveryPolicyRequestBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
+}
+
+// RecoverWebhookEndpointRequestBody is the type of the "Zebrahook" service
+// "recoverWebhookEndpoint" endpoint HTTP request body.
+type RecoverWebhookEndpointRequestBody struct {
+	// recover event deliveries created since this time (unix timestamp seconds)
+	Since *int64 `form:"since,omitempty" json:"since,omitempty" xml:"since,omitempty"`
+}
+
+// RotateWebhookEndpointSecretRequestBody is the type of the "Zebrahook"
+// service "rotateWebhookEndpointSecret" endpoint HTTP request body.
+type RotateWebhookEndpointSecretRequestBody struct {
+	// how many seconds the previous secret stays valid, if not provided the
+	// configured value is used
+	GracePeriodSecs *uint `form:"grace_period_secs,omitempty" json:"grace_period_secs,omitempty" xml:"grace_period_secs,omitempty"`
+}
+
+// TestWebhookEndpointRequestBody is the type of the "Zebrahook" service
+// "testWebhookEndpoint" endpoint HTTP request body.
+type TestWebhookEndpointRequestBody struct {
+	// event type set in the sample content (`zebrahook.test` by default), can't be
+	// used with `event_content` as deliveries send only the event content
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// content of the test event, sent as is like the content of a delivery, if not
+	// provided a sample content is sent
+	EventContent map[string]interface{} `form:"event_content,omitempty" json:"event_content,omitempty" xml:"event_content,omitempty"`
+}
+
+// UpdateAPIKeyRequestBody is the type of the "Zebrahook" service
+// "updateApiKey" endpoint HTTP request body.
+type UpdateAPIKeyRequestBody struct {
+	// description for internal use
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// when this api key expires (unix timestamp seconds), use 0 to remove the
+	// expiration
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+}
+
+// RegisterEventTypeRequestBody is the type of the "Zebrahook" service
+// "registerEventType" endpoint HTTP request body.
+type RegisterEventTypeRequestBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must
+	// satisfy, external references are not allowed
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`, must satisfy the schema
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+}
+
+// UpdateEventTypeRequestBody is the type of the "Zebrahook" service
+// "updateEventType" endpoint HTTP request body.
+type UpdateEventTypeRequestBody struct {
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must
+	// satisfy, external references are not allowed
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`, must satisfy the schema
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+}
+
+// ReplayDeadLettersRequestBody is the type of the "Zebrahook" service
+// "replayDeadLetters" endpoint HTTP request body.
+type ReplayDeadLettersRequestBody struct {
+	// replay only these dead letters
+	Ids []uint `form:"ids,omitempty" json:"ids,omitempty" xml:"ids,omitempty"`
+	// replay only the dead letters of this webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// replay only the dead letters of this event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// replay only the dead letters created after (greater than or equal)
+	CreatedAtGte *uint64 `form:"createdAt.gte,omitempty" json:"createdAt.gte,omitempty" xml:"createdAt.gte,omitempty"`
+	// replay only the dead letters created before (less than)
+	CreatedAtLt *uint64 `form:"createdAt.lt,omitempty" json:"createdAt.lt,omitempty" xml:"createdAt.lt,omitempty"`
+	// limit how many dead letters to replay
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty" xml:"limit,omitempty"`
+}
+
+// PurgeDeadLettersRequestBody is the type of the "Zebrahook" service
+// "purgeDeadLetters" endpoint HTTP request body.
+type PurgeDeadLettersRequestBody struct {
+	// purge only the dead letters with this status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// purge only the dead letters of this webhook endpoint
+	EndpointID *string `form:"endpoint_id,omitempty" json:"endpoint_id,omitempty" xml:"endpoint_id,omitempty"`
+	// purge only the dead letters of this event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// purge only the dead letters created before (less than)
+	CreatedAtLt *uint64 `form:"createdAt.lt,omitempty" json:"createdAt.lt,omitempty" xml:"createdAt.lt,omitempty"`
 }
 
 // SubmitNewEventsResponseBody is the type of the "Zebrahook" service
 // "submitNewEvents" endpoint HTTP response body.
 type SubmitNewEventsResponseBody struct {
-	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// true if all the submitted events have been accepted
+	Success bool `form:"success" json:"success" xml:"success"`
+	// result for each submitted event, in the same order of the submitted events
+	Results []*SubmittedEventResultResponseBody `form:"results" json:"results" xml:"results"`
 }
 
 // RegisterResponseBody is the type of the "Zebrahook" service "register"
@@ -84,6 +201,13 @@ type GetWebhookEndpointByIDResponseBody struct {
 	// status of current endpoint, enabled means that the webhook endpoint is
 	// eligible for receiving webhook events
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// why the endpoint was disabled, not set when enabled
+	DisabledReason *string `form:"disabled_reason,omitempty" json:"disabled_reason,omitempty" xml:"disabled_reason,omitempty"`
+	// circuit breaker state, deliveries are held while open and a single probe
+	// delivery is sent while half open
+	CircuitState *string `form:"circuit_state,omitempty" json:"circuit_state,omitempty" xml:"circuit_state,omitempty"`
+	// when the circuit was opened (unix timestamp seconds)
+	CircuitOpenedAt *int64 `form:"circuit_opened_at,omitempty" json:"circuit_opened_at,omitempty" xml:"circuit_opened_at,omitempty"`
 	// URL of the webhook that will be called on each `enabled_events`
 	URL string `form:"url" json:"url" xml:"url"`
 	// Enabled events for this webhook URL, regex supported - use `["*"]` to listen
@@ -91,12 +215,434 @@ type GetWebhookEndpointByIDResponseBody struct {
 	EnabledEvents []string `form:"enabled_events" json:"enabled_events" xml:"enabled_events"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicyResponseBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
+	// identifier of the webhook
+	ID string `form:"id" json:"id" xml:"id"`
+	// secret to be used by the webhook to verify the events
+	Secret string `form:"secret" json:"secret" xml:"secret"`
+}
+
+// DeleteWebhookEndpointResponseBody is the type of the "Zebrahook" service
+// "deleteWebhookEndpoint" endpoint HTTP response body.
+type DeleteWebhookEndpointResponseBody struct {
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// ListEventsResponseBody is the type of the "Zebrahook" service "listEvents"
+// endpoint HTTP response body.
+type ListEventsResponseBody struct {
+	Result []*EventResponseBody `form:"result" json:"result" xml:"result"`
+	// true if there are more events after this page, use the last identifier as
+	// `starting_after`
+	HasMore bool `form:"has_more" json:"has_more" xml:"has_more"`
+}
+
+// GetEventByIDResponseBody is the type of the "Zebrahook" service
+// "getEventById" endpoint HTTP response body.
+type GetEventByIDResponseBody struct {
+	// deliveries of this event, one for each webhook endpoint subscribed
+	Deliveries []*EventDeliveryResponseBody `form:"deliveries" json:"deliveries" xml:"deliveries"`
+	// identifier of the event
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Event type of the `event_content`
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// event content dispatched to the webhook endpoints
+	EventContent map[string]interface{} `form:"event_content" json:"event_content" xml:"event_content"`
+	// priority of this event
+	Priority int `form:"priority" json:"priority" xml:"priority"`
+	// when this event was submitted (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// ListWebhookEndpointAttemptsResponseBody is the type of the "Zebrahook"
+// service "listWebhookEndpointAttempts" endpoint HTTP response body.
+type ListWebhookEndpointAttemptsResponseBody struct {
+	Result []*EventDeliveryAttemptResponseBody `form:"result" json:"result" xml:"result"`
+	// true if there are more attempts after this page, use the last identifier as
+	// `starting_after`
+	HasMore bool `form:"has_more" json:"has_more" xml:"has_more"`
+}
+
+// RetryEventDeliveryResponseBody is the type of the "Zebrahook" service
+// "retryEventDelivery" endpoint HTTP response body.
+type RetryEventDeliveryResponseBody struct {
+	// identifier of the event delivery
+	ID uint `form:"id" json:"id" xml:"id"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// URL of the webhook endpoint
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// status of this delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// how many attempts have been made so far
+	AttemptsCounter uint `form:"attempts_counter" json:"attempts_counter" xml:"attempts_counter"`
+	// how many attempts are remaining
+	AttemptsRemaining uint `form:"attempts_remaining" json:"attempts_remaining" xml:"attempts_remaining"`
+	// when the next attempt is scheduled (unix timestamp seconds)
+	NextAttemptScheduledAt *uint `form:"next_attempt_scheduled_at,omitempty" json:"next_attempt_scheduled_at,omitempty" xml:"next_attempt_scheduled_at,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// RecoverWebhookEndpointResponseBody is the type of the "Zebrahook" service
+// "recoverWebhookEndpoint" endpoint HTTP response body.
+type RecoverWebhookEndpointResponseBody struct {
+	// identifier of the recovery
+	ID string `form:"id" json:"id" xml:"id"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// event deliveries created since this time are recovered (unix timestamp
+	// seconds)
+	Since int64 `form:"since" json:"since" xml:"since"`
+	// status of the recovery
+	Status string `form:"status" json:"status" xml:"status"`
+	// how many event deliveries will be delivered again
+	DeliveriesFound uint `form:"deliveries_found" json:"deliveries_found" xml:"deliveries_found"`
+	// how many event deliveries have been enqueued so far
+	DeliveriesEnqueued uint `form:"deliveries_enqueued" json:"deliveries_enqueued" xml:"deliveries_enqueued"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// GetWebhookEndpointRecoveryResponseBody is the type of the "Zebrahook"
+// service "getWebhookEndpointRecovery" endpoint HTTP response body.
+type GetWebhookEndpointRecoveryResponseBody struct {
+	// identifier of the recovery
+	ID string `form:"id" json:"id" xml:"id"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// event deliveries created since this time are recovered (unix timestamp
+	// seconds)
+	Since int64 `form:"since" json:"since" xml:"since"`
+	// status of the recovery
+	Status string `form:"status" json:"status" xml:"status"`
+	// how many event deliveries will be delivered again
+	DeliveriesFound uint `form:"deliveries_found" json:"deliveries_found" xml:"deliveries_found"`
+	// how many event deliveries have been enqueued so far
+	DeliveriesEnqueued uint `form:"deliveries_enqueued" json:"deliveries_enqueued" xml:"deliveries_enqueued"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// RotateWebhookEndpointSecretResponseBody is the type of the "Zebrahook"
+// service "rotateWebhookEndpointSecret" endpoint HTTP response body.
+type RotateWebhookEndpointSecretResponseBody struct {
+	// until when the previous secret is used to sign events (unix timestamp
+	// seconds)
+	PreviousSecretExpiresAt int64 `form:"previous_secret_expires_at" json:"previous_secret_expires_at" xml:"previous_secret_expires_at"`
 	// identifier of the webhook
 	ID string `form:"id" json:"id" xml:"id"`
 	// secret to be used by the webhook to verify the events
 	Secret string `form:"secret" json:"secret" xml:"secret"`
 }
 
+// TestWebhookEndpointResponseBody is the type of the "Zebrahook" service
+// "testWebhookEndpoint" endpoint HTTP response body.
+type TestWebhookEndpointResponseBody struct {
+	// outcome of the request
+	Status string `form:"status" json:"status" xml:"status"`
+	// http status code of the response (if any)
+	HTTPStatusCode *int `form:"http_status_code,omitempty" json:"http_status_code,omitempty" xml:"http_status_code,omitempty"`
+	// response body returned from the endpoint, truncated (if any)
+	HTTPBodyResponse *string `form:"http_body_response,omitempty" json:"http_body_response,omitempty" xml:"http_body_response,omitempty"`
+	// response time in seconds
+	HTTPResponseTimeSecs float64 `form:"http_response_time_secs" json:"http_response_time_secs" xml:"http_response_time_secs"`
+}
+
+// ListAPIKeysResponseBody is the type of the "Zebrahook" service "listApiKeys"
+// endpoint HTTP response body.
+type ListAPIKeysResponseBody struct {
+	Result []*APIKeyResponseBody `form:"result" json:"result" xml:"result"`
+}
+
+// GetAPIKeyResponseBody is the type of the "Zebrahook" service "getApiKey"
+// endpoint HTTP response body.
+type GetAPIKeyResponseBody struct {
+	// identifier of the api key
+	ID uint `form:"id" json:"id" xml:"id"`
+	// description for internal use
+	Description string `form:"description" json:"description" xml:"description"`
+	// status of the api key, disabled keys are rejected
+	Status string `form:"status" json:"status" xml:"status"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant string `form:"tenant" json:"tenant" xml:"tenant"`
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64 `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// UpdateAPIKeyResponseBody is the type of the "Zebrahook" service
+// "updateApiKey" endpoint HTTP response body.
+type UpdateAPIKeyResponseBody struct {
+	// identifier of the api key
+	ID uint `form:"id" json:"id" xml:"id"`
+	// description for internal use
+	Description string `form:"description" json:"description" xml:"description"`
+	// status of the api key, disabled keys are rejected
+	Status string `form:"status" json:"status" xml:"status"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant string `form:"tenant" json:"tenant" xml:"tenant"`
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64 `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// RevokeAPIKeyResponseBody is the type of the "Zebrahook" service
+// "revokeApiKey" endpoint HTTP response body.
+type RevokeAPIKeyResponseBody struct {
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// RegisterEventTypeResponseBody is the type of the "Zebrahook" service
+// "registerEventType" endpoint HTTP response body.
+type RegisterEventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name string `form:"name" json:"name" xml:"name"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool `form:"deprecated" json:"deprecated" xml:"deprecated"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// ListEventTypesResponseBody is the type of the "Zebrahook" service
+// "listEventTypes" endpoint HTTP response body.
+type ListEventTypesResponseBody struct {
+	Result []*EventTypeResponseBody `form:"result" json:"result" xml:"result"`
+}
+
+// GetEventTypeResponseBody is the type of the "Zebrahook" service
+// "getEventType" endpoint HTTP response body.
+type GetEventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name string `form:"name" json:"name" xml:"name"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool `form:"deprecated" json:"deprecated" xml:"deprecated"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// UpdateEventTypeResponseBody is the type of the "Zebrahook" service
+// "updateEventType" endpoint HTTP response body.
+type UpdateEventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name string `form:"name" json:"name" xml:"name"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool `form:"deprecated" json:"deprecated" xml:"deprecated"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// GetWebhookEndpointStatusHistoryResponseBody is the type of the "Zebrahook"
+// service "getWebhookEndpointStatusHistory" endpoint HTTP response body.
+type GetWebhookEndpointStatusHistoryResponseBody struct {
+	Result []*EndpointStatusEventResponseBody `form:"result" json:"result" xml:"result"`
+	// true if there are more status changes after this page, use the last
+	// identifier as `starting_after`
+	HasMore bool `form:"has_more" json:"has_more" xml:"has_more"`
+}
+
+// GetWebhookEndpointStatsResponseBody is the type of the "Zebrahook" service
+// "getWebhookEndpointStats" endpoint HTTP response body.
+type GetWebhookEndpointStatsResponseBody struct {
+	// webhook endpoint identifier
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// time window of the stats
+	Window string `form:"window" json:"window" xml:"window"`
+	// start of the window (unix timestamp seconds), aligned to the 5 minutes stats
+	// buckets
+	From int64 `form:"from" json:"from" xml:"from"`
+	// end of the window (unix timestamp seconds)
+	To int64 `form:"to" json:"to" xml:"to"`
+	// delivery attempts made in the window
+	Attempts uint `form:"attempts" json:"attempts" xml:"attempts"`
+	// share of successful attempts (0-1), not set without attempts
+	SuccessRate *float64 `form:"success_rate,omitempty" json:"success_rate,omitempty" xml:"success_rate,omitempty"`
+	// attempts per attempt status
+	StatusCounts map[string]uint `form:"status_counts" json:"status_counts" xml:"status_counts"`
+	// attempts per http response status code
+	HTTPStatusCodes map[string]uint `form:"http_status_codes" json:"http_status_codes" xml:"http_status_codes"`
+	// response time percentiles, estimated from a histogram (linear interpolation
+	// within the bucket holding the percentile), not set without responses
+	ResponseTimeSecs *ResponseTimePercentilesResponseBody `form:"response_time_secs,omitempty" json:"response_time_secs,omitempty" xml:"response_time_secs,omitempty"`
+}
+
+// ListDeadLettersResponseBody is the type of the "Zebrahook" service
+// "listDeadLetters" endpoint HTTP response body.
+type ListDeadLettersResponseBody struct {
+	Result []*DeadLetterResponseBody `form:"result" json:"result" xml:"result"`
+	// true if there are more dead letters after this page, use the last identifier
+	// as `starting_after`
+	HasMore bool `form:"has_more" json:"has_more" xml:"has_more"`
+}
+
+// GetDeadLetterResponseBody is the type of the "Zebrahook" service
+// "getDeadLetter" endpoint HTTP response body.
+type GetDeadLetterResponseBody struct {
+	// body of the last response (if any)
+	LastHTTPBodyResponse *string `form:"last_http_body_response,omitempty" json:"last_http_body_response,omitempty" xml:"last_http_body_response,omitempty"`
+	// event that was not delivered
+	Event *EventResponseBody `form:"event" json:"event" xml:"event"`
+	// identifier of the dead letter
+	ID uint `form:"id" json:"id" xml:"id"`
+	// identifier of the failed event delivery
+	EventDeliveryID uint `form:"event_delivery_id" json:"event_delivery_id" xml:"event_delivery_id"`
+	// identifier of the event
+	EventID uint `form:"event_id" json:"event_id" xml:"event_id"`
+	// event type of the event
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// why the delivery failed
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// how many attempts have been made
+	AttemptsCounter uint `form:"attempts_counter" json:"attempts_counter" xml:"attempts_counter"`
+	// outcome of the last attempt
+	LastAttemptStatus string `form:"last_attempt_status" json:"last_attempt_status" xml:"last_attempt_status"`
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int `form:"last_http_status_code,omitempty" json:"last_http_status_code,omitempty" xml:"last_http_status_code,omitempty"`
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64 `form:"last_attempt_made_at,omitempty" json:"last_attempt_made_at,omitempty" xml:"last_attempt_made_at,omitempty"`
+	// dead until the event is delivered again
+	Status string `form:"status" json:"status" xml:"status"`
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint `form:"replay_event_delivery_id,omitempty" json:"replay_event_delivery_id,omitempty" xml:"replay_event_delivery_id,omitempty"`
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64 `form:"replayed_at,omitempty" json:"replayed_at,omitempty" xml:"replayed_at,omitempty"`
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// ReplayDeadLetterResponseBody is the type of the "Zebrahook" service
+// "replayDeadLetter" endpoint HTTP response body.
+type ReplayDeadLetterResponseBody struct {
+	// identifier of the dead letter
+	ID uint `form:"id" json:"id" xml:"id"`
+	// identifier of the failed event delivery
+	EventDeliveryID uint `form:"event_delivery_id" json:"event_delivery_id" xml:"event_delivery_id"`
+	// identifier of the event
+	EventID uint `form:"event_id" json:"event_id" xml:"event_id"`
+	// event type of the event
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// why the delivery failed
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// how many attempts have been made
+	AttemptsCounter uint `form:"attempts_counter" json:"attempts_counter" xml:"attempts_counter"`
+	// outcome of the last attempt
+	LastAttemptStatus string `form:"last_attempt_status" json:"last_attempt_status" xml:"last_attempt_status"`
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int `form:"last_http_status_code,omitempty" json:"last_http_status_code,omitempty" xml:"last_http_status_code,omitempty"`
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64 `form:"last_attempt_made_at,omitempty" json:"last_attempt_made_at,omitempty" xml:"last_attempt_made_at,omitempty"`
+	// dead until the event is delivered again
+	Status string `form:"status" json:"status" xml:"status"`
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint `form:"replay_event_delivery_id,omitempty" json:"replay_event_delivery_id,omitempty" xml:"replay_event_delivery_id,omitempty"`
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64 `form:"replayed_at,omitempty" json:"replayed_at,omitempty" xml:"replayed_at,omitempty"`
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// ReplayDeadLettersResponseBody is the type of the "Zebrahook" service
+// "replayDeadLetters" endpoint HTTP response body.
+type ReplayDeadLettersResponseBody struct {
+	// replayed dead letters
+	Result []*DeadLetterResponseBody `form:"result" json:"result" xml:"result"`
+	// true if there are more dead letters to replay matching the filters
+	HasMore bool `form:"has_more" json:"has_more" xml:"has_more"`
+}
+
+// DeleteDeadLetterResponseBody is the type of the "Zebrahook" service
+// "deleteDeadLetter" endpoint HTTP response body.
+type DeleteDeadLetterResponseBody struct {
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// PurgeDeadLettersResponseBody is the type of the "Zebrahook" service
+// "purgeDeadLetters" endpoint HTTP response body.
+type PurgeDeadLettersResponseBody struct {
+	// how many dead letters have been removed
+	Purged int64 `form:"purged" json:"purged" xml:"purged"`
+}
+
+// SubmittedEventResultResponseBody is used to define fields on response body
+// types.
+type SubmittedEventResultResponseBody struct {
+	// position of the event in the submitted events
+	Index int `form:"index" json:"index" xml:"index"`
+	// identifier of the event, not present if the event was rejected
+	EventID *uint `form:"event_id,omitempty" json:"event_id,omitempty" xml:"event_id,omitempty"`
+	// normalized event type
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// `pending_mapping` until the event is mapped to the subscribed webhook
+	// endpoints
+	MappingStatus *string `form:"mapping_status,omitempty" json:"mapping_status,omitempty" xml:"mapping_status,omitempty"`
+	// how many webhook endpoints are subscribed to this event, present once mapped
+	EndpointsMatched *uint `form:"endpoints_matched,omitempty" json:"endpoints_matched,omitempty" xml:"endpoints_matched,omitempty"`
+	// reason why the event was rejected
+	ErrorCode *string `form:"error_code,omitempty" json:"error_code,omitempty" xml:"error_code,omitempty"`
+	// human readable reason why the event was rejected
+	ErrorMessage *string `form:"error_message,omitempty" json:"error_message,omitempty" xml:"error_message,omitempty"`
+}
+
 // WebhookEndpointWithoutSecretResponseBody is used to define fields on
 // response body types.
 type WebhookEndpointWithoutSecretResponseBody struct {
@@ -107,6 +653,13 @@ type WebhookEndpointWithoutSecretResponseBody struct {
 	// status of current endpoint, enabled means that the webhook endpoint is
 	// eligible for receiving webhook events
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// why the endpoint was disabled, not set when enabled
+	DisabledReason *string `form:"disabled_reason,omitempty" json:"disabled_reason,omitempty" xml:"disabled_reason,omitempty"`
+	// circuit breaker state, deliveries are held while open and a single probe
+	// delivery is sent while half open
+	CircuitState *string `form:"circuit_state,omitempty" json:"circuit_state,omitempty" xml:"circuit_state,omitempty"`
+	// when the circuit was opened (unix timestamp seconds)
+	CircuitOpenedAt *int64 `form:"circuit_opened_at,omitempty" json:"circuit_opened_at,omitempty" xml:"circuit_opened_at,omitempty"`
 	// URL of the webhook that will be called on each `enabled_events`
 	URL string `form:"url" json:"url" xml:"url"`
 	// Enabled events for this webhook URL, regex supported - use `["*"]` to listen
@@ -114,10 +667,201 @@ type WebhookEndpointWithoutSecretResponseBody struct {
 	EnabledEvents []string `form:"enabled_events" json:"enabled_events" xml:"enabled_events"`
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string `form:"metadata,omitempty" json:"metadata,omitempty" xml:"metadata,omitempty"`
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicyResponseBody `form:"delivery_policy,omitempty" json:"delivery_policy,omitempty" xml:"delivery_policy,omitempty"`
 	// identifier of the webhook
 	ID string `form:"id" json:"id" xml:"id"`
 }
 
+// DeliveryPolicyResponseBody is used to define fields on response body types.
+type DeliveryPolicyResponseBody struct {
+	// HTTP request timeout in seconds
+	TimeoutSecs *uint `form:"timeout_secs,omitempty" json:"timeout_secs,omitempty" xml:"timeout_secs,omitempty"`
+	// maximum number of delivery attempts of each event
+	MaxAttempts *uint `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// backoff strategy between two attempts: exponential (with full jitter),
+	// linear, fixed or schedule
+	BackoffType *string `form:"backoff_type,omitempty" json:"backoff_type,omitempty" xml:"backoff_type,omitempty"`
+	// base seconds used to calculate the backoff
+	BackoffBaseSecs *uint `form:"backoff_base_secs,omitempty" json:"backoff_base_secs,omitempty" xml:"backoff_base_secs,omitempty"`
+	// maximum seconds between two attempts
+	MaxBackoffSecs *uint `form:"max_backoff_secs,omitempty" json:"max_backoff_secs,omitempty" xml:"max_backoff_secs,omitempty"`
+	// seconds to wait before each retry with the schedule backoff type, the last
+	// one is used for the remaining attempts
+	BackoffScheduleSecs []uint `form:"backoff_schedule_secs,omitempty" json:"backoff_schedule_secs,omitempty" xml:"backoff_schedule_secs,omitempty"`
+}
+
+// EventResponseBody is used to define fields on response body types.
+type EventResponseBody struct {
+	// identifier of the event
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Event type of the `event_content`
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// event content dispatched to the webhook endpoints
+	EventContent map[string]interface{} `form:"event_content" json:"event_content" xml:"event_content"`
+	// priority of this event
+	Priority int `form:"priority" json:"priority" xml:"priority"`
+	// when this event was submitted (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// EventDeliveryResponseBody is used to define fields on response body types.
+type EventDeliveryResponseBody struct {
+	// identifier of the event delivery
+	ID uint `form:"id" json:"id" xml:"id"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// URL of the webhook endpoint
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// status of this delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// how many attempts have been made so far
+	AttemptsCounter uint `form:"attempts_counter" json:"attempts_counter" xml:"attempts_counter"`
+	// how many attempts are remaining
+	AttemptsRemaining uint `form:"attempts_remaining" json:"attempts_remaining" xml:"attempts_remaining"`
+	// when the next attempt is scheduled (unix timestamp seconds)
+	NextAttemptScheduledAt *uint `form:"next_attempt_scheduled_at,omitempty" json:"next_attempt_scheduled_at,omitempty" xml:"next_attempt_scheduled_at,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// EventDeliveryAttemptResponseBody is used to define fields on response body
+// types.
+type EventDeliveryAttemptResponseBody struct {
+	// identifier of the attempt
+	ID uint `form:"id" json:"id" xml:"id"`
+	// identifier of the event delivery
+	EventDeliveryID uint `form:"event_delivery_id" json:"event_delivery_id" xml:"event_delivery_id"`
+	// identifier of the event
+	EventID uint `form:"event_id" json:"event_id" xml:"event_id"`
+	// event type of the event delivered
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// outcome of this attempt
+	Status string `form:"status" json:"status" xml:"status"`
+	// when the request was sent (unix timestamp seconds)
+	AttemptMadeAt *int64 `form:"attempt_made_at,omitempty" json:"attempt_made_at,omitempty" xml:"attempt_made_at,omitempty"`
+	// http status code of the response (if any)
+	HTTPStatusCode *int `form:"http_status_code,omitempty" json:"http_status_code,omitempty" xml:"http_status_code,omitempty"`
+	// response body returned from the endpoint, truncated (if any)
+	HTTPBodyResponse *string `form:"http_body_response,omitempty" json:"http_body_response,omitempty" xml:"http_body_response,omitempty"`
+	// response time in seconds
+	HTTPResponseTimeSecs *float32 `form:"http_response_time_secs,omitempty" json:"http_response_time_secs,omitempty" xml:"http_response_time_secs,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// APIKeyResponseBody is used to define fields on response body types.
+type APIKeyResponseBody struct {
+	// identifier of the api key
+	ID uint `form:"id" json:"id" xml:"id"`
+	// description for internal use
+	Description string `form:"description" json:"description" xml:"description"`
+	// status of the api key, disabled keys are rejected
+	Status string `form:"status" json:"status" xml:"status"`
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant string `form:"tenant" json:"tenant" xml:"tenant"`
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64 `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64 `form:"lastUsedAt,omitempty" json:"lastUsedAt,omitempty" xml:"lastUsedAt,omitempty"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// EventTypeResponseBody is used to define fields on response body types.
+type EventTypeResponseBody struct {
+	// event type name, same format of the submitted `event_type`
+	Name string `form:"name" json:"name" xml:"name"`
+	// what the event type represents
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{} `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// example of `event_content`
+	Example map[string]interface{} `form:"example,omitempty" json:"example,omitempty" xml:"example,omitempty"`
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool `form:"deprecated" json:"deprecated" xml:"deprecated"`
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64 `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// EndpointStatusEventResponseBody is used to define fields on response body
+// types.
+type EndpointStatusEventResponseBody struct {
+	// identifier of the status change
+	ID uint `form:"id" json:"id" xml:"id"`
+	// status before the change
+	OldStatus string `form:"old_status" json:"old_status" xml:"old_status"`
+	// status after the change
+	NewStatus string `form:"new_status" json:"new_status" xml:"new_status"`
+	// why the status changed
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// api key that made the change, not set for automatic changes
+	ActorAPIKeyID *uint `form:"actor_api_key_id,omitempty" json:"actor_api_key_id,omitempty" xml:"actor_api_key_id,omitempty"`
+	// jwt subject that made the change, not set for automatic changes
+	ActorSubject *string `form:"actor_subject,omitempty" json:"actor_subject,omitempty" xml:"actor_subject,omitempty"`
+	// when the status changed (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
+// ResponseTimePercentilesResponseBody is used to define fields on response
+// body types.
+type ResponseTimePercentilesResponseBody struct {
+	// median response time in seconds
+	P50 float64 `form:"p50" json:"p50" xml:"p50"`
+	// 95th percentile of the response time in seconds
+	P95 float64 `form:"p95" json:"p95" xml:"p95"`
+	// 99th percentile of the response time in seconds
+	P99 float64 `form:"p99" json:"p99" xml:"p99"`
+}
+
+// DeadLetterResponseBody is used to define fields on response body types.
+type DeadLetterResponseBody struct {
+	// identifier of the dead letter
+	ID uint `form:"id" json:"id" xml:"id"`
+	// identifier of the failed event delivery
+	EventDeliveryID uint `form:"event_delivery_id" json:"event_delivery_id" xml:"event_delivery_id"`
+	// identifier of the event
+	EventID uint `form:"event_id" json:"event_id" xml:"event_id"`
+	// event type of the event
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// identifier of the webhook endpoint
+	EndpointID string `form:"endpoint_id" json:"endpoint_id" xml:"endpoint_id"`
+	// why the delivery failed
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// how many attempts have been made
+	AttemptsCounter uint `form:"attempts_counter" json:"attempts_counter" xml:"attempts_counter"`
+	// outcome of the last attempt
+	LastAttemptStatus string `form:"last_attempt_status" json:"last_attempt_status" xml:"last_attempt_status"`
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int `form:"last_http_status_code,omitempty" json:"last_http_status_code,omitempty" xml:"last_http_status_code,omitempty"`
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64 `form:"last_attempt_made_at,omitempty" json:"last_attempt_made_at,omitempty" xml:"last_attempt_made_at,omitempty"`
+	// dead until the event is delivered again
+	Status string `form:"status" json:"status" xml:"status"`
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint `form:"replay_event_delivery_id,omitempty" json:"replay_event_delivery_id,omitempty" xml:"replay_event_delivery_id,omitempty"`
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64 `form:"replayed_at,omitempty" json:"replayed_at,omitempty" xml:"replayed_at,omitempty"`
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt int64 `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
 // EventRequestRequestBody is used to define fields on request body types.
 type EventRequestRequestBody struct {
 	// Event type of the `event_content`
@@ -127,6 +871,27 @@ type EventRequestRequestBody struct {
 	// Optional priority for this event, an higher number will make this event
 	// delivered before other ones
 	Priority *int `form:"priority,omitempty" json:"priority,omitempty" xml:"priority,omitempty"`
+	// Optional idempotency key for this event, if an event with the same key was
+	// already submitted the event is not created again
+	IdempotencyKey *string `form:"idempotency_key,omitempty" json:"idempotency_key,omitempty" xml:"idempotency_key,omitempty"`
+}
+
+// DeliveryPolicyRequestBody is used to define fields on request body types.
+type DeliveryPolicyRequestBody struct {
+	// HTTP request timeout in seconds
+	TimeoutSecs *uint `form:"timeout_secs,omitempty" json:"timeout_secs,omitempty" xml:"timeout_secs,omitempty"`
+	// maximum number of delivery attempts of each event
+	MaxAttempts *uint `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// backoff strategy between two attempts: exponential (with full jitter),
+	// linear, fixed or schedule
+	BackoffType *string `form:"backoff_type,omitempty" json:"backoff_type,omitempty" xml:"backoff_type,omitempty"`
+	// base seconds used to calculate the backoff
+	BackoffBaseSecs *uint `form:"backoff_base_secs,omitempty" json:"backoff_base_secs,omitempty" xml:"backoff_base_secs,omitempty"`
+	// maximum seconds between two attempts
+	MaxBackoffSecs *uint `form:"max_backoff_secs,omitempty" json:"max_backoff_secs,omitempty" xml:"max_backoff_secs,omitempty"`
+	// seconds to wait before each retry with the schedule backoff type, the last
+	// one is used for the remaining attempts
+	BackoffScheduleSecs []uint `form:"backoff_schedule_secs,omitempty" json:"backoff_schedule_secs,omitempty" xml:"backoff_schedule_secs,omitempty"`
 }
 
 // NewSubmitNewEventsResponseBody builds the HTTP response body from the result
@@ -135,6 +900,12 @@ func NewSubmitNewEventsResponseBody(res *zebrahook.SubmitNewEventsResult) *Submi
 	body := &SubmitNewEventsResponseBody{
 		Success: res.Success,
 	}
+	if res.Results != nil {
+		body.Results = make([]*SubmittedEventResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			body.Results[i] = marshalZebrahookSubmittedEventResultToSubmittedEventResultResponseBody(val)
+		}
+	}
 	return body
 }
 
@@ -174,12 +945,15 @@ func NewListWebhookEndpointResponseBody(res *zebrahook.ListWebhookEndpointResult
 // result of the "getWebhookEndpointById" endpoint of the "Zebrahook" service.
 func NewGetWebhookEndpointByIDResponseBody(res *zebrahook.WebhookEndpoint) *GetWebhookEndpointByIDResponseBody {
 	body := &GetWebhookEndpointByIDResponseBody{
-		CreatedAt: res.CreatedAt,
-		UpdatedAt: res.UpdatedAt,
-		Status:    res.Status,
-		URL:       res.URL,
-		ID:        res.ID,
-		Secret:    res.Secret,
+		CreatedAt:       res.CreatedAt,
+		UpdatedAt:       res.UpdatedAt,
+		Status:          res.Status,
+		DisabledReason:  res.DisabledReason,
+		CircuitState:    res.CircuitState,
+		CircuitOpenedAt: res.CircuitOpenedAt,
+		URL:             res.URL,
+		ID:              res.ID,
+		Secret:          res.Secret,
 	}
 	if res.EnabledEvents != nil {
 		body.EnabledEvents = make([]string, len(res.EnabledEvents))
@@ -195,96 +969,948 @@ func NewGetWebhookEndpointByIDResponseBody(res *zebrahook.WebhookEndpoint) *GetW
 			body.Metadata[tk] = tv
 		}
 	}
+	if res.Headers != nil {
+		body.Headers = make(map[string]string, len(res.Headers))
+		for key, val := range res.Headers {
+			tk := key
+			tv := val
+			body.Headers[tk] = tv
+		}
+	}
+	if res.DeliveryPolicy != nil {
+		body.DeliveryPolicy = marshalZebrahookDeliveryPolicyToDeliveryPolicyResponseBody(res.DeliveryPolicy)
+	}
 	return body
 }
 
-// NewSubmitNewEventsPayload builds a Zebrahook service submitNewEvents
-// endpoint payload.
-func NewSubmitNewEventsPayload(body *SubmitNewEventsRequestBody, token string) *zebrahook.SubmitNewEventsPayload {
-	v := &zebrahook.SubmitNewEventsPayload{}
-	v.Events = make([]*zebrahook.EventRequest, len(body.Events))
-	for i, val := range body.Events {
-		v.Events[i] = unmarshalEventRequestRequestBodyToZebrahookEventRequest(val)
+// NewDeleteWebhookEndpointResponseBody builds the HTTP response body from the
+// result of the "deleteWebhookEndpoint" endpoint of the "Zebrahook" service.
+func NewDeleteWebhookEndpointResponseBody(res *zebrahook.DeleteWebhookEndpointResult) *DeleteWebhookEndpointResponseBody {
+	body := &DeleteWebhookEndpointResponseBody{
+		Success: res.Success,
 	}
-	v.Token = token
-
-	return v
+	return body
 }
 
-// NewRegisterPayload builds a Zebrahook service register endpoint payload.
-func NewRegisterPayload(body *RegisterRequestBody, token string) *zebrahook.RegisterPayload {
-	v := &zebrahook.RegisterPayload{
-		URL: *body.URL,
+// NewListEventsResponseBody builds the HTTP response body from the result of
+// the "listEvents" endpoint of the "Zebrahook" service.
+func NewListEventsResponseBody(res *zebrahook.ListEventsResult) *ListEventsResponseBody {
+	body := &ListEventsResponseBody{
+		HasMore: res.HasMore,
 	}
-	v.EnabledEvents = make([]string, len(body.EnabledEvents))
-	for i, val := range body.EnabledEvents {
-		v.EnabledEvents[i] = val
-	}
-	if body.Metadata != nil {
-		v.Metadata = make(map[string]string, len(body.Metadata))
-		for key, val := range body.Metadata {
-			tk := key
-			tv := val
-			v.Metadata[tk] = tv
+	if res.Result != nil {
+		body.Result = make([]*EventResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookEventToEventResponseBody(val)
 		}
 	}
-	v.Token = token
-
-	return v
+	return body
 }
 
-// NewUpdatePayload builds a Zebrahook service update endpoint payload.
-func NewUpdatePayload(body *UpdateRequestBody, id string, token string) *zebrahook.UpdatePayload {
-	v := &zebrahook.UpdatePayload{
-		URL:      body.URL,
-		Disabled: body.Disabled,
+// NewGetEventByIDResponseBody builds the HTTP response body from the result of
+// the "getEventById" endpoint of the "Zebrahook" service.
+func NewGetEventByIDResponseBody(res *zebrahook.EventWithDeliveries) *GetEventByIDResponseBody {
+	body := &GetEventByIDResponseBody{
+		ID:        res.ID,
+		EventType: res.EventType,
+		Priority:  res.Priority,
+		CreatedAt: res.CreatedAt,
 	}
-	if body.EnabledEvents != nil {
-		v.EnabledEvents = make([]string, len(body.EnabledEvents))
-		for i, val := range body.EnabledEvents {
-			v.EnabledEvents[i] = val
+	if res.Deliveries != nil {
+		body.Deliveries = make([]*EventDeliveryResponseBody, len(res.Deliveries))
+		for i, val := range res.Deliveries {
+			body.Deliveries[i] = marshalZebrahookEventDeliveryToEventDeliveryResponseBody(val)
 		}
 	}
-	if body.Metadata != nil {
-		v.Metadata = make(map[string]string, len(body.Metadata))
-		for key, val := range body.Metadata {
+	if res.EventContent != nil {
+		body.EventContent = make(map[string]interface{}, len(res.EventContent))
+		for key, val := range res.EventContent {
 			tk := key
 			tv := val
-			v.Metadata[tk] = tv
+			body.EventContent[tk] = tv
 		}
 	}
-	v.ID = id
-	v.Token = token
+	return body
+}
 
-	return v
+// NewListWebhookEndpointAttemptsResponseBody builds the HTTP response body
+// from the result of the "listWebhookEndpointAttempts" endpoint of the
+// "Zebrahook" service.
+func NewListWebhookEndpointAttemptsResponseBody(res *zebrahook.ListWebhookEndpointAttemptsResult) *ListWebhookEndpointAttemptsResponseBody {
+	body := &ListWebhookEndpointAttemptsResponseBody{
+		HasMore: res.HasMore,
+	}
+	if res.Result != nil {
+		body.Result = make([]*EventDeliveryAttemptResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookEventDeliveryAttemptToEventDeliveryAttemptResponseBody(val)
+		}
+	}
+	return body
 }
 
-// NewListWebhookEndpointPayload builds a Zebrahook service listWebhookEndpoint
-// endpoint payload.
-func NewListWebhookEndpointPayload(limit int32, offset uint32, createdAtGte *uint64, updatedAtLt *uint64, metadata map[string]string, token string) *zebrahook.ListWebhookEndpointPayload {
-	v := &zebrahook.ListWebhookEndpointPayload{}
-	v.Limit = limit
-	v.Offset = offset
-	v.CreatedAtGte = createdAtGte
-	v.UpdatedAtLt = updatedAtLt
-	v.Metadata = metadata
-	v.Token = token
+// NewRetryEventDeliveryResponseBody builds the HTTP response body from the
+// result of the "retryEventDelivery" endpoint of the "Zebrahook" service.
+func NewRetryEventDeliveryResponseBody(res *zebrahook.EventDelivery) *RetryEventDeliveryResponseBody {
+	body := &RetryEventDeliveryResponseBody{
+		ID:                     res.ID,
+		EndpointID:             res.EndpointID,
+		EndpointURL:            res.EndpointURL,
+		Status:                 res.Status,
+		AttemptsCounter:        res.AttemptsCounter,
+		AttemptsRemaining:      res.AttemptsRemaining,
+		NextAttemptScheduledAt: res.NextAttemptScheduledAt,
+		CreatedAt:              res.CreatedAt,
+		UpdatedAt:              res.UpdatedAt,
+	}
+	return body
+}
 
-	return v
+// NewRecoverWebhookEndpointResponseBody builds the HTTP response body from the
+// result of the "recoverWebhookEndpoint" endpoint of the "Zebrahook" service.
+func NewRecoverWebhookEndpointResponseBody(res *zebrahook.EndpointRecovery) *RecoverWebhookEndpointResponseBody {
+	body := &RecoverWebhookEndpointResponseBody{
+		ID:                 res.ID,
+		EndpointID:         res.EndpointID,
+		Since:              res.Since,
+		Status:             res.Status,
+		DeliveriesFound:    res.DeliveriesFound,
+		DeliveriesEnqueued: res.DeliveriesEnqueued,
+		CreatedAt:          res.CreatedAt,
+		UpdatedAt:          res.UpdatedAt,
+	}
+	return body
 }
 
-// NewGetWebhookEndpointByIDPayload builds a Zebrahook service
-// getWebhookEndpointById endpoint payload.
-func NewGetWebhookEndpointByIDPayload(id string, token string) *zebrahook.GetWebhookEndpointByIDPayload {
-	v := &zebrahook.GetWebhookEndpointByIDPayload{}
-	v.ID = id
-	v.Token = token
+// NewGetWebhookEndpointRecoveryResponseBody builds the HTTP response body from
+// the result of the "getWebhookEndpointRecovery" endpoint of the "Zebrahook"
+// service.
+func NewGetWebhookEndpointRecoveryResponseBody(res *zebrahook.EndpointRecovery) *GetWebhookEndpointRecoveryResponseBody {
+	body := &GetWebhookEndpointRecoveryResponseBody{
+		ID:                 res.ID,
+		EndpointID:         res.EndpointID,
+		Since:              res.Since,
+		Status:             res.Status,
+		DeliveriesFound:    res.DeliveriesFound,
+		DeliveriesEnqueued: res.DeliveriesEnqueued,
+		CreatedAt:          res.CreatedAt,
+		UpdatedAt:          res.UpdatedAt,
+	}
+	return body
+}
 
-	return v
+// NewRotateWebhookEndpointSecretResponseBody builds the HTTP response body
+// from the result of the "rotateWebhookEndpointSecret" endpoint of the
+// "Zebrahook" service.
+func NewRotateWebhookEndpointSecretResponseBody(res *zebrahook.RotateWebhookEndpointSecretResult) *RotateWebhookEndpointSecretResponseBody {
+	body := &RotateWebhookEndpointSecretResponseBody{
+		PreviousSecretExpiresAt: res.PreviousSecretExpiresAt,
+		ID:                      res.ID,
+		Secret:                  res.Secret,
+	}
+	return body
 }
 
-// ValidateSubmitNewEventsRequestBody runs the validations defined on
-// SubmitNewEventsRequestBody
+// NewTestWebhookEndpointResponseBody builds the HTTP response body from the
+// result of the "testWebhookEndpoint" endpoint of the "Zebrahook" service.
+func NewTestWebhookEndpointResponseBody(res *zebrahook.TestWebhookEndpointResult) *TestWebhookEndpointResponseBody {
+	body := &TestWebhookEndpointResponseBody{
+		Status:               res.Status,
+		HTTPStatusCode:       res.HTTPStatusCode,
+		HTTPBodyResponse:     res.HTTPBodyResponse,
+		HTTPResponseTimeSecs: res.HTTPResponseTimeSecs,
+	}
+	return body
+}
+
+// NewListAPIKeysResponseBody builds the HTTP response body from the result of
+// the "listApiKeys" endpoint of the "Zebrahook" service.
+func NewListAPIKeysResponseBody(res *zebrahook.ListAPIKeysResult) *ListAPIKeysResponseBody {
+	body := &ListAPIKeysResponseBody{}
+	if res.Result != nil {
+		body.Result = make([]*APIKeyResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookAPIKeyToAPIKeyResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetAPIKeyResponseBody builds the HTTP response body from the result of
+// the "getApiKey" endpoint of the "Zebrahook" service.
+func NewGetAPIKeyResponseBody(res *zebrahook.APIKey) *GetAPIKeyResponseBody {
+	body := &GetAPIKeyResponseBody{
+		ID:          res.ID,
+		Description: res.Description,
+		Status:      res.Status,
+		Tenant:      res.Tenant,
+		ExpiresAt:   res.ExpiresAt,
+		LastUsedAt:  res.LastUsedAt,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateAPIKeyResponseBody builds the HTTP response body from the result of
+// the "updateApiKey" endpoint of the "Zebrahook" service.
+func NewUpdateAPIKeyResponseBody(res *zebrahook.APIKey) *UpdateAPIKeyResponseBody {
+	body := &UpdateAPIKeyResponseBody{
+		ID:          res.ID,
+		Description: res.Description,
+		Status:      res.Status,
+		Tenant:      res.Tenant,
+		ExpiresAt:   res.ExpiresAt,
+		LastUsedAt:  res.LastUsedAt,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.Scopes != nil {
+		body.Scopes = make([]string, len(res.Scopes))
+		for i, val := range res.Scopes {
+			body.Scopes[i] = val
+		}
+	}
+	return body
+}
+
+// NewRevokeAPIKeyResponseBody builds the HTTP response body from the result of
+// the "revokeApiKey" endpoint of the "Zebrahook" service.
+func NewRevokeAPIKeyResponseBody(res *zebrahook.RevokeAPIKeyResult) *RevokeAPIKeyResponseBody {
+	body := &RevokeAPIKeyResponseBody{
+		Success: res.Success,
+	}
+	return body
+}
+
+// NewRegisterEventTypeResponseBody builds the HTTP response body from the
+// result of the "registerEventType" endpoint of the "Zebrahook" service.
+func NewRegisterEventTypeResponseBody(res *zebrahook.EventType) *RegisterEventTypeResponseBody {
+	body := &RegisterEventTypeResponseBody{
+		Name:        res.Name,
+		Description: res.Description,
+		Deprecated:  res.Deprecated,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.Schema != nil {
+		body.Schema = make(map[string]interface{}, len(res.Schema))
+		for key, val := range res.Schema {
+			tk := key
+			tv := val
+			body.Schema[tk] = tv
+		}
+	}
+	if res.Example != nil {
+		body.Example = make(map[string]interface{}, len(res.Example))
+		for key, val := range res.Example {
+			tk := key
+			tv := val
+			body.Example[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewListEventTypesResponseBody builds the HTTP response body from the result
+// of the "listEventTypes" endpoint of the "Zebrahook" service.
+func NewListEventTypesResponseBody(res *zebrahook.ListEventTypesResult) *ListEventTypesResponseBody {
+	body := &ListEventTypesResponseBody{}
+	if res.Result != nil {
+		body.Result = make([]*EventTypeResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookEventTypeToEventTypeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetEventTypeResponseBody builds the HTTP response body from the result of
+// the "getEventType" endpoint of the "Zebrahook" service.
+func NewGetEventTypeResponseBody(res *zebrahook.EventType) *GetEventTypeResponseBody {
+	body := &GetEventTypeResponseBody{
+		Name:        res.Name,
+		Description: res.Description,
+		Deprecated:  res.Deprecated,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.Schema != nil {
+		body.Schema = make(map[string]interface{}, len(res.Schema))
+		for key, val := range res.Schema {
+			tk := key
+			tv := val
+			body.Schema[tk] = tv
+		}
+	}
+	if res.Example != nil {
+		body.Example = make(map[string]interface{}, len(res.Example))
+		for key, val := range res.Example {
+			tk := key
+			tv := val
+			body.Example[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewUpdateEventTypeResponseBody builds the HTTP response body from the result
+// of the "updateEventType" endpoint of the "Zebrahook" service.
+func NewUpdateEventTypeResponseBody(res *zebrahook.EventType) *UpdateEventTypeResponseBody {
+	body := &UpdateEventTypeResponseBody{
+		Name:        res.Name,
+		Description: res.Description,
+		Deprecated:  res.Deprecated,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.Schema != nil {
+		body.Schema = make(map[string]interface{}, len(res.Schema))
+		for key, val := range res.Schema {
+			tk := key
+			tv := val
+			body.Schema[tk] = tv
+		}
+	}
+	if res.Example != nil {
+		body.Example = make(map[string]interface{}, len(res.Example))
+		for key, val := range res.Example {
+			tk := key
+			tv := val
+			body.Example[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewGetWebhookEndpointStatusHistoryResponseBody builds the HTTP response body
+// from the result of the "getWebhookEndpointStatusHistory" endpoint of the
+// "Zebrahook" service.
+func NewGetWebhookEndpointStatusHistoryResponseBody(res *zebrahook.GetWebhookEndpointStatusHistoryResult) *GetWebhookEndpointStatusHistoryResponseBody {
+	body := &GetWebhookEndpointStatusHistoryResponseBody{
+		HasMore: res.HasMore,
+	}
+	if res.Result != nil {
+		body.Result = make([]*EndpointStatusEventResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookEndpointStatusEventToEndpointStatusEventResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetWebhookEndpointStatsResponseBody builds the HTTP response body from
+// the result of the "getWebhookEndpointStats" endpoint of the "Zebrahook"
+// service.
+func NewGetWebhookEndpointStatsResponseBody(res *zebrahook.EndpointStats) *GetWebhookEndpointStatsResponseBody {
+	body := &GetWebhookEndpointStatsResponseBody{
+		EndpointID:  res.EndpointID,
+		Window:      res.Window,
+		From:        res.From,
+		To:          res.To,
+		Attempts:    res.Attempts,
+		SuccessRate: res.SuccessRate,
+	}
+	if res.StatusCounts != nil {
+		body.StatusCounts = make(map[string]uint, len(res.StatusCounts))
+		for key, val := range res.StatusCounts {
+			tk := key
+			tv := val
+			body.StatusCounts[tk] = tv
+		}
+	}
+	if res.HTTPStatusCodes != nil {
+		body.HTTPStatusCodes = make(map[string]uint, len(res.HTTPStatusCodes))
+		for key, val := range res.HTTPStatusCodes {
+			tk := key
+			tv := val
+			body.HTTPStatusCodes[tk] = tv
+		}
+	}
+	if res.ResponseTimeSecs != nil {
+		body.ResponseTimeSecs = marshalZebrahookResponseTimePercentilesToResponseTimePercentilesResponseBody(res.ResponseTimeSecs)
+	}
+	return body
+}
+
+// NewListDeadLettersResponseBody builds the HTTP response body from the result
+// of the "listDeadLetters" endpoint of the "Zebrahook" service.
+func NewListDeadLettersResponseBody(res *zebrahook.ListDeadLettersResult) *ListDeadLettersResponseBody {
+	body := &ListDeadLettersResponseBody{
+		HasMore: res.HasMore,
+	}
+	if res.Result != nil {
+		body.Result = make([]*DeadLetterResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookDeadLetterToDeadLetterResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetDeadLetterResponseBody builds the HTTP response body from the result
+// of the "getDeadLetter" endpoint of the "Zebrahook" service.
+func NewGetDeadLetterResponseBody(res *zebrahook.DeadLetterDetails) *GetDeadLetterResponseBody {
+	body := &GetDeadLetterResponseBody{
+		LastHTTPBodyResponse:  res.LastHTTPBodyResponse,
+		ID:                    res.ID,
+		EventDeliveryID:       res.EventDeliveryID,
+		EventID:               res.EventID,
+		EventType:             res.EventType,
+		EndpointID:            res.EndpointID,
+		Reason:                res.Reason,
+		AttemptsCounter:       res.AttemptsCounter,
+		LastAttemptStatus:     res.LastAttemptStatus,
+		LastHTTPStatusCode:    res.LastHTTPStatusCode,
+		LastAttemptMadeAt:     res.LastAttemptMadeAt,
+		Status:                res.Status,
+		ReplayEventDeliveryID: res.ReplayEventDeliveryID,
+		ReplayedAt:            res.ReplayedAt,
+		CreatedAt:             res.CreatedAt,
+	}
+	if res.Event != nil {
+		body.Event = marshalZebrahookEventToEventResponseBody(res.Event)
+	}
+	return body
+}
+
+// NewReplayDeadLetterResponseBody builds the HTTP response body from the
+// result of the "replayDeadLetter" endpoint of the "Zebrahook" service.
+func NewReplayDeadLetterResponseBody(res *zebrahook.DeadLetter) *ReplayDeadLetterResponseBody {
+	body := &ReplayDeadLetterResponseBody{
+		ID:                    res.ID,
+		EventDeliveryID:       res.EventDeliveryID,
+		EventID:               res.EventID,
+		EventType:             res.EventType,
+		EndpointID:            res.EndpointID,
+		Reason:                res.Reason,
+		AttemptsCounter:       res.AttemptsCounter,
+		LastAttemptStatus:     res.LastAttemptStatus,
+		LastHTTPStatusCode:    res.LastHTTPStatusCode,
+		LastAttemptMadeAt:     res.LastAttemptMadeAt,
+		Status:                res.Status,
+		ReplayEventDeliveryID: res.ReplayEventDeliveryID,
+		ReplayedAt:            res.ReplayedAt,
+		CreatedAt:             res.CreatedAt,
+	}
+	return body
+}
+
+// NewReplayDeadLettersResponseBody builds the HTTP response body from the
+// result of the "replayDeadLetters" endpoint of the "Zebrahook" service.
+func NewReplayDeadLettersResponseBody(res *zebrahook.ReplayDeadLettersResult) *ReplayDeadLettersResponseBody {
+	body := &ReplayDeadLettersResponseBody{
+		HasMore: res.HasMore,
+	}
+	if res.Result != nil {
+		body.Result = make([]*DeadLetterResponseBody, len(res.Result))
+		for i, val := range res.Result {
+			body.Result[i] = marshalZebrahookDeadLetterToDeadLetterResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteDeadLetterResponseBody builds the HTTP response body from the
+// result of the "deleteDeadLetter" endpoint of the "Zebrahook" service.
+func NewDeleteDeadLetterResponseBody(res *zebrahook.DeleteDeadLetterResult) *DeleteDeadLetterResponseBody {
+	body := &DeleteDeadLetterResponseBody{
+		Success: res.Success,
+	}
+	return body
+}
+
+// NewPurgeDeadLettersResponseBody builds the HTTP response body from the
+// result of the "purgeDeadLetters" endpoint of the "Zebrahook" service.
+func NewPurgeDeadLettersResponseBody(res *zebrahook.PurgeDeadLettersResult) *PurgeDeadLettersResponseBody {
+	body := &PurgeDeadLettersResponseBody{
+		Purged: res.Purged,
+	}
+	return body
+}
+
+// NewSubmitNewEventsPayload builds a Zebrahook service submitNewEvents
+// endpoint payload.
+func NewSubmitNewEventsPayload(body *SubmitNewEventsRequestBody, idempotencyKey *string, token string) *zebrahook.SubmitNewEventsPayload {
+	v := &zebrahook.SubmitNewEventsPayload{}
+	v.Events = make([]*zebrahook.EventRequest, len(body.Events))
+	for i, val := range body.Events {
+		v.Events[i] = unmarshalEventRequestRequestBodyToZebrahookEventRequest(val)
+	}
+	v.IdempotencyKey = idempotencyKey
+	v.Token = token
+
+	return v
+}
+
+// NewRegisterPayload builds a Zebrahook service register endpoint payload.
+func NewRegisterPayload(body *RegisterRequestBody, token string) *zebrahook.RegisterPayload {
+	v := &zebrahook.RegisterPayload{
+		URL: *body.URL,
+	}
+	v.EnabledEvents = make([]string, len(body.EnabledEvents))
+	for i, val := range body.EnabledEvents {
+		v.EnabledEvents[i] = val
+	}
+	if body.Metadata != nil {
+		v.Metadata = make(map[string]string, len(body.Metadata))
+		for key, val := range body.Metadata {
+			tk := key
+			tv := val
+			v.Metadata[tk] = tv
+		}
+	}
+	if body.Headers != nil {
+		v.Headers = make(map[string]string, len(body.Headers))
+		for key, val := range body.Headers {
+			tk := key
+			tv := val
+			v.Headers[tk] = tv
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		v.DeliveryPolicy = unmarshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy(body.DeliveryPolicy)
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewUpdatePayload builds a Zebrahook service update endpoint payload.
+func NewUpdatePayload(body *UpdateRequestBody, id string, token string) *zebrahook.UpdatePayload {
+	v := &zebrahook.UpdatePayload{
+		URL:      body.URL,
+		Disabled: body.Disabled,
+	}
+	if body.EnabledEvents != nil {
+		v.EnabledEvents = make([]string, len(body.EnabledEvents))
+		for i, val := range body.EnabledEvents {
+			v.EnabledEvents[i] = val
+		}
+	}
+	if body.Metadata != nil {
+		v.Metadata = make(map[string]string, len(body.Metadata))
+		for key, val := range body.Metadata {
+			tk := key
+			tv := val
+			v.Metadata[tk] = tv
+		}
+	}
+	if body.Headers != nil {
+		v.Headers = make(map[string]string, len(body.Headers))
+		for key, val := range body.Headers {
+			tk := key
+			tv := val
+			v.Headers[tk] = tv
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		v.DeliveryPolicy = unmarshalDeliveryPolicyRequestBodyToZebrahookDeliveryPolicy(body.DeliveryPolicy)
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListWebhookEndpointPayload builds a Zebrahook service listWebhookEndpoint
+// endpoint payload.
+func NewListWebhookEndpointPayload(limit int32, offset uint32, createdAtGte *uint64, updatedAtLt *uint64, metadata map[string]string, token string) *zebrahook.ListWebhookEndpointPayload {
+	v := &zebrahook.ListWebhookEndpointPayload{}
+	v.Limit = limit
+	v.Offset = offset
+	v.CreatedAtGte = createdAtGte
+	v.UpdatedAtLt = updatedAtLt
+	v.Metadata = metadata
+	v.Token = token
+
+	return v
+}
+
+// NewGetWebhookEndpointByIDPayload builds a Zebrahook service
+// getWebhookEndpointById endpoint payload.
+func NewGetWebhookEndpointByIDPayload(id string, token string) *zebrahook.GetWebhookEndpointByIDPayload {
+	v := &zebrahook.GetWebhookEndpointByIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteWebhookEndpointPayload builds a Zebrahook service
+// deleteWebhookEndpoint endpoint payload.
+func NewDeleteWebhookEndpointPayload(id string, token string) *zebrahook.DeleteWebhookEndpointPayload {
+	v := &zebrahook.DeleteWebhookEndpointPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListEventsPayload builds a Zebrahook service listEvents endpoint payload.
+func NewListEventsPayload(limit int32, startingAfter *uint, eventType *string, priority *int, createdAtGte *uint64, createdAtLt *uint64, token string) *zebrahook.ListEventsPayload {
+	v := &zebrahook.ListEventsPayload{}
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.EventType = eventType
+	v.Priority = priority
+	v.CreatedAtGte = createdAtGte
+	v.CreatedAtLt = createdAtLt
+	v.Token = token
+
+	return v
+}
+
+// NewGetEventByIDPayload builds a Zebrahook service getEventById endpoint
+// payload.
+func NewGetEventByIDPayload(id uint, token string) *zebrahook.GetEventByIDPayload {
+	v := &zebrahook.GetEventByIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListWebhookEndpointAttemptsPayload builds a Zebrahook service
+// listWebhookEndpointAttempts endpoint payload.
+func NewListWebhookEndpointAttemptsPayload(id string, limit int32, startingAfter *uint, status *string, eventType *string, createdAtGte *uint64, createdAtLt *uint64, token string) *zebrahook.ListWebhookEndpointAttemptsPayload {
+	v := &zebrahook.ListWebhookEndpointAttemptsPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.Status = status
+	v.EventType = eventType
+	v.CreatedAtGte = createdAtGte
+	v.CreatedAtLt = createdAtLt
+	v.Token = token
+
+	return v
+}
+
+// NewRetryEventDeliveryPayload builds a Zebrahook service retryEventDelivery
+// endpoint payload.
+func NewRetryEventDeliveryPayload(id uint, endpointID string, token string) *zebrahook.RetryEventDeliveryPayload {
+	v := &zebrahook.RetryEventDeliveryPayload{}
+	v.ID = id
+	v.EndpointID = endpointID
+	v.Token = token
+
+	return v
+}
+
+// NewRecoverWebhookEndpointPayload builds a Zebrahook service
+// recoverWebhookEndpoint endpoint payload.
+func NewRecoverWebhookEndpointPayload(body *RecoverWebhookEndpointRequestBody, id string, token string) *zebrahook.RecoverWebhookEndpointPayload {
+	v := &zebrahook.RecoverWebhookEndpointPayload{
+		Since: *body.Since,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewGetWebhookEndpointRecoveryPayload builds a Zebrahook service
+// getWebhookEndpointRecovery endpoint payload.
+func NewGetWebhookEndpointRecoveryPayload(id string, recoveryID string, token string) *zebrahook.GetWebhookEndpointRecoveryPayload {
+	v := &zebrahook.GetWebhookEndpointRecoveryPayload{}
+	v.ID = id
+	v.RecoveryID = recoveryID
+	v.Token = token
+
+	return v
+}
+
+// NewRotateWebhookEndpointSecretPayload builds a Zebrahook service
+// rotateWebhookEndpointSecret endpoint payload.
+func NewRotateWebhookEndpointSecretPayload(body *RotateWebhookEndpointSecretRequestBody, id string, token string) *zebrahook.RotateWebhookEndpointSecretPayload {
+	v := &zebrahook.RotateWebhookEndpointSecretPayload{
+		GracePeriodSecs: body.GracePeriodSecs,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewTestWebhookEndpointPayload builds a Zebrahook service testWebhookEndpoint
+// endpoint payload.
+func NewTestWebhookEndpointPayload(body *TestWebhookEndpointRequestBody, id string, token string) *zebrahook.TestWebhookEndpointPayload {
+	v := &zebrahook.TestWebhookEndpointPayload{
+		EventType: body.EventType,
+	}
+	if body.EventContent != nil {
+		v.EventContent = make(map[string]interface{}, len(body.EventContent))
+		for key, val := range body.EventContent {
+			tk := key
+			tv := val
+			v.EventContent[tk] = tv
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListAPIKeysPayload builds a Zebrahook service listApiKeys endpoint
+// payload.
+func NewListAPIKeysPayload(status *string, token string) *zebrahook.ListAPIKeysPayload {
+	v := &zebrahook.ListAPIKeysPayload{}
+	v.Status = status
+	v.Token = token
+
+	return v
+}
+
+// NewGetAPIKeyPayload builds a Zebrahook service getApiKey endpoint payload.
+func NewGetAPIKeyPayload(id uint, token string) *zebrahook.GetAPIKeyPayload {
+	v := &zebrahook.GetAPIKeyPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateAPIKeyPayload builds a Zebrahook service updateApiKey endpoint
+// payload.
+func NewUpdateAPIKeyPayload(body *UpdateAPIKeyRequestBody, id uint, token string) *zebrahook.UpdateAPIKeyPayload {
+	v := &zebrahook.UpdateAPIKeyPayload{
+		Description: body.Description,
+		ExpiresAt:   body.ExpiresAt,
+	}
+	if body.Scopes != nil {
+		v.Scopes = make([]string, len(body.Scopes))
+		for i, val := range body.Scopes {
+			v.Scopes[i] = val
+		}
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeAPIKeyPayload builds a Zebrahook service revokeApiKey endpoint
+// payload.
+func NewRevokeAPIKeyPayload(id uint, token string) *zebrahook.RevokeAPIKeyPayload {
+	v := &zebrahook.RevokeAPIKeyPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewRegisterEventTypePayload builds a Zebrahook service registerEventType
+// endpoint payload.
+func NewRegisterEventTypePayload(body *RegisterEventTypeRequestBody, token string) *zebrahook.RegisterEventTypePayload {
+	v := &zebrahook.RegisterEventTypePayload{
+		Name:        *body.Name,
+		Description: body.Description,
+	}
+	if body.Deprecated != nil {
+		v.Deprecated = *body.Deprecated
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+	if body.Deprecated == nil {
+		v.Deprecated = false
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListEventTypesPayload builds a Zebrahook service listEventTypes endpoint
+// payload.
+func NewListEventTypesPayload(deprecated *bool, token string) *zebrahook.ListEventTypesPayload {
+	v := &zebrahook.ListEventTypesPayload{}
+	v.Deprecated = deprecated
+	v.Token = token
+
+	return v
+}
+
+// NewGetEventTypePayload builds a Zebrahook service getEventType endpoint
+// payload.
+func NewGetEventTypePayload(name string, token string) *zebrahook.GetEventTypePayload {
+	v := &zebrahook.GetEventTypePayload{}
+	v.Name = name
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateEventTypePayload builds a Zebrahook service updateEventType
+// endpoint payload.
+func NewUpdateEventTypePayload(body *UpdateEventTypeRequestBody, name string, token string) *zebrahook.UpdateEventTypePayload {
+	v := &zebrahook.UpdateEventTypePayload{
+		Description: body.Description,
+		Deprecated:  body.Deprecated,
+	}
+	if body.Schema != nil {
+		v.Schema = make(map[string]interface{}, len(body.Schema))
+		for key, val := range body.Schema {
+			tk := key
+			tv := val
+			v.Schema[tk] = tv
+		}
+	}
+	if body.Example != nil {
+		v.Example = make(map[string]interface{}, len(body.Example))
+		for key, val := range body.Example {
+			tk := key
+			tv := val
+			v.Example[tk] = tv
+		}
+	}
+	v.Name = name
+	v.Token = token
+
+	return v
+}
+
+// NewGetEventTypesCatalogPayload builds a Zebrahook service
+// getEventTypesCatalog endpoint payload.
+func NewGetEventTypesCatalogPayload(format string, token string) *zebrahook.GetEventTypesCatalogPayload {
+	v := &zebrahook.GetEventTypesCatalogPayload{}
+	v.Format = format
+	v.Token = token
+
+	return v
+}
+
+// NewGetWebhookEndpointStatusHistoryPayload builds a Zebrahook service
+// getWebhookEndpointStatusHistory endpoint payload.
+func NewGetWebhookEndpointStatusHistoryPayload(id string, limit int32, startingAfter *uint, token string) *zebrahook.GetWebhookEndpointStatusHistoryPayload {
+	v := &zebrahook.GetWebhookEndpointStatusHistoryPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.Token = token
+
+	return v
+}
+
+// NewGetWebhookEndpointStatsPayload builds a Zebrahook service
+// getWebhookEndpointStats endpoint payload.
+func NewGetWebhookEndpointStatsPayload(id string, window string, token string) *zebrahook.GetWebhookEndpointStatsPayload {
+	v := &zebrahook.GetWebhookEndpointStatsPayload{}
+	v.ID = id
+	v.Window = window
+	v.Token = token
+
+	return v
+}
+
+// NewListDeadLettersPayload builds a Zebrahook service listDeadLetters
+// endpoint payload.
+func NewListDeadLettersPayload(limit int32, startingAfter *uint, status string, endpointID *string, eventType *string, createdAtGte *uint64, createdAtLt *uint64, token string) *zebrahook.ListDeadLettersPayload {
+	v := &zebrahook.ListDeadLettersPayload{}
+	v.Limit = limit
+	v.StartingAfter = startingAfter
+	v.Status = status
+	v.EndpointID = endpointID
+	v.EventType = eventType
+	v.CreatedAtGte = createdAtGte
+	v.CreatedAtLt = createdAtLt
+	v.Token = token
+
+	return v
+}
+
+// NewGetDeadLetterPayload builds a Zebrahook service getDeadLetter endpoint
+// payload.
+func NewGetDeadLetterPayload(id uint, token string) *zebrahook.GetDeadLetterPayload {
+	v := &zebrahook.GetDeadLetterPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewReplayDeadLetterPayload builds a Zebrahook service replayDeadLetter
+// endpoint payload.
+func NewReplayDeadLetterPayload(id uint, token string) *zebrahook.ReplayDeadLetterPayload {
+	v := &zebrahook.ReplayDeadLetterPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewReplayDeadLettersPayload builds a Zebrahook service replayDeadLetters
+// endpoint payload.
+func NewReplayDeadLettersPayload(body *ReplayDeadLettersRequestBody, token string) *zebrahook.ReplayDeadLettersPayload {
+	v := &zebrahook.ReplayDeadLettersPayload{
+		EndpointID:   body.EndpointID,
+		EventType:    body.EventType,
+		CreatedAtGte: body.CreatedAtGte,
+		CreatedAtLt:  body.CreatedAtLt,
+	}
+	if body.Limit != nil {
+		v.Limit = *body.Limit
+	}
+	if body.Ids != nil {
+		v.Ids = make([]uint, len(body.Ids))
+		for i, val := range body.Ids {
+			v.Ids[i] = val
+		}
+	}
+	if body.Limit == nil {
+		v.Limit = 100
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteDeadLetterPayload builds a Zebrahook service deleteDeadLetter
+// endpoint payload.
+func NewDeleteDeadLetterPayload(id uint, token string) *zebrahook.DeleteDeadLetterPayload {
+	v := &zebrahook.DeleteDeadLetterPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewPurgeDeadLettersPayload builds a Zebrahook service purgeDeadLetters
+// endpoint payload.
+func NewPurgeDeadLettersPayload(body *PurgeDeadLettersRequestBody, token string) *zebrahook.PurgeDeadLettersPayload {
+	v := &zebrahook.PurgeDeadLettersPayload{
+		Status:      body.Status,
+		EndpointID:  body.EndpointID,
+		EventType:   body.EventType,
+		CreatedAtLt: body.CreatedAtLt,
+	}
+	v.Token = token
+
+	return v
+}
+
+// ValidateSubmitNewEventsRequestBody runs the validations defined on
+// SubmitNewEventsRequestBody
 func ValidateSubmitNewEventsRequestBody(body *SubmitNewEventsRequestBody) (err error) {
 	if body.Events == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
@@ -322,6 +1948,19 @@ func ValidateRegisterRequestBody(body *RegisterRequestBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata[key]", v, utf8.RuneCountInString(v), 1, true))
 		}
 	}
+	for k, v := range body.Headers {
+		if utf8.RuneCountInString(k) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers.key", k, utf8.RuneCountInString(k), 1, true))
+		}
+		if utf8.RuneCountInString(v) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers[key]", v, utf8.RuneCountInString(v), 1, true))
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		if err2 := ValidateDeliveryPolicyRequestBody(body.DeliveryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -338,6 +1977,116 @@ func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.metadata[key]", v, utf8.RuneCountInString(v), 1, true))
 		}
 	}
+	for k, v := range body.Headers {
+		if utf8.RuneCountInString(k) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers.key", k, utf8.RuneCountInString(k), 1, true))
+		}
+		if utf8.RuneCountInString(v) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.headers[key]", v, utf8.RuneCountInString(v), 1, true))
+		}
+	}
+	if body.DeliveryPolicy != nil {
+		if err2 := ValidateDeliveryPolicyRequestBody(body.DeliveryPolicy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateRecoverWebhookEndpointRequestBody runs the validations defined on
+// RecoverWebhookEndpointRequestBody
+func ValidateRecoverWebhookEndpointRequestBody(body *RecoverWebhookEndpointRequestBody) (err error) {
+	if body.Since == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("since", "body"))
+	}
+	if body.Since != nil {
+		if *body.Since < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.since", *body.Since, 0, true))
+		}
+	}
+	return
+}
+
+// ValidateUpdateAPIKeyRequestBody runs the validations defined on
+// UpdateApiKeyRequestBody
+func ValidateUpdateAPIKeyRequestBody(body *UpdateAPIKeyRequestBody) (err error) {
+	if body.Description != nil {
+		if utf8.RuneCountInString(*body.Description) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 1, true))
+		}
+	}
+	if body.ExpiresAt != nil {
+		if *body.ExpiresAt < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiresAt", *body.ExpiresAt, 0, true))
+		}
+	}
+	if len(body.Scopes) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scopes", body.Scopes, len(body.Scopes), 1, true))
+	}
+	for _, e := range body.Scopes {
+		if !(e == "*" || e == "events:read" || e == "events:write" || e == "endpoints:read" || e == "endpoints:write" || e == "secrets:read" || e == "admin") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scopes[*]", e, []interface{}{"*", "events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"}))
+		}
+	}
+	return
+}
+
+// ValidateRegisterEventTypeRequestBody runs the validations defined on
+// RegisterEventTypeRequestBody
+func ValidateRegisterEventTypeRequestBody(body *RegisterEventTypeRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateReplayDeadLettersRequestBody runs the validations defined on
+// ReplayDeadLettersRequestBody
+func ValidateReplayDeadLettersRequestBody(body *ReplayDeadLettersRequestBody) (err error) {
+	if len(body.Ids) > 500 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.ids", body.Ids, len(body.Ids), 500, false))
+	}
+	if body.CreatedAtGte != nil {
+		if *body.CreatedAtGte < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.createdAt.gte", *body.CreatedAtGte, 0, true))
+		}
+	}
+	if body.CreatedAtLt != nil {
+		if *body.CreatedAtLt < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.createdAt.lt", *body.CreatedAtLt, 0, true))
+		}
+	}
+	if body.Limit != nil {
+		if *body.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", *body.Limit, 1, true))
+		}
+	}
+	if body.Limit != nil {
+		if *body.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit", *body.Limit, 500, false))
+		}
+	}
+	return
+}
+
+// ValidatePurgeDeadLettersRequestBody runs the validations defined on
+// PurgeDeadLettersRequestBody
+func ValidatePurgeDeadLettersRequestBody(body *PurgeDeadLettersRequestBody) (err error) {
+	if body.Status != nil {
+		if !(*body.Status == "dead" || *body.Status == "replayed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []interface{}{"dead", "replayed"}))
+		}
+	}
+	if body.CreatedAtLt != nil {
+		if *body.CreatedAtLt < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.createdAt.lt", *body.CreatedAtLt, 0, true))
+		}
+	}
 	return
 }
 
@@ -350,5 +2099,67 @@ func ValidateEventRequestRequestBody(body *EventRequestRequestBody) (err error)
 	if body.EventContent == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("event_content", "body"))
 	}
+	if body.IdempotencyKey != nil {
+		if utf8.RuneCountInString(*body.IdempotencyKey) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.idempotency_key", *body.IdempotencyKey, utf8.RuneCountInString(*body.IdempotencyKey), 1, true))
+		}
+	}
+	if body.IdempotencyKey != nil {
+		if utf8.RuneCountInString(*body.IdempotencyKey) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.idempotency_key", *body.IdempotencyKey, utf8.RuneCountInString(*body.IdempotencyKey), 255, false))
+		}
+	}
+	return
+}
+
+// ValidateDeliveryPolicyRequestBody runs the validations defined on
+// DeliveryPolicyRequestBody
+func ValidateDeliveryPolicyRequestBody(body *DeliveryPolicyRequestBody) (err error) {
+	if body.TimeoutSecs != nil {
+		if *body.TimeoutSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_secs", *body.TimeoutSecs, 1, true))
+		}
+	}
+	if body.TimeoutSecs != nil {
+		if *body.TimeoutSecs > 300 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_secs", *body.TimeoutSecs, 300, false))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 100, false))
+		}
+	}
+	if body.BackoffType != nil {
+		if !(*body.BackoffType == "exponential" || *body.BackoffType == "linear" || *body.BackoffType == "fixed" || *body.BackoffType == "schedule") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.backoff_type", *body.BackoffType, []interface{}{"exponential", "linear", "fixed", "schedule"}))
+		}
+	}
+	if body.BackoffBaseSecs != nil {
+		if *body.BackoffBaseSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.backoff_base_secs", *body.BackoffBaseSecs, 1, true))
+		}
+	}
+	if body.MaxBackoffSecs != nil {
+		if *body.MaxBackoffSecs < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_backoff_secs", *body.MaxBackoffSecs, 1, true))
+		}
+	}
+	if len(body.BackoffScheduleSecs) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.backoff_schedule_secs", body.BackoffScheduleSecs, len(body.BackoffScheduleSecs), 1, true))
+	}
+	if len(body.BackoffScheduleSecs) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.backoff_schedule_secs", body.BackoffScheduleSecs, len(body.BackoffScheduleSecs), 100, false))
+	}
+	for _, e := range body.BackoffScheduleSecs {
+		if e < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.backoff_schedule_secs[*]", e, 1, true))
+		}
+	}
 	return
 }
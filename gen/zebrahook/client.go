@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook client
 //
@@ -9,29 +9,82 @@ package zebrahook
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "Zebrahook" service client.
 type Client struct {
-	CreateAPIKeyEndpoint           goa.Endpoint
-	SubmitNewEventsEndpoint        goa.Endpoint
-	RegisterEndpoint               goa.Endpoint
-	UpdateEndpoint                 goa.Endpoint
-	ListWebhookEndpointEndpoint    goa.Endpoint
-	GetWebhookEndpointByIDEndpoint goa.Endpoint
+	CreateAPIKeyEndpoint                    goa.Endpoint
+	SubmitNewEventsEndpoint                 goa.Endpoint
+	RegisterEndpoint                        goa.Endpoint
+	UpdateEndpoint                          goa.Endpoint
+	ListWebhookEndpointEndpoint             goa.Endpoint
+	GetWebhookEndpointByIDEndpoint          goa.Endpoint
+	DeleteWebhookEndpointEndpoint           goa.Endpoint
+	ListEventsEndpoint                      goa.Endpoint
+	GetEventByIDEndpoint                    goa.Endpoint
+	ListWebhookEndpointAttemptsEndpoint     goa.Endpoint
+	RetryEventDeliveryEndpoint              goa.Endpoint
+	RecoverWebhookEndpointEndpoint          goa.Endpoint
+	GetWebhookEndpointRecoveryEndpoint      goa.Endpoint
+	RotateWebhookEndpointSecretEndpoint     goa.Endpoint
+	TestWebhookEndpointEndpoint             goa.Endpoint
+	ListAPIKeysEndpoint                     goa.Endpoint
+	GetAPIKeyEndpoint                       goa.Endpoint
+	UpdateAPIKeyEndpoint                    goa.Endpoint
+	RevokeAPIKeyEndpoint                    goa.Endpoint
+	RegisterEventTypeEndpoint               goa.Endpoint
+	ListEventTypesEndpoint                  goa.Endpoint
+	GetEventTypeEndpoint                    goa.Endpoint
+	UpdateEventTypeEndpoint                 goa.Endpoint
+	GetEventTypesCatalogEndpoint            goa.Endpoint
+	GetWebhookEndpointStatusHistoryEndpoint goa.Endpoint
+	GetWebhookEndpointStatsEndpoint         goa.Endpoint
+	ListDeadLettersEndpoint                 goa.Endpoint
+	GetDeadLetterEndpoint                   goa.Endpoint
+	ReplayDeadLetterEndpoint                goa.Endpoint
+	ReplayDeadLettersEndpoint               goa.Endpoint
+	DeleteDeadLetterEndpoint                goa.Endpoint
+	PurgeDeadLettersEndpoint                goa.Endpoint
 }
 
 // NewClient initializes a "Zebrahook" service client given the endpoints.
-func NewClient(createAPIKey, submitNewEvents, register, update, listWebhookEndpoint, getWebhookEndpointByID goa.Endpoint) *Client {
+func NewClient(createAPIKey, submitNewEvents, register, update, listWebhookEndpoint, getWebhookEndpointByID, deleteWebhookEndpoint, listEvents, getEventByID, listWebhookEndpointAttempts, retryEventDelivery, recoverWebhookEndpoint, getWebhookEndpointRecovery, rotateWebhookEndpointSecret, testWebhookEndpoint, listAPIKeys, getAPIKey, updateAPIKey, revokeAPIKey, registerEventType, listEventTypes, getEventType, updateEventType, getEventTypesCatalog, getWebhookEndpointStatusHistory, getWebhookEndpointStats, listDeadLetters, getDeadLetter, replayDeadLetter, replayDeadLetters, deleteDeadLetter, purgeDeadLetters goa.Endpoint) *Client {
 	return &Client{
-		CreateAPIKeyEndpoint:           createAPIKey,
-		SubmitNewEventsEndpoint:        submitNewEvents,
-		RegisterEndpoint:               register,
-		UpdateEndpoint:                 update,
-		ListWebhookEndpointEndpoint:    listWebhookEndpoint,
-		GetWebhookEndpointByIDEndpoint: getWebhookEndpointByID,
+		CreateAPIKeyEndpoint:                    createAPIKey,
+		SubmitNewEventsEndpoint:                 submitNewEvents,
+		RegisterEndpoint:                        register,
+		UpdateEndpoint:                          update,
+		ListWebhookEndpointEndpoint:             listWebhookEndpoint,
+		GetWebhookEndpointByIDEndpoint:          getWebhookEndpointByID,
+		DeleteWebhookEndpointEndpoint:           deleteWebhookEndpoint,
+		ListEventsEndpoint:                      listEvents,
+		GetEventByIDEndpoint:                    getEventByID,
+		ListWebhookEndpointAttemptsEndpoint:     listWebhookEndpointAttempts,
+		RetryEventDeliveryEndpoint:              retryEventDelivery,
+		RecoverWebhookEndpointEndpoint:          recoverWebhookEndpoint,
+		GetWebhookEndpointRecoveryEndpoint:      getWebhookEndpointRecovery,
+		RotateWebhookEndpointSecretEndpoint:     rotateWebhookEndpointSecret,
+		TestWebhookEndpointEndpoint:             testWebhookEndpoint,
+		ListAPIKeysEndpoint:                     listAPIKeys,
+		GetAPIKeyEndpoint:                       getAPIKey,
+		UpdateAPIKeyEndpoint:                    updateAPIKey,
+		RevokeAPIKeyEndpoint:                    revokeAPIKey,
+		RegisterEventTypeEndpoint:               registerEventType,
+		ListEventTypesEndpoint:                  listEventTypes,
+		GetEventTypeEndpoint:                    getEventType,
+		UpdateEventTypeEndpoint:                 updateEventType,
+		GetEventTypesCatalogEndpoint:            getEventTypesCatalog,
+		GetWebhookEndpointStatusHistoryEndpoint: getWebhookEndpointStatusHistory,
+		GetWebhookEndpointStatsEndpoint:         getWebhookEndpointStats,
+		ListDeadLettersEndpoint:                 listDeadLetters,
+		GetDeadLetterEndpoint:                   getDeadLetter,
+		ReplayDeadLetterEndpoint:                replayDeadLetter,
+		ReplayDeadLettersEndpoint:               replayDeadLetters,
+		DeleteDeadLetterEndpoint:                deleteDeadLetter,
+		PurgeDeadLettersEndpoint:                purgeDeadLetters,
 	}
 }
 
@@ -97,3 +150,282 @@ func (c *Client) GetWebhookEndpointByID(ctx context.Context, p *GetWebhookEndpoi
 	}
 	return ires.(*WebhookEndpoint), nil
 }
+
+// DeleteWebhookEndpoint calls the "deleteWebhookEndpoint" endpoint of the
+// "Zebrahook" service.
+func (c *Client) DeleteWebhookEndpoint(ctx context.Context, p *DeleteWebhookEndpointPayload) (res *DeleteWebhookEndpointResult, err error) {
+	var ires interface{}
+	ires, err = c.DeleteWebhookEndpointEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeleteWebhookEndpointResult), nil
+}
+
+// ListEvents calls the "listEvents" endpoint of the "Zebrahook" service.
+func (c *Client) ListEvents(ctx context.Context, p *ListEventsPayload) (res *ListEventsResult, err error) {
+	var ires interface{}
+	ires, err = c.ListEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListEventsResult), nil
+}
+
+// GetEventByID calls the "getEventById" endpoint of the "Zebrahook" service.
+func (c *Client) GetEventByID(ctx context.Context, p *GetEventByIDPayload) (res *EventWithDeliveries, err error) {
+	var ires interface{}
+	ires, err = c.GetEventByIDEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EventWithDeliveries), nil
+}
+
+// ListWebhookEndpointAttempts calls the "listWebhookEndpointAttempts" endpoint
+// of the "Zebrahook" service.
+func (c *Client) ListWebhookEndpointAttempts(ctx context.Context, p *ListWebhookEndpointAttemptsPayload) (res *ListWebhookEndpointAttemptsResult, err error) {
+	var ires interface{}
+	ires, err = c.ListWebhookEndpointAttemptsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListWebhookEndpointAttemptsResult), nil
+}
+
+// RetryEventDelivery calls the "retryEventDelivery" endpoint of the
+// "Zebrahook" service.
+func (c *Client) RetryEventDelivery(ctx context.Context, p *RetryEventDeliveryPayload) (res *EventDelivery, err error) {
+	var ires interface{}
+	ires, err = c.RetryEventDeliveryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EventDelivery), nil
+}
+
+// RecoverWebhookEndpoint calls the "recoverWebhookEndpoint" endpoint of the
+// "Zebrahook" service.
+func (c *Client) RecoverWebhookEndpoint(ctx context.Context, p *RecoverWebhookEndpointPayload) (res *EndpointRecovery, err error) {
+	var ires interface{}
+	ires, err = c.RecoverWebhookEndpointEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EndpointRecovery), nil
+}
+
+// GetWebhookEndpointRecovery calls the "getWebhookEndpointRecovery" endpoint
+// of the "Zebrahook" service.
+func (c *Client) GetWebhookEndpointRecovery(ctx context.Context, p *GetWebhookEndpointRecoveryPayload) (res *EndpointRecovery, err error) {
+	var ires interface{}
+	ires, err = c.GetWebhookEndpointRecoveryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EndpointRecovery), nil
+}
+
+// RotateWebhookEndpointSecret calls the "rotateWebhookEndpointSecret" endpoint
+// of the "Zebrahook" service.
+func (c *Client) RotateWebhookEndpointSecret(ctx context.Context, p *RotateWebhookEndpointSecretPayload) (res *RotateWebhookEndpointSecretResult, err error) {
+	var ires interface{}
+	ires, err = c.RotateWebhookEndpointSecretEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RotateWebhookEndpointSecretResult), nil
+}
+
+// TestWebhookEndpoint calls the "testWebhookEndpoint" endpoint of the
+// "Zebrahook" service.
+func (c *Client) TestWebhookEndpoint(ctx context.Context, p *TestWebhookEndpointPayload) (res *TestWebhookEndpointResult, err error) {
+	var ires interface{}
+	ires, err = c.TestWebhookEndpointEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TestWebhookEndpointResult), nil
+}
+
+// ListAPIKeys calls the "listApiKeys" endpoint of the "Zebrahook" service.
+func (c *Client) ListAPIKeys(ctx context.Context, p *ListAPIKeysPayload) (res *ListAPIKeysResult, err error) {
+	var ires interface{}
+	ires, err = c.ListAPIKeysEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListAPIKeysResult), nil
+}
+
+// GetAPIKey calls the "getApiKey" endpoint of the "Zebrahook" service.
+func (c *Client) GetAPIKey(ctx context.Context, p *GetAPIKeyPayload) (res *APIKey, err error) {
+	var ires interface{}
+	ires, err = c.GetAPIKeyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*APIKey), nil
+}
+
+// UpdateAPIKey calls the "updateApiKey" endpoint of the "Zebrahook" service.
+func (c *Client) UpdateAPIKey(ctx context.Context, p *UpdateAPIKeyPayload) (res *APIKey, err error) {
+	var ires interface{}
+	ires, err = c.UpdateAPIKeyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*APIKey), nil
+}
+
+// RevokeAPIKey calls the "revokeApiKey" endpoint of the "Zebrahook" service.
+func (c *Client) RevokeAPIKey(ctx context.Context, p *RevokeAPIKeyPayload) (res *RevokeAPIKeyResult, err error) {
+	var ires interface{}
+	ires, err = c.RevokeAPIKeyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RevokeAPIKeyResult), nil
+}
+
+// RegisterEventType calls the "registerEventType" endpoint of the "Zebrahook"
+// service.
+func (c *Client) RegisterEventType(ctx context.Context, p *RegisterEventTypePayload) (res *EventType, err error) {
+	var ires interface{}
+	ires, err = c.RegisterEventTypeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EventType), nil
+}
+
+// ListEventTypes calls the "listEventTypes" endpoint of the "Zebrahook"
+// service.
+func (c *Client) ListEventTypes(ctx context.Context, p *ListEventTypesPayload) (res *ListEventTypesResult, err error) {
+	var ires interface{}
+	ires, err = c.ListEventTypesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListEventTypesResult), nil
+}
+
+// GetEventType calls the "getEventType" endpoint of the "Zebrahook" service.
+func (c *Client) GetEventType(ctx context.Context, p *GetEventTypePayload) (res *EventType, err error) {
+	var ires interface{}
+	ires, err = c.GetEventTypeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EventType), nil
+}
+
+// UpdateEventType calls the "updateEventType" endpoint of the "Zebrahook"
+// service.
+func (c *Client) UpdateEventType(ctx context.Context, p *UpdateEventTypePayload) (res *EventType, err error) {
+	var ires interface{}
+	ires, err = c.UpdateEventTypeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EventType), nil
+}
+
+// GetEventTypesCatalog calls the "getEventTypesCatalog" endpoint of the
+// "Zebrahook" service.
+func (c *Client) GetEventTypesCatalog(ctx context.Context, p *GetEventTypesCatalogPayload) (res *GetEventTypesCatalogResult, resp io.ReadCloser, err error) {
+	var ires interface{}
+	ires, err = c.GetEventTypesCatalogEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*GetEventTypesCatalogResponseData)
+	return o.Result, o.Body, nil
+}
+
+// GetWebhookEndpointStatusHistory calls the "getWebhookEndpointStatusHistory"
+// endpoint of the "Zebrahook" service.
+func (c *Client) GetWebhookEndpointStatusHistory(ctx context.Context, p *GetWebhookEndpointStatusHistoryPayload) (res *GetWebhookEndpointStatusHistoryResult, err error) {
+	var ires interface{}
+	ires, err = c.GetWebhookEndpointStatusHistoryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GetWebhookEndpointStatusHistoryResult), nil
+}
+
+// GetWebhookEndpointStats calls the "getWebhookEndpointStats" endpoint of the
+// "Zebrahook" service.
+func (c *Client) GetWebhookEndpointStats(ctx context.Context, p *GetWebhookEndpointStatsPayload) (res *EndpointStats, err error) {
+	var ires interface{}
+	ires, err = c.GetWebhookEndpointStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*EndpointStats), nil
+}
+
+// ListDeadLetters calls the "listDeadLetters" endpoint of the "Zebrahook"
+// service.
+func (c *Client) ListDeadLetters(ctx context.Context, p *ListDeadLettersPayload) (res *ListDeadLettersResult, err error) {
+	var ires interface{}
+	ires, err = c.ListDeadLettersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListDeadLettersResult), nil
+}
+
+// GetDeadLetter calls the "getDeadLetter" endpoint of the "Zebrahook" service.
+func (c *Client) GetDeadLetter(ctx context.Context, p *GetDeadLetterPayload) (res *DeadLetterDetails, err error) {
+	var ires interface{}
+	ires, err = c.GetDeadLetterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLetterDetails), nil
+}
+
+// ReplayDeadLetter calls the "replayDeadLetter" endpoint of the "Zebrahook"
+// service.
+func (c *Client) ReplayDeadLetter(ctx context.Context, p *ReplayDeadLetterPayload) (res *DeadLetter, err error) {
+	var ires interface{}
+	ires, err = c.ReplayDeadLetterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLetter), nil
+}
+
+// ReplayDeadLetters calls the "replayDeadLetters" endpoint of the "Zebrahook"
+// service.
+func (c *Client) ReplayDeadLetters(ctx context.Context, p *ReplayDeadLettersPayload) (res *ReplayDeadLettersResult, err error) {
+	var ires interface{}
+	ires, err = c.ReplayDeadLettersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReplayDeadLettersResult), nil
+}
+
+// DeleteDeadLetter calls the "deleteDeadLetter" endpoint of the "Zebrahook"
+// service.
+func (c *Client) DeleteDeadLetter(ctx context.Context, p *DeleteDeadLetterPayload) (res *DeleteDeadLetterResult, err error) {
+	var ires interface{}
+	ires, err = c.DeleteDeadLetterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeleteDeadLetterResult), nil
+}
+
+// PurgeDeadLetters calls the "purgeDeadLetters" endpoint of the "Zebrahook"
+// service.
+func (c *Client) PurgeDeadLetters(ctx context.Context, p *PurgeDeadLettersPayload) (res *PurgeDeadLettersResult, err error) {
+	var ires interface{}
+	ires, err = c.PurgeDeadLettersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PurgeDeadLettersResult), nil
+}
@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook endpoints
 //
@@ -9,6 +9,7 @@ package zebrahook
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
@@ -16,12 +17,47 @@ import (
 
 // Endpoints wraps the "Zebrahook" service endpoints.
 type Endpoints struct {
-	CreateAPIKey           goa.Endpoint
-	SubmitNewEvents        goa.Endpoint
-	Register               goa.Endpoint
-	Update                 goa.Endpoint
-	ListWebhookEndpoint    goa.Endpoint
-	GetWebhookEndpointByID goa.Endpoint
+	CreateAPIKey                    goa.Endpoint
+	SubmitNewEvents                 goa.Endpoint
+	Register                        goa.Endpoint
+	Update                          goa.Endpoint
+	ListWebhookEndpoint             goa.Endpoint
+	GetWebhookEndpointByID          goa.Endpoint
+	DeleteWebhookEndpoint           goa.Endpoint
+	ListEvents                      goa.Endpoint
+	GetEventByID                    goa.Endpoint
+	ListWebhookEndpointAttempts     goa.Endpoint
+	RetryEventDelivery              goa.Endpoint
+	RecoverWebhookEndpoint          goa.Endpoint
+	GetWebhookEndpointRecovery      goa.Endpoint
+	RotateWebhookEndpointSecret     goa.Endpoint
+	TestWebhookEndpoint             goa.Endpoint
+	ListAPIKeys                     goa.Endpoint
+	GetAPIKey                       goa.Endpoint
+	UpdateAPIKey                    goa.Endpoint
+	RevokeAPIKey                    goa.Endpoint
+	RegisterEventType               goa.Endpoint
+	ListEventTypes                  goa.Endpoint
+	GetEventType                    goa.Endpoint
+	UpdateEventType                 goa.Endpoint
+	GetEventTypesCatalog            goa.Endpoint
+	GetWebhookEndpointStatusHistory goa.Endpoint
+	GetWebhookEndpointStats         goa.Endpoint
+	ListDeadLetters                 goa.Endpoint
+	GetDeadLetter                   goa.Endpoint
+	ReplayDeadLetter                goa.Endpoint
+	ReplayDeadLetters               goa.Endpoint
+	DeleteDeadLetter                goa.Endpoint
+	PurgeDeadLetters                goa.Endpoint
+}
+
+// GetEventTypesCatalogResponseData holds both the result and the HTTP response
+// body reader of the "getEventTypesCatalog" method.
+type GetEventTypesCatalogResponseData struct {
+	// Result is the method result.
+	Result *GetEventTypesCatalogResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
 }
 
 // NewEndpoints wraps the methods of the "Zebrahook" service with endpoints.
@@ -29,12 +65,38 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		CreateAPIKey:           NewCreateAPIKeyEndpoint(s),
-		SubmitNewEvents:        NewSubmitNewEventsEndpoint(s, a.JWTAuth),
-		Register:               NewRegisterEndpoint(s, a.JWTAuth),
-		Update:                 NewUpdateEndpoint(s, a.JWTAuth),
-		ListWebhookEndpoint:    NewListWebhookEndpointEndpoint(s, a.JWTAuth),
-		GetWebhookEndpointByID: NewGetWebhookEndpointByIDEndpoint(s, a.JWTAuth),
+		CreateAPIKey:                    NewCreateAPIKeyEndpoint(s),
+		SubmitNewEvents:                 NewSubmitNewEventsEndpoint(s, a.JWTAuth),
+		Register:                        NewRegisterEndpoint(s, a.JWTAuth),
+		Update:                          NewUpdateEndpoint(s, a.JWTAuth),
+		ListWebhookEndpoint:             NewListWebhookEndpointEndpoint(s, a.JWTAuth),
+		GetWebhookEndpointByID:          NewGetWebhookEndpointByIDEndpoint(s, a.JWTAuth),
+		DeleteWebhookEndpoint:           NewDeleteWebhookEndpointEndpoint(s, a.JWTAuth),
+		ListEvents:                      NewListEventsEndpoint(s, a.JWTAuth),
+		GetEventByID:                    NewGetEventByIDEndpoint(s, a.JWTAuth),
+		ListWebhookEndpointAttempts:     NewListWebhookEndpointAttemptsEndpoint(s, a.JWTAuth),
+		RetryEventDelivery:              NewRetryEventDeliveryEndpoint(s, a.JWTAuth),
+		RecoverWebhookEndpoint:          NewRecoverWebhookEndpointEndpoint(s, a.JWTAuth),
+		GetWebhookEndpointRecovery:      NewGetWebhookEndpointRecoveryEndpoint(s, a.JWTAuth),
+		RotateWebhookEndpointSecret:     NewRotateWebhookEndpointSecretEndpoint(s, a.JWTAuth),
+		TestWebhookEndpoint:             NewTestWebhookEndpointEndpoint(s, a.JWTAuth),
+		ListAPIKeys:                     NewListAPIKeysEndpoint(s, a.JWTAuth),
+		GetAPIKey:                       NewGetAPIKeyEndpoint(s, a.JWTAuth),
+		UpdateAPIKey:                    NewUpdateAPIKeyEndpoint(s, a.JWTAuth),
+		RevokeAPIKey:                    NewRevokeAPIKeyEndpoint(s, a.JWTAuth),
+		RegisterEventType:               NewRegisterEventTypeEndpoint(s, a.JWTAuth),
+		ListEventTypes:                  NewListEventTypesEndpoint(s, a.JWTAuth),
+		GetEventType:                    NewGetEventTypeEndpoint(s, a.JWTAuth),
+		UpdateEventType:                 NewUpdateEventTypeEndpoint(s, a.JWTAuth),
+		GetEventTypesCatalog:            NewGetEventTypesCatalogEndpoint(s, a.JWTAuth),
+		GetWebhookEndpointStatusHistory: NewGetWebhookEndpointStatusHistoryEndpoint(s, a.JWTAuth),
+		GetWebhookEndpointStats:         NewGetWebhookEndpointStatsEndpoint(s, a.JWTAuth),
+		ListDeadLetters:                 NewListDeadLettersEndpoint(s, a.JWTAuth),
+		GetDeadLetter:                   NewGetDeadLetterEndpoint(s, a.JWTAuth),
+		ReplayDeadLetter:                NewReplayDeadLetterEndpoint(s, a.JWTAuth),
+		ReplayDeadLetters:               NewReplayDeadLettersEndpoint(s, a.JWTAuth),
+		DeleteDeadLetter:                NewDeleteDeadLetterEndpoint(s, a.JWTAuth),
+		PurgeDeadLetters:                NewPurgeDeadLettersEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -46,6 +108,32 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Update = m(e.Update)
 	e.ListWebhookEndpoint = m(e.ListWebhookEndpoint)
 	e.GetWebhookEndpointByID = m(e.GetWebhookEndpointByID)
+	e.DeleteWebhookEndpoint = m(e.DeleteWebhookEndpoint)
+	e.ListEvents = m(e.ListEvents)
+	e.GetEventByID = m(e.GetEventByID)
+	e.ListWebhookEndpointAttempts = m(e.ListWebhookEndpointAttempts)
+	e.RetryEventDelivery = m(e.RetryEventDelivery)
+	e.RecoverWebhookEndpoint = m(e.RecoverWebhookEndpoint)
+	e.GetWebhookEndpointRecovery = m(e.GetWebhookEndpointRecovery)
+	e.RotateWebhookEndpointSecret = m(e.RotateWebhookEndpointSecret)
+	e.TestWebhookEndpoint = m(e.TestWebhookEndpoint)
+	e.ListAPIKeys = m(e.ListAPIKeys)
+	e.GetAPIKey = m(e.GetAPIKey)
+	e.UpdateAPIKey = m(e.UpdateAPIKey)
+	e.RevokeAPIKey = m(e.RevokeAPIKey)
+	e.RegisterEventType = m(e.RegisterEventType)
+	e.ListEventTypes = m(e.ListEventTypes)
+	e.GetEventType = m(e.GetEventType)
+	e.UpdateEventType = m(e.UpdateEventType)
+	e.GetEventTypesCatalog = m(e.GetEventTypesCatalog)
+	e.GetWebhookEndpointStatusHistory = m(e.GetWebhookEndpointStatusHistory)
+	e.GetWebhookEndpointStats = m(e.GetWebhookEndpointStats)
+	e.ListDeadLetters = m(e.ListDeadLetters)
+	e.GetDeadLetter = m(e.GetDeadLetter)
+	e.ReplayDeadLetter = m(e.ReplayDeadLetter)
+	e.ReplayDeadLetters = m(e.ReplayDeadLetters)
+	e.DeleteDeadLetter = m(e.DeleteDeadLetter)
+	e.PurgeDeadLetters = m(e.PurgeDeadLetters)
 }
 
 // NewCreateAPIKeyEndpoint returns an endpoint function that calls the method
@@ -65,8 +153,8 @@ func NewSubmitNewEventsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.E
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:write"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
 		if err != nil {
@@ -84,8 +172,8 @@ func NewRegisterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:write"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
 		if err != nil {
@@ -103,8 +191,8 @@ func NewUpdateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:write"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
 		if err != nil {
@@ -122,8 +210,8 @@ func NewListWebhookEndpointEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:read"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
 		if err != nil {
@@ -141,8 +229,8 @@ func NewGetWebhookEndpointByIDEndpoint(s Service, authJWTFn security.AuthJWTFunc
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{},
-			RequiredScopes: []string{},
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:read", "secrets:read"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
 		if err != nil {
@@ -151,3 +239,501 @@ func NewGetWebhookEndpointByIDEndpoint(s Service, authJWTFn security.AuthJWTFunc
 		return s.GetWebhookEndpointByID(ctx, p)
 	}
 }
+
+// NewDeleteWebhookEndpointEndpoint returns an endpoint function that calls the
+// method "deleteWebhookEndpoint" of service "Zebrahook".
+func NewDeleteWebhookEndpointEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*DeleteWebhookEndpointPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.DeleteWebhookEndpoint(ctx, p)
+	}
+}
+
+// NewListEventsEndpoint returns an endpoint function that calls the method
+// "listEvents" of service "Zebrahook".
+func NewListEventsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ListEventsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListEvents(ctx, p)
+	}
+}
+
+// NewGetEventByIDEndpoint returns an endpoint function that calls the method
+// "getEventById" of service "Zebrahook".
+func NewGetEventByIDEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetEventByIDPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetEventByID(ctx, p)
+	}
+}
+
+// NewListWebhookEndpointAttemptsEndpoint returns an endpoint function that
+// calls the method "listWebhookEndpointAttempts" of service "Zebrahook".
+func NewListWebhookEndpointAttemptsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ListWebhookEndpointAttemptsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListWebhookEndpointAttempts(ctx, p)
+	}
+}
+
+// NewRetryEventDeliveryEndpoint returns an endpoint function that calls the
+// method "retryEventDelivery" of service "Zebrahook".
+func NewRetryEventDeliveryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*RetryEventDeliveryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RetryEventDelivery(ctx, p)
+	}
+}
+
+// NewRecoverWebhookEndpointEndpoint returns an endpoint function that calls
+// the method "recoverWebhookEndpoint" of service "Zebrahook".
+func NewRecoverWebhookEndpointEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*RecoverWebhookEndpointPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RecoverWebhookEndpoint(ctx, p)
+	}
+}
+
+// NewGetWebhookEndpointRecoveryEndpoint returns an endpoint function that
+// calls the method "getWebhookEndpointRecovery" of service "Zebrahook".
+func NewGetWebhookEndpointRecoveryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetWebhookEndpointRecoveryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetWebhookEndpointRecovery(ctx, p)
+	}
+}
+
+// NewRotateWebhookEndpointSecretEndpoint returns an endpoint function that
+// calls the method "rotateWebhookEndpointSecret" of service "Zebrahook".
+func NewRotateWebhookEndpointSecretEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*RotateWebhookEndpointSecretPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:write", "secrets:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RotateWebhookEndpointSecret(ctx, p)
+	}
+}
+
+// NewTestWebhookEndpointEndpoint returns an endpoint function that calls the
+// method "testWebhookEndpoint" of service "Zebrahook".
+func NewTestWebhookEndpointEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*TestWebhookEndpointPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.TestWebhookEndpoint(ctx, p)
+	}
+}
+
+// NewListAPIKeysEndpoint returns an endpoint function that calls the method
+// "listApiKeys" of service "Zebrahook".
+func NewListAPIKeysEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ListAPIKeysPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListAPIKeys(ctx, p)
+	}
+}
+
+// NewGetAPIKeyEndpoint returns an endpoint function that calls the method
+// "getApiKey" of service "Zebrahook".
+func NewGetAPIKeyEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetAPIKeyPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetAPIKey(ctx, p)
+	}
+}
+
+// NewUpdateAPIKeyEndpoint returns an endpoint function that calls the method
+// "updateApiKey" of service "Zebrahook".
+func NewUpdateAPIKeyEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*UpdateAPIKeyPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateAPIKey(ctx, p)
+	}
+}
+
+// NewRevokeAPIKeyEndpoint returns an endpoint function that calls the method
+// "revokeApiKey" of service "Zebrahook".
+func NewRevokeAPIKeyEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*RevokeAPIKeyPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RevokeAPIKey(ctx, p)
+	}
+}
+
+// NewRegisterEventTypeEndpoint returns an endpoint function that calls the
+// method "registerEventType" of service "Zebrahook".
+func NewRegisterEventTypeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*RegisterEventTypePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RegisterEventType(ctx, p)
+	}
+}
+
+// NewListEventTypesEndpoint returns an endpoint function that calls the method
+// "listEventTypes" of service "Zebrahook".
+func NewListEventTypesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ListEventTypesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListEventTypes(ctx, p)
+	}
+}
+
+// NewGetEventTypeEndpoint returns an endpoint function that calls the method
+// "getEventType" of service "Zebrahook".
+func NewGetEventTypeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetEventTypePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetEventType(ctx, p)
+	}
+}
+
+// NewUpdateEventTypeEndpoint returns an endpoint function that calls the
+// method "updateEventType" of service "Zebrahook".
+func NewUpdateEventTypeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*UpdateEventTypePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"admin"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateEventType(ctx, p)
+	}
+}
+
+// NewGetEventTypesCatalogEndpoint returns an endpoint function that calls the
+// method "getEventTypesCatalog" of service "Zebrahook".
+func NewGetEventTypesCatalogEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetEventTypesCatalogPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, body, err := s.GetEventTypesCatalog(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &GetEventTypesCatalogResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewGetWebhookEndpointStatusHistoryEndpoint returns an endpoint function that
+// calls the method "getWebhookEndpointStatusHistory" of service "Zebrahook".
+func NewGetWebhookEndpointStatusHistoryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetWebhookEndpointStatusHistoryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetWebhookEndpointStatusHistory(ctx, p)
+	}
+}
+
+// NewGetWebhookEndpointStatsEndpoint returns an endpoint function that calls
+// the method "getWebhookEndpointStats" of service "Zebrahook".
+func NewGetWebhookEndpointStatsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetWebhookEndpointStatsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"endpoints:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetWebhookEndpointStats(ctx, p)
+	}
+}
+
+// NewListDeadLettersEndpoint returns an endpoint function that calls the
+// method "listDeadLetters" of service "Zebrahook".
+func NewListDeadLettersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ListDeadLettersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListDeadLetters(ctx, p)
+	}
+}
+
+// NewGetDeadLetterEndpoint returns an endpoint function that calls the method
+// "getDeadLetter" of service "Zebrahook".
+func NewGetDeadLetterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*GetDeadLetterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetDeadLetter(ctx, p)
+	}
+}
+
+// NewReplayDeadLetterEndpoint returns an endpoint function that calls the
+// method "replayDeadLetter" of service "Zebrahook".
+func NewReplayDeadLetterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ReplayDeadLetterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ReplayDeadLetter(ctx, p)
+	}
+}
+
+// NewReplayDeadLettersEndpoint returns an endpoint function that calls the
+// method "replayDeadLetters" of service "Zebrahook".
+func NewReplayDeadLettersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*ReplayDeadLettersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ReplayDeadLetters(ctx, p)
+	}
+}
+
+// NewDeleteDeadLetterEndpoint returns an endpoint function that calls the
+// method "deleteDeadLetter" of service "Zebrahook".
+func NewDeleteDeadLetterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*DeleteDeadLetterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.DeleteDeadLetter(ctx, p)
+	}
+}
+
+// NewPurgeDeadLettersEndpoint returns an endpoint function that calls the
+// method "purgeDeadLetters" of service "Zebrahook".
+func NewPurgeDeadLettersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		p := req.(*PurgeDeadLettersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"events:read", "events:write", "endpoints:read", "endpoints:write", "secrets:read", "admin"},
+			RequiredScopes: []string{"events:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.PurgeDeadLetters(ctx, p)
+	}
+}
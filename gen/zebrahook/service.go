@@ -1,4 +1,4 @@
-// Code generated by goa v3.7.2, DO NOT EDIT.
+// Code generated by goa v3.7.6, DO NOT EDIT.
 //
 // Zebrahook service
 //
@@ -9,6 +9,7 @@ package zebrahook
 
 import (
 	"context"
+	"io"
 
 	"goa.design/goa/v3/security"
 )
@@ -28,6 +29,85 @@ type Service interface {
 	ListWebhookEndpoint(context.Context, *ListWebhookEndpointPayload) (res *ListWebhookEndpointResult, err error)
 	// Allows to get info about a registered webhook URL via the identifier
 	GetWebhookEndpointByID(context.Context, *GetWebhookEndpointByIDPayload) (res *WebhookEndpoint, err error)
+	// Allows to delete a registered webhook endpoint, pending and held deliveries
+	// for this endpoint are cancelled
+	DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointPayload) (res *DeleteWebhookEndpointResult, err error)
+	// Allows to list and query submitted events, most recent first
+	ListEvents(context.Context, *ListEventsPayload) (res *ListEventsResult, err error)
+	// Allows to get a submitted event with the delivery status for each webhook
+	// endpoint
+	GetEventByID(context.Context, *GetEventByIDPayload) (res *EventWithDeliveries, err error)
+	// Allows to list the delivery attempts made to a webhook endpoint, most recent
+	// first
+	ListWebhookEndpointAttempts(context.Context, *ListWebhookEndpointAttemptsPayload) (res *ListWebhookEndpointAttemptsResult, err error)
+	// Allows to deliver again an event to a webhook endpoint, a new delivery is
+	// created even if the previous one has no more attempts remaining or the
+	// webhook endpoint is disabled
+	RetryEventDelivery(context.Context, *RetryEventDeliveryPayload) (res *EventDelivery, err error)
+	// Allows to deliver again all the events not successfully delivered to a
+	// webhook endpoint since the provided time, the webhook endpoint is enabled if
+	// disabled. Recovery is asynchronous, use the returned identifier to check the
+	// progress
+	RecoverWebhookEndpoint(context.Context, *RecoverWebhookEndpointPayload) (res *EndpointRecovery, err error)
+	// Allows to get the progress of a webhook endpoint recovery
+	GetWebhookEndpointRecovery(context.Context, *GetWebhookEndpointRecoveryPayload) (res *EndpointRecovery, err error)
+	// Allows to generate a new secret for a webhook endpoint, the previous secret
+	// stays valid for a grace period during which events are signed with both
+	// secrets. Only one previous secret is kept: the secret can't be rotated again
+	// until the grace period ends
+	RotateWebhookEndpointSecret(context.Context, *RotateWebhookEndpointSecretPayload) (res *RotateWebhookEndpointSecretResult, err error)
+	// Allows to send a test event to a webhook endpoint, the event is signed and
+	// delivered synchronously and the outcome is returned. Test events are not
+	// stored and never disable the webhook endpoint
+	TestWebhookEndpoint(context.Context, *TestWebhookEndpointPayload) (res *TestWebhookEndpointResult, err error)
+	// Allows to list the api keys
+	ListAPIKeys(context.Context, *ListAPIKeysPayload) (res *ListAPIKeysResult, err error)
+	// Allows to describe an api key
+	GetAPIKey(context.Context, *GetAPIKeyPayload) (res *APIKey, err error)
+	// Allows to update the description and the expiration of an api key
+	UpdateAPIKey(context.Context, *UpdateAPIKeyPayload) (res *APIKey, err error)
+	// Allows to revoke an api key, revoked api keys can't be used anymore
+	RevokeAPIKey(context.Context, *RevokeAPIKeyPayload) (res *RevokeAPIKeyResult, err error)
+	// Allows to declare a new event type, the content of the submitted events of
+	// this type is validated against its JSON Schema
+	RegisterEventType(context.Context, *RegisterEventTypePayload) (res *EventType, err error)
+	// Allows to list the registered event types
+	ListEventTypes(context.Context, *ListEventTypesPayload) (res *ListEventTypesResult, err error)
+	// Allows to retrieve a registered event type
+	GetEventType(context.Context, *GetEventTypePayload) (res *EventType, err error)
+	// Allows to update the description, the JSON Schema, the example or the
+	// deprecated flag of an event type
+	UpdateEventType(context.Context, *UpdateEventTypePayload) (res *EventType, err error)
+	// Allows to export the catalog of the registered event types as an AsyncAPI
+	// 2.x document, a Markdown or an HTML page, describing the webhook payloads,
+	// the signature header and the retry policy
+	GetEventTypesCatalog(context.Context, *GetEventTypesCatalogPayload) (res *GetEventTypesCatalogResult, body io.ReadCloser, err error)
+	// Allows to list the status changes of a webhook endpoint with their reason,
+	// most recent first
+	GetWebhookEndpointStatusHistory(context.Context, *GetWebhookEndpointStatusHistoryPayload) (res *GetWebhookEndpointStatusHistoryResult, err error)
+	// Allows to retrieve the delivery stats of a webhook endpoint over a time
+	// window, stats are updated periodically and can lag behind the latest
+	// attempts by up to `stats.rollupIntervalSecs` seconds
+	GetWebhookEndpointStats(context.Context, *GetWebhookEndpointStatsPayload) (res *EndpointStats, err error)
+	// Allows to list the event deliveries that failed without more attempts (dead
+	// letters), most recent first
+	ListDeadLetters(context.Context, *ListDeadLettersPayload) (res *ListDeadLettersResult, err error)
+	// Allows to inspect a dead letter with its event and the last response of the
+	// webhook endpoint
+	GetDeadLetter(context.Context, *GetDeadLetterPayload) (res *DeadLetterDetails, err error)
+	// Allows to deliver again the event of a dead letter to its webhook endpoint,
+	// a new delivery is created even if the webhook endpoint is disabled
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterPayload) (res *DeadLetter, err error)
+	// Allows to deliver again the events of the dead letters matching the filters,
+	// oldest first. Dead letters of deleted webhook endpoints are skipped, call
+	// again while `has_more` is true
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersPayload) (res *ReplayDeadLettersResult, err error)
+	// Allows to permanently remove a dead letter, the event and its deliveries are
+	// kept
+	DeleteDeadLetter(context.Context, *DeleteDeadLetterPayload) (res *DeleteDeadLetterResult, err error)
+	// Allows to permanently remove the dead letters matching the filters, the
+	// events and their deliveries are kept
+	PurgeDeadLetters(context.Context, *PurgeDeadLettersPayload) (res *PurgeDeadLettersResult, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -44,13 +124,40 @@ const ServiceName = "Zebrahook"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"createApiKey", "submitNewEvents", "register", "update", "listWebhookEndpoint", "getWebhookEndpointById"}
+var MethodNames = [32]string{"createApiKey", "submitNewEvents", "register", "update", "listWebhookEndpoint", "getWebhookEndpointById", "deleteWebhookEndpoint", "listEvents", "getEventById", "listWebhookEndpointAttempts", "retryEventDelivery", "recoverWebhookEndpoint", "getWebhookEndpointRecovery", "rotateWebhookEndpointSecret", "testWebhookEndpoint", "listApiKeys", "getApiKey", "updateApiKey", "revokeApiKey", "registerEventType", "listEventTypes", "getEventType", "updateEventType", "getEventTypesCatalog", "getWebhookEndpointStatusHistory", "getWebhookEndpointStats", "listDeadLetters", "getDeadLetter", "replayDeadLetter", "replayDeadLetters", "deleteDeadLetter", "purgeDeadLetters"}
+
+// APIKey is the result type of the Zebrahook service getApiKey method.
+type APIKey struct {
+	// identifier of the api key
+	ID uint
+	// description for internal use
+	Description string
+	// status of the api key, disabled keys are rejected
+	Status string
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string
+	// tenant owning the api key, endpoints and events are only visible within the
+	// same tenant
+	Tenant string
+	// when this api key expires (unix timestamp seconds)
+	ExpiresAt *int64
+	// when this api key was last used (unix timestamp seconds)
+	LastUsedAt *int64
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64
+}
 
 // CreateAPIKeyPayload is the payload type of the Zebrahook service
 // createApiKey method.
 type CreateAPIKeyPayload struct {
 	// description for internal use
 	Description *string
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string
+	// tenant owning the api key, empty for the default tenant
+	Tenant *string
 }
 
 // CreateAPIKeyResult is the result type of the Zebrahook service createApiKey
@@ -60,6 +167,244 @@ type CreateAPIKeyResult struct {
 	APIKey string
 }
 
+// DeadLetter is the result type of the Zebrahook service replayDeadLetter
+// method.
+type DeadLetter struct {
+	// identifier of the dead letter
+	ID uint
+	// identifier of the failed event delivery
+	EventDeliveryID uint
+	// identifier of the event
+	EventID uint
+	// event type of the event
+	EventType string
+	// identifier of the webhook endpoint
+	EndpointID string
+	// why the delivery failed
+	Reason string
+	// how many attempts have been made
+	AttemptsCounter uint
+	// outcome of the last attempt
+	LastAttemptStatus string
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64
+	// dead until the event is delivered again
+	Status string
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt int64
+}
+
+// DeadLetterDetails is the result type of the Zebrahook service getDeadLetter
+// method.
+type DeadLetterDetails struct {
+	// body of the last response (if any)
+	LastHTTPBodyResponse *string
+	// event that was not delivered
+	Event *Event
+	// identifier of the dead letter
+	ID uint
+	// identifier of the failed event delivery
+	EventDeliveryID uint
+	// identifier of the event
+	EventID uint
+	// event type of the event
+	EventType string
+	// identifier of the webhook endpoint
+	EndpointID string
+	// why the delivery failed
+	Reason string
+	// how many attempts have been made
+	AttemptsCounter uint
+	// outcome of the last attempt
+	LastAttemptStatus string
+	// http status code of the last response (if any)
+	LastHTTPStatusCode *int
+	// when the last attempt was made (unix timestamp seconds)
+	LastAttemptMadeAt *int64
+	// dead until the event is delivered again
+	Status string
+	// event delivery created by the replay
+	ReplayEventDeliveryID *uint
+	// when the event was delivered again (unix timestamp seconds)
+	ReplayedAt *int64
+	// when the delivery failed (unix timestamp seconds)
+	CreatedAt int64
+}
+
+// DeleteDeadLetterPayload is the payload type of the Zebrahook service
+// deleteDeadLetter method.
+type DeleteDeadLetterPayload struct {
+	Token string
+	// dead letter identifier
+	ID uint
+}
+
+// DeleteDeadLetterResult is the result type of the Zebrahook service
+// deleteDeadLetter method.
+type DeleteDeadLetterResult struct {
+	Success *bool
+}
+
+// DeleteWebhookEndpointPayload is the payload type of the Zebrahook service
+// deleteWebhookEndpoint method.
+type DeleteWebhookEndpointPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+}
+
+// DeleteWebhookEndpointResult is the result type of the Zebrahook service
+// deleteWebhookEndpoint method.
+type DeleteWebhookEndpointResult struct {
+	Success *bool
+}
+
+type DeliveryPolicy struct {
+	// HTTP request timeout in seconds
+	TimeoutSecs *uint
+	// maximum number of delivery attempts of each event
+	MaxAttempts *uint
+	// backoff strategy between two attempts: exponential (with full jitter),
+	// linear, fixed or schedule
+	BackoffType *string
+	// base seconds used to calculate the backoff
+	BackoffBaseSecs *uint
+	// maximum seconds between two attempts
+	MaxBackoffSecs *uint
+	// seconds to wait before each retry with the schedule backoff type, the last
+	// one is used for the remaining attempts
+	BackoffScheduleSecs []uint
+}
+
+// EndpointRecovery is the result type of the Zebrahook service
+// recoverWebhookEndpoint method.
+type EndpointRecovery struct {
+	// identifier of the recovery
+	ID string
+	// identifier of the webhook endpoint
+	EndpointID string
+	// event deliveries created since this time are recovered (unix timestamp
+	// seconds)
+	Since int64
+	// status of the recovery
+	Status string
+	// how many event deliveries will be delivered again
+	DeliveriesFound uint
+	// how many event deliveries have been enqueued so far
+	DeliveriesEnqueued uint
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64
+}
+
+// EndpointStats is the result type of the Zebrahook service
+// getWebhookEndpointStats method.
+type EndpointStats struct {
+	// webhook endpoint identifier
+	EndpointID string
+	// time window of the stats
+	Window string
+	// start of the window (unix timestamp seconds), aligned to the 5 minutes stats
+	// buckets
+	From int64
+	// end of the window (unix timestamp seconds)
+	To int64
+	// delivery attempts made in the window
+	Attempts uint
+	// share of successful attempts (0-1), not set without attempts
+	SuccessRate *float64
+	// attempts per attempt status
+	StatusCounts map[string]uint
+	// attempts per http response status code
+	HTTPStatusCodes map[string]uint
+	// response time percentiles, estimated from a histogram (linear interpolation
+	// within the bucket holding the percentile), not set without responses
+	ResponseTimeSecs *ResponseTimePercentiles
+}
+
+type EndpointStatusEvent struct {
+	// identifier of the status change
+	ID uint
+	// status before the change
+	OldStatus string
+	// status after the change
+	NewStatus string
+	// why the status changed
+	Reason string
+	// api key that made the change, not set for automatic changes
+	ActorAPIKeyID *uint
+	// jwt subject that made the change, not set for automatic changes
+	ActorSubject *string
+	// when the status changed (unix timestamp seconds)
+	CreatedAt int64
+}
+
+type Event struct {
+	// identifier of the event
+	ID uint
+	// Event type of the `event_content`
+	EventType string
+	// event content dispatched to the webhook endpoints
+	EventContent map[string]interface{}
+	// priority of this event
+	Priority int
+	// when this event was submitted (unix timestamp seconds)
+	CreatedAt int64
+}
+
+// EventDelivery is the result type of the Zebrahook service retryEventDelivery
+// method.
+type EventDelivery struct {
+	// identifier of the event delivery
+	ID uint
+	// identifier of the webhook endpoint
+	EndpointID string
+	// URL of the webhook endpoint
+	EndpointURL *string
+	// status of this delivery
+	Status string
+	// how many attempts have been made so far
+	AttemptsCounter uint
+	// how many attempts are remaining
+	AttemptsRemaining uint
+	// when the next attempt is scheduled (unix timestamp seconds)
+	NextAttemptScheduledAt *uint
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64
+}
+
+type EventDeliveryAttempt struct {
+	// identifier of the attempt
+	ID uint
+	// identifier of the event delivery
+	EventDeliveryID uint
+	// identifier of the event
+	EventID uint
+	// event type of the event delivered
+	EventType string
+	// outcome of this attempt
+	Status string
+	// when the request was sent (unix timestamp seconds)
+	AttemptMadeAt *int64
+	// http status code of the response (if any)
+	HTTPStatusCode *int
+	// response body returned from the endpoint, truncated (if any)
+	HTTPBodyResponse *string
+	// response time in seconds
+	HTTPResponseTimeSecs *float32
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64
+}
+
 type EventRequest struct {
 	// Event type of the `event_content`
 	EventType string
@@ -68,6 +413,93 @@ type EventRequest struct {
 	// Optional priority for this event, an higher number will make this event
 	// delivered before other ones
 	Priority *int
+	// Optional idempotency key for this event, if an event with the same key was
+	// already submitted the event is not created again
+	IdempotencyKey *string
+}
+
+// EventType is the result type of the Zebrahook service registerEventType
+// method.
+type EventType struct {
+	// event type name, same format of the submitted `event_type`
+	Name string
+	// what the event type represents
+	Description *string
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must satisfy
+	Schema map[string]interface{}
+	// example of `event_content`
+	Example map[string]interface{}
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool
+	// when this item was created (unix timestamp seconds)
+	CreatedAt int64
+	// when this item was last updated (unix timestamp seconds)
+	UpdatedAt int64
+}
+
+// EventWithDeliveries is the result type of the Zebrahook service getEventById
+// method.
+type EventWithDeliveries struct {
+	// deliveries of this event, one for each webhook endpoint subscribed
+	Deliveries []*EventDelivery
+	// identifier of the event
+	ID uint
+	// Event type of the `event_content`
+	EventType string
+	// event content dispatched to the webhook endpoints
+	EventContent map[string]interface{}
+	// priority of this event
+	Priority int
+	// when this event was submitted (unix timestamp seconds)
+	CreatedAt int64
+}
+
+// GetAPIKeyPayload is the payload type of the Zebrahook service getApiKey
+// method.
+type GetAPIKeyPayload struct {
+	Token string
+	// api key identifier
+	ID uint
+}
+
+// GetDeadLetterPayload is the payload type of the Zebrahook service
+// getDeadLetter method.
+type GetDeadLetterPayload struct {
+	Token string
+	// dead letter identifier
+	ID uint
+}
+
+// GetEventByIDPayload is the payload type of the Zebrahook service
+// getEventById method.
+type GetEventByIDPayload struct {
+	Token string
+	// event identifier
+	ID uint
+}
+
+// GetEventTypePayload is the payload type of the Zebrahook service
+// getEventType method.
+type GetEventTypePayload struct {
+	Token string
+	// event type name
+	Name string
+}
+
+// GetEventTypesCatalogPayload is the payload type of the Zebrahook service
+// getEventTypesCatalog method.
+type GetEventTypesCatalogPayload struct {
+	Token string
+	// catalog format, `asyncapi` is an AsyncAPI 2.6 json document
+	Format string
+}
+
+// GetEventTypesCatalogResult is the result type of the Zebrahook service
+// getEventTypesCatalog method.
+type GetEventTypesCatalogResult struct {
+	// content type of the catalog
+	ContentType string
 }
 
 // GetWebhookEndpointByIDPayload is the payload type of the Zebrahook service
@@ -78,6 +510,164 @@ type GetWebhookEndpointByIDPayload struct {
 	ID string
 }
 
+// GetWebhookEndpointRecoveryPayload is the payload type of the Zebrahook
+// service getWebhookEndpointRecovery method.
+type GetWebhookEndpointRecoveryPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// recovery identifier
+	RecoveryID string
+}
+
+// GetWebhookEndpointStatsPayload is the payload type of the Zebrahook service
+// getWebhookEndpointStats method.
+type GetWebhookEndpointStatsPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// time window of the stats
+	Window string
+}
+
+// GetWebhookEndpointStatusHistoryPayload is the payload type of the Zebrahook
+// service getWebhookEndpointStatusHistory method.
+type GetWebhookEndpointStatusHistoryPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// limit how many results to return
+	Limit int32
+	// cursor for pagination, return status changes made before the provided
+	// identifier
+	StartingAfter *uint
+}
+
+// GetWebhookEndpointStatusHistoryResult is the result type of the Zebrahook
+// service getWebhookEndpointStatusHistory method.
+type GetWebhookEndpointStatusHistoryResult struct {
+	Result []*EndpointStatusEvent
+	// true if there are more status changes after this page, use the last
+	// identifier as `starting_after`
+	HasMore bool
+}
+
+// ListAPIKeysPayload is the payload type of the Zebrahook service listApiKeys
+// method.
+type ListAPIKeysPayload struct {
+	Token string
+	// filter by status
+	Status *string
+}
+
+// ListAPIKeysResult is the result type of the Zebrahook service listApiKeys
+// method.
+type ListAPIKeysResult struct {
+	Result []*APIKey
+}
+
+// ListDeadLettersPayload is the payload type of the Zebrahook service
+// listDeadLetters method.
+type ListDeadLettersPayload struct {
+	Token string
+	// limit how many results to return
+	Limit int32
+	// cursor for pagination, return dead letters created before the provided
+	// identifier
+	StartingAfter *uint
+	// filter by status
+	Status string
+	// filter by webhook endpoint
+	EndpointID *string
+	// filter by event type
+	EventType *string
+	// filter by createdAt unix (greater than or equal)
+	CreatedAtGte *uint64
+	// filter by createdAt unix (less than)
+	CreatedAtLt *uint64
+}
+
+// ListDeadLettersResult is the result type of the Zebrahook service
+// listDeadLetters method.
+type ListDeadLettersResult struct {
+	Result []*DeadLetter
+	// true if there are more dead letters after this page, use the last identifier
+	// as `starting_after`
+	HasMore bool
+}
+
+// ListEventTypesPayload is the payload type of the Zebrahook service
+// listEventTypes method.
+type ListEventTypesPayload struct {
+	Token string
+	// filter by deprecated flag
+	Deprecated *bool
+}
+
+// ListEventTypesResult is the result type of the Zebrahook service
+// listEventTypes method.
+type ListEventTypesResult struct {
+	Result []*EventType
+}
+
+// ListEventsPayload is the payload type of the Zebrahook service listEvents
+// method.
+type ListEventsPayload struct {
+	Token string
+	// limit how many results to return
+	Limit int32
+	// cursor for pagination, return events created before the provided event
+	// identifier
+	StartingAfter *uint
+	// filter by event type
+	EventType *string
+	// filter by priority
+	Priority *int
+	// filter by createdAt unix (greater than or equal)
+	CreatedAtGte *uint64
+	// filter by createdAt unix (less than)
+	CreatedAtLt *uint64
+}
+
+// ListEventsResult is the result type of the Zebrahook service listEvents
+// method.
+type ListEventsResult struct {
+	Result []*Event
+	// true if there are more events after this page, use the last identifier as
+	// `starting_after`
+	HasMore bool
+}
+
+// ListWebhookEndpointAttemptsPayload is the payload type of the Zebrahook
+// service listWebhookEndpointAttempts method.
+type ListWebhookEndpointAttemptsPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// limit how many results to return
+	Limit int32
+	// cursor for pagination, return attempts created before the provided attempt
+	// identifier
+	StartingAfter *uint
+	// filter by attempt status
+	Status *string
+	// filter by event type
+	EventType *string
+	// filter by createdAt unix (greater than or equal)
+	CreatedAtGte *uint64
+	// filter by createdAt unix (less than)
+	CreatedAtLt *uint64
+}
+
+// ListWebhookEndpointAttemptsResult is the result type of the Zebrahook
+// service listWebhookEndpointAttempts method.
+type ListWebhookEndpointAttemptsResult struct {
+	Result []*EventDeliveryAttempt
+	// true if there are more attempts after this page, use the last identifier as
+	// `starting_after`
+	HasMore bool
+}
+
 // ListWebhookEndpointPayload is the payload type of the Zebrahook service
 // listWebhookEndpoint method.
 type ListWebhookEndpointPayload struct {
@@ -100,6 +690,55 @@ type ListWebhookEndpointResult struct {
 	Result []*WebhookEndpointWithoutSecret
 }
 
+// PurgeDeadLettersPayload is the payload type of the Zebrahook service
+// purgeDeadLetters method.
+type PurgeDeadLettersPayload struct {
+	Token string
+	// purge only the dead letters with this status
+	Status *string
+	// purge only the dead letters of this webhook endpoint
+	EndpointID *string
+	// purge only the dead letters of this event type
+	EventType *string
+	// purge only the dead letters created before (less than)
+	CreatedAtLt *uint64
+}
+
+// PurgeDeadLettersResult is the result type of the Zebrahook service
+// purgeDeadLetters method.
+type PurgeDeadLettersResult struct {
+	// how many dead letters have been removed
+	Purged int64
+}
+
+// RecoverWebhookEndpointPayload is the payload type of the Zebrahook service
+// recoverWebhookEndpoint method.
+type RecoverWebhookEndpointPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// recover event deliveries created since this time (unix timestamp seconds)
+	Since int64
+}
+
+// RegisterEventTypePayload is the payload type of the Zebrahook service
+// registerEventType method.
+type RegisterEventTypePayload struct {
+	Token string
+	// event type name, same format of the submitted `event_type`
+	Name string
+	// what the event type represents
+	Description *string
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must
+	// satisfy, external references are not allowed
+	Schema map[string]interface{}
+	// example of `event_content`, must satisfy the schema
+	Example map[string]interface{}
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated bool
+}
+
 // RegisterPayload is the payload type of the Zebrahook service register method.
 type RegisterPayload struct {
 	Token string
@@ -110,6 +749,105 @@ type RegisterPayload struct {
 	EnabledEvents []string
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicy
+}
+
+// ReplayDeadLetterPayload is the payload type of the Zebrahook service
+// replayDeadLetter method.
+type ReplayDeadLetterPayload struct {
+	Token string
+	// dead letter identifier
+	ID uint
+}
+
+// ReplayDeadLettersPayload is the payload type of the Zebrahook service
+// replayDeadLetters method.
+type ReplayDeadLettersPayload struct {
+	Token string
+	// replay only these dead letters
+	Ids []uint
+	// replay only the dead letters of this webhook endpoint
+	EndpointID *string
+	// replay only the dead letters of this event type
+	EventType *string
+	// replay only the dead letters created after (greater than or equal)
+	CreatedAtGte *uint64
+	// replay only the dead letters created before (less than)
+	CreatedAtLt *uint64
+	// limit how many dead letters to replay
+	Limit int32
+}
+
+// ReplayDeadLettersResult is the result type of the Zebrahook service
+// replayDeadLetters method.
+type ReplayDeadLettersResult struct {
+	// replayed dead letters
+	Result []*DeadLetter
+	// true if there are more dead letters to replay matching the filters
+	HasMore bool
+}
+
+type ResponseTimePercentiles struct {
+	// median response time in seconds
+	P50 float64
+	// 95th percentile of the response time in seconds
+	P95 float64
+	// 99th percentile of the response time in seconds
+	P99 float64
+}
+
+// RetryEventDeliveryPayload is the payload type of the Zebrahook service
+// retryEventDelivery method.
+type RetryEventDeliveryPayload struct {
+	Token string
+	// event identifier
+	ID uint
+	// identifier of the webhook endpoint that received the event
+	EndpointID string
+}
+
+// RevokeAPIKeyPayload is the payload type of the Zebrahook service
+// revokeApiKey method.
+type RevokeAPIKeyPayload struct {
+	Token string
+	// api key identifier
+	ID uint
+}
+
+// RevokeAPIKeyResult is the result type of the Zebrahook service revokeApiKey
+// method.
+type RevokeAPIKeyResult struct {
+	Success *bool
+}
+
+// RotateWebhookEndpointSecretPayload is the payload type of the Zebrahook
+// service rotateWebhookEndpointSecret method.
+type RotateWebhookEndpointSecretPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// how many seconds the previous secret stays valid, if not provided the
+	// configured value is used
+	GracePeriodSecs *uint
+}
+
+// RotateWebhookEndpointSecretResult is the result type of the Zebrahook
+// service rotateWebhookEndpointSecret method.
+type RotateWebhookEndpointSecretResult struct {
+	// until when the previous secret is used to sign events (unix timestamp
+	// seconds)
+	PreviousSecretExpiresAt int64
+	// identifier of the webhook
+	ID string
+	// secret to be used by the webhook to verify the events
+	Secret string
 }
 
 // SubmitNewEventsPayload is the payload type of the Zebrahook service
@@ -117,12 +855,96 @@ type RegisterPayload struct {
 type SubmitNewEventsPayload struct {
 	Token  string
 	Events []*EventRequest
+	// Optional idempotency key for the whole request, requests with an already
+	// used key return the events created the first time
+	IdempotencyKey *string
 }
 
 // SubmitNewEventsResult is the result type of the Zebrahook service
 // submitNewEvents method.
 type SubmitNewEventsResult struct {
-	Success *bool
+	// true if all the submitted events have been accepted
+	Success bool
+	// result for each submitted event, in the same order of the submitted events
+	Results []*SubmittedEventResult
+}
+
+type SubmittedEventResult struct {
+	// position of the event in the submitted events
+	Index int
+	// identifier of the event, not present if the event was rejected
+	EventID *uint
+	// normalized event type
+	EventType string
+	// `pending_mapping` until the event is mapped to the subscribed webhook
+	// endpoints
+	MappingStatus *string
+	// how many webhook endpoints are subscribed to this event, present once mapped
+	EndpointsMatched *uint
+	// reason why the event was rejected
+	ErrorCode *string
+	// human readable reason why the event was rejected
+	ErrorMessage *string
+}
+
+// TestWebhookEndpointPayload is the payload type of the Zebrahook service
+// testWebhookEndpoint method.
+type TestWebhookEndpointPayload struct {
+	Token string
+	// webhook identifier returned in creation
+	ID string
+	// event type set in the sample content (`zebrahook.test` by default), can't be
+	// used with `event_content` as deliveries send only the event content
+	EventType *string
+	// content of the test event, sent as is like the content of a delivery, if not
+	// provided a sample content is sent
+	EventContent map[string]interface{}
+}
+
+// TestWebhookEndpointResult is the result type of the Zebrahook service
+// testWebhookEndpoint method.
+type TestWebhookEndpointResult struct {
+	// outcome of the request
+	Status string
+	// http status code of the response (if any)
+	HTTPStatusCode *int
+	// response body returned from the endpoint, truncated (if any)
+	HTTPBodyResponse *string
+	// response time in seconds
+	HTTPResponseTimeSecs float64
+}
+
+// UpdateAPIKeyPayload is the payload type of the Zebrahook service
+// updateApiKey method.
+type UpdateAPIKeyPayload struct {
+	Token string
+	// api key identifier
+	ID uint
+	// description for internal use
+	Description *string
+	// when this api key expires (unix timestamp seconds), use 0 to remove the
+	// expiration
+	ExpiresAt *int64
+	// scopes granted to the api key, `*` grants all the scopes
+	Scopes []string
+}
+
+// UpdateEventTypePayload is the payload type of the Zebrahook service
+// updateEventType method.
+type UpdateEventTypePayload struct {
+	Token string
+	// event type name
+	Name string
+	// what the event type represents
+	Description *string
+	// JSON Schema (draft 2020-12 by default) that the `event_content` must
+	// satisfy, external references are not allowed
+	Schema map[string]interface{}
+	// example of `event_content`, must satisfy the schema
+	Example map[string]interface{}
+	// deprecated event types are still accepted but should not be used by new
+	// producers
+	Deprecated *bool
 }
 
 // UpdatePayload is the payload type of the Zebrahook service update method.
@@ -138,6 +960,12 @@ type UpdatePayload struct {
 	EnabledEvents []string
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string
+	// Custom HTTP headers added to every webhook request, replaces the current
+	// ones (use an empty object to remove them)
+	Headers map[string]string
+	// Delivery policy of this endpoint, replaces the current one (settings not
+	// provided fall back to the global configuration)
+	DeliveryPolicy *DeliveryPolicy
 	// identifier of the webhook
 	ID string
 }
@@ -157,6 +985,13 @@ type WebhookEndpoint struct {
 	// status of current endpoint, enabled means that the webhook endpoint is
 	// eligible for receiving webhook events
 	Status *string
+	// why the endpoint was disabled, not set when enabled
+	DisabledReason *string
+	// circuit breaker state, deliveries are held while open and a single probe
+	// delivery is sent while half open
+	CircuitState *string
+	// when the circuit was opened (unix timestamp seconds)
+	CircuitOpenedAt *int64
 	// URL of the webhook that will be called on each `enabled_events`
 	URL string
 	// Enabled events for this webhook URL, regex supported - use `["*"]` to listen
@@ -164,6 +999,14 @@ type WebhookEndpoint struct {
 	EnabledEvents []string
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicy
 	// identifier of the webhook
 	ID string
 	// secret to be used by the webhook to verify the events
@@ -178,6 +1021,13 @@ type WebhookEndpointWithoutSecret struct {
 	// status of current endpoint, enabled means that the webhook endpoint is
 	// eligible for receiving webhook events
 	Status *string
+	// why the endpoint was disabled, not set when enabled
+	DisabledReason *string
+	// circuit breaker state, deliveries are held while open and a single probe
+	// delivery is sent while half open
+	CircuitState *string
+	// when the circuit was opened (unix timestamp seconds)
+	CircuitOpenedAt *int64
 	// URL of the webhook that will be called on each `enabled_events`
 	URL string
 	// Enabled events for this webhook URL, regex supported - use `["*"]` to listen
@@ -185,6 +1035,14 @@ type WebhookEndpointWithoutSecret struct {
 	EnabledEvents []string
 	// Optionally pass any custom metadata (key->value)
 	Metadata map[string]string
+	// Optional custom HTTP headers added to every webhook request (e.g.
+	// `X-Api-Key`), values are stored encrypted and masked in responses.
+	// `User-Agent`, `Content-Type`, the signature header and the hop-by-hop
+	// headers are reserved
+	Headers map[string]string
+	// Optional delivery policy of this endpoint, settings not provided fall back
+	// to the global configuration
+	DeliveryPolicy *DeliveryPolicy
 	// identifier of the webhook
 	ID string
 }
@@ -0,0 +1,250 @@
+package zebrahook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spf13/viper"
+)
+
+// claims extracted from a validated JWT token
+type jwtClaims struct {
+	subject  string
+	tenantId string
+	scopes   []string
+}
+
+// validates JWT tokens issued by a trusted gateway, HS256 tokens are
+// verified with the configured secret, RS256/ES256 tokens with the
+// public keys of a local JWKS file
+type jwtVerifier struct {
+	hmacSecret []byte
+	// public keys by key id (`kid`)
+	publicKeys map[string]interface{}
+
+	issuer   string
+	audience string
+
+	tenantClaim string
+	scopeClaim  string
+	// tokens without the tenant claim belong to the default tenant
+	allowDefaultTenant bool
+}
+
+// single key of a JSON Web Key Set (RFC 7517), only the
+// parameters of RSA and EC public keys are supported
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// returns the verifier based on the `jwt` configuration, nil
+// if JWT authentication is not configured
+func newJwtVerifier() (*jwtVerifier, error) {
+	hmacSecret := viper.GetString("jwt.hmacSecret")
+	jwksFile := viper.GetString("jwt.jwksFile")
+
+	if hmacSecret == "" && jwksFile == "" {
+		return nil, nil
+	}
+
+	verifier := &jwtVerifier{
+		hmacSecret:         []byte(hmacSecret),
+		publicKeys:         map[string]interface{}{},
+		issuer:             viper.GetString("jwt.issuer"),
+		audience:           viper.GetString("jwt.audience"),
+		tenantClaim:        viper.GetString("jwt.tenantClaim"),
+		scopeClaim:         viper.GetString("jwt.scopeClaim"),
+		allowDefaultTenant: viper.GetBool("jwt.allowDefaultTenant"),
+	}
+
+	if jwksFile != "" {
+		publicKeys, err := loadJwks(jwksFile)
+		if err != nil {
+			return nil, err
+		}
+		verifier.publicKeys = publicKeys
+	}
+
+	return verifier, nil
+}
+
+// reads the public keys of a JWKS file, keys are indexed by `kid`
+func loadJwks(path string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read jwks file: %w", err)
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(content, &jwks); err != nil {
+		return nil, fmt.Errorf("invalid jwks file: %w", err)
+	}
+
+	publicKeys := map[string]interface{}{}
+	for _, key := range jwks.Keys {
+		// skip encryption keys
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+
+		publicKey, err := key.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwks key %s: %w", key.Kid, err)
+		}
+		publicKeys[key.Kid] = publicKey
+	}
+
+	if len(publicKeys) == 0 {
+		return nil, errors.New("jwks file does not contain any signing key")
+	}
+
+	return publicKeys, nil
+}
+
+func decodeBase64UrlInt(value string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(decoded), nil
+}
+
+func (key jsonWebKey) publicKey() (interface{}, error) {
+	switch key.Kty {
+	case "RSA":
+		n, err := decodeBase64UrlInt(key.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64UrlInt(key.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch key.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.New("unsupported curve " + key.Crv)
+		}
+		x, err := decodeBase64UrlInt(key.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64UrlInt(key.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve " + key.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, errors.New("unsupported key type " + key.Kty)
+}
+
+// returns the key to use to verify the token signature
+func (v *jwtVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(v.hmacSecret) == 0 {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return v.hmacSecret, nil
+
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		kid, _ := token.Header["kid"].(string)
+		if publicKey, ok := v.publicKeys[kid]; ok {
+			return publicKey, nil
+		}
+		// tokens without `kid` are accepted only when there is one key
+		if kid == "" && len(v.publicKeys) == 1 {
+			for _, publicKey := range v.publicKeys {
+				return publicKey, nil
+			}
+		}
+		return nil, errors.New("unknown key id " + kid)
+	}
+
+	return nil, errors.New("unexpected signing method " + token.Method.Alg())
+}
+
+// validates the token signature and claims, tokens must expire (`exp`)
+func (v *jwtVerifier) parse(tokenString string) (*jwtClaims, error) {
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"HS256", "RS256", "ES256"}))
+
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(tokenString, claims, v.keyFunc); err != nil {
+		return nil, err
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("token without expiration")
+	}
+	if v.issuer != "" && !claims.VerifyIssuer(v.issuer, true) {
+		return nil, errors.New("invalid token issuer")
+	}
+	if v.audience != "" && !claims.VerifyAudience(v.audience, true) {
+		return nil, errors.New("invalid token audience")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("token without subject")
+	}
+
+	// the default tenant owns the resources created before multi tenancy,
+	// it's never granted by a missing claim unless explicitly allowed
+	tenantId, _ := claims[v.tenantClaim].(string)
+	if tenantId == "" && !v.allowDefaultTenant {
+		return nil, errors.New("token without tenant")
+	}
+
+	// space separated (OAuth 2.0) or list of scopes
+	scopes := []string{}
+	switch scopeClaim := claims[v.scopeClaim].(type) {
+	case string:
+		scopes = strings.Fields(scopeClaim)
+	case []interface{}:
+		for _, scope := range scopeClaim {
+			if scopeStr, ok := scope.(string); ok {
+				scopes = append(scopes, scopeStr)
+			}
+		}
+	}
+
+	return &jwtClaims{
+		subject:  subject,
+		tenantId: tenantId,
+		scopes:   scopes,
+	}, nil
+}
@@ -0,0 +1,207 @@
+package zebrahook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spf13/viper"
+)
+
+const testJwtSecret = "a-gateway-secret-of-at-least-32-bytes"
+
+// writes a JWKS file publishing the public part of the keys, by kid
+func writeTestJwks(t *testing.T, keys map[string]interface{}) string {
+	encode := func(value []byte) string {
+		return base64.RawURLEncoding.EncodeToString(value)
+	}
+
+	jwks := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	for kid, key := range keys {
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			jwks.Keys = append(jwks.Keys, jsonWebKey{Kid: kid, Kty: "RSA", Use: "sig",
+				N: encode(key.N.Bytes()), E: encode([]byte{1, 0, 1})})
+		case *ecdsa.PrivateKey:
+			jwks.Keys = append(jwks.Keys, jsonWebKey{Kid: kid, Kty: "EC", Crv: "P-256",
+				X: encode(key.X.Bytes()), Y: encode(key.Y.Bytes())})
+		}
+	}
+
+	content, err := json.Marshal(jwks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func gatewayClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":    "svc-orders",
+		"tenant": "merchant-93842",
+		"scope":  "events:write endpoints:read",
+		"exp":    time.Now().Add(5 * time.Minute).Unix(),
+	}
+}
+
+func signWithKid(t *testing.T, method jwt.SigningMethod, kid string, key interface{}) string {
+	token := jwt.NewWithClaims(method, gatewayClaims())
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestJwtJwksKeyIdMiss(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rotatedKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	viper.Set("jwt.jwksFile", writeTestJwks(t, map[string]interface{}{"2024-rsa": rsaKey, "2024-ec": ecKey}))
+	viper.Set("jwt.tenantClaim", "tenant")
+	viper.Set("jwt.scopeClaim", "scope")
+	verifier, err := newJwtVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := verifier.parse(signWithKid(t, jwt.SigningMethodRS256, "2024-rsa", rsaKey)); err != nil {
+		t.Errorf("rs256 token with a published kid rejected: %v", err)
+	}
+	if _, err := verifier.parse(signWithKid(t, jwt.SigningMethodES256, "2024-ec", ecKey)); err != nil {
+		t.Errorf("es256 token with a published kid rejected: %v", err)
+	}
+
+	// kid of a key not published (yet) in the JWKS file
+	_, err = verifier.parse(signWithKid(t, jwt.SigningMethodRS256, "2025-rsa", rotatedKey))
+	if err == nil || !strings.Contains(err.Error(), "unknown key id 2025-rsa") {
+		t.Errorf("expected unknown key id error, got %v", err)
+	}
+
+	// without kid the key can't be chosen among several ones
+	_, err = verifier.parse(signWithKid(t, jwt.SigningMethodRS256, "", rsaKey))
+	if err == nil || !strings.Contains(err.Error(), "unknown key id") {
+		t.Errorf("expected token without kid to be rejected with two keys, got %v", err)
+	}
+
+	// published kid, signed by another key
+	if _, err := verifier.parse(signWithKid(t, jwt.SigningMethodRS256, "2024-rsa", rotatedKey)); err == nil {
+		t.Error("expected token signed by a rotated key to be rejected")
+	}
+
+	// the kid of an RSA key doesn't make an ES256 signature valid
+	if _, err := verifier.parse(signWithKid(t, jwt.SigningMethodES256, "2024-rsa", ecKey)); err == nil {
+		t.Error("expected es256 token with the kid of an RSA key to be rejected")
+	}
+
+	// with a single key the kid is optional
+	viper.Set("jwt.jwksFile", writeTestJwks(t, map[string]interface{}{"2024-rsa": rsaKey}))
+	singleKeyVerifier, err := newJwtVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := singleKeyVerifier.parse(signWithKid(t, jwt.SigningMethodRS256, "", rsaKey)); err != nil {
+		t.Errorf("token without kid rejected with a single key: %v", err)
+	}
+}
+
+func TestJwtUnexpectedAlgorithms(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	viper.Set("jwt.jwksFile", writeTestJwks(t, map[string]interface{}{"2024-rsa": rsaKey}))
+	verifier, err := newJwtVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKeyDer, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+
+	for name, token := range map[string]string{
+		"none":  signWithKid(t, jwt.SigningMethodNone, "2024-rsa", jwt.UnsafeAllowNoneSignatureType),
+		"RS384": signWithKid(t, jwt.SigningMethodRS384, "2024-rsa", rsaKey),
+		// HMAC keyed with the public key, accepted by verifiers picking
+		// the key without checking the algorithm
+		"HS256 with the public key": signWithKid(t, jwt.SigningMethodHS256, "2024-rsa", publicKeyDer),
+	} {
+		if _, err := verifier.parse(token); err == nil {
+			t.Errorf("expected %s token to be rejected", name)
+		}
+	}
+}
+
+func TestJwtExpiration(t *testing.T) {
+	verifier := &jwtVerifier{hmacSecret: []byte(testJwtSecret), tenantClaim: "tenant", scopeClaim: "scope"}
+
+	expired := gatewayClaims()
+	expired["exp"] = time.Now().Add(-time.Second).Unix()
+	expiredToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, expired).SignedString([]byte(testJwtSecret))
+	if _, err := verifier.parse(expiredToken); err == nil {
+		t.Error("expected expired token to be rejected")
+	}
+
+	neverExpiring := gatewayClaims()
+	delete(neverExpiring, "exp")
+	neverExpiringToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, neverExpiring).SignedString([]byte(testJwtSecret))
+	if _, err := verifier.parse(neverExpiringToken); err == nil || err.Error() != "token without expiration" {
+		t.Errorf("expected token without expiration to be rejected, got %v", err)
+	}
+}
+
+func TestJwtTenantClaim(t *testing.T) {
+	withoutTenant := gatewayClaims()
+	delete(withoutTenant, "tenant")
+	withoutTenantToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, withoutTenant).SignedString([]byte(testJwtSecret))
+
+	withTenantToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, gatewayClaims()).SignedString([]byte(testJwtSecret))
+
+	// tenant claim configured with another name than the one in the token
+	verifier := &jwtVerifier{hmacSecret: []byte(testJwtSecret), tenantClaim: "https://example.com/tenant", scopeClaim: "scope"}
+	if _, err := verifier.parse(withTenantToken); err == nil || err.Error() != "token without tenant" {
+		t.Errorf("expected token without the configured tenant claim to be rejected, got %v", err)
+	}
+
+	verifier.tenantClaim = "tenant"
+	if _, err := verifier.parse(withoutTenantToken); err == nil || err.Error() != "token without tenant" {
+		t.Errorf("expected token without tenant to be rejected, got %v", err)
+	}
+
+	claims, err := verifier.parse(withTenantToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.tenantId != "merchant-93842" || strings.Join(claims.scopes, ",") != "events:write,endpoints:read" {
+		t.Errorf("unexpected claims %+v", claims)
+	}
+
+	verifier.allowDefaultTenant = true
+	claims, err = verifier.parse(withoutTenantToken)
+	if err != nil {
+		t.Fatalf("expected token without tenant to be accepted with allowDefaultTenant, got %v", err)
+	}
+	if claims.tenantId != "" {
+		t.Errorf("expected the default tenant, got %q", claims.tenantId)
+	}
+}
@@ -1,13 +1,17 @@
 package zebrahook
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	goa "goa.design/goa/v3/pkg"
@@ -19,12 +23,16 @@ import (
 	"zebrahook/database"
 	"zebrahook/models"
 	"zebrahook/utils"
+	"zebrahook/worker/dispatcher"
+	"zebrahook/worker/eventMapping"
 
 	front "zebrahook/gen/zebrahook"
 
+	"github.com/lib/pq"
 	"github.com/nya1/pgq"
 	xid "github.com/rs/xid"
 	"github.com/rs/zerolog"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/spf13/viper"
 	"github.com/wagslane/go-rabbitmq"
 	"gorm.io/datatypes"
@@ -41,6 +49,8 @@ type frontsrvc struct {
 	logger    *zerolog.Logger
 	db        *gorm.DB
 	publisher *rabbitmq.Publisher
+	// nil when jwt authentication is not configured
+	jwtVerifier *jwtVerifier
 }
 
 // NewFront returns the front service implementation.
@@ -73,7 +83,12 @@ func NewFront() front.Service {
 		panic("failed to connect to database")
 	}
 
-	return &frontsrvc{&logger, db, nil}
+	jwtVerifier, err := newJwtVerifier()
+	if err != nil {
+		panic(fmt.Errorf("invalid jwt configuration: %w", err))
+	}
+
+	return &frontsrvc{&logger, db, nil, jwtVerifier}
 }
 
 func (s *frontsrvc) findApiKey(clearTextApiKey string) (matchFound *models.ApiKey, err error) {
@@ -84,9 +99,44 @@ func (s *frontsrvc) findApiKey(clearTextApiKey string) (matchFound *models.ApiKe
 		return nil, errors.New("api key not found")
 	}
 
+	if apiKeyFound.Status != constants.StatusEnabled {
+		return nil, errors.New("api key revoked")
+	}
+
+	if apiKeyFound.ExpiresAt != nil && *apiKeyFound.ExpiresAt <= time.Now().Unix() {
+		return nil, errors.New("api key expired")
+	}
+
 	return &apiKeyFound, nil
 }
 
+// restricts a query to the resources owned by the authenticated tenant
+func tenantScope(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	tenantId := contextAuthInfo(ctx).tenantId
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("tenant_id = ?", tenantId)
+	}
+}
+
+// returns an error if any of the scopes can't be granted to an api key
+func validateApiKeyScopes(scopes []string) error {
+	for _, scope := range scopes {
+		allowed := false
+		for _, allowedScope := range constants.ApiKeyScopes {
+			if scope == allowedScope {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return errors.New("invalid api key scope " + scope + ", allowed: " + strings.Join(constants.ApiKeyScopes, ","))
+		}
+	}
+
+	return nil
+}
+
 func (s *frontsrvc) CreateAPIKey(ctx context.Context, p *front.CreateAPIKeyPayload) (res *front.CreateAPIKeyResult, err error) {
 	s.logger.Debug().Interface("payload", p).Msg("front.CreateApiKey")
 
@@ -95,12 +145,25 @@ func (s *frontsrvc) CreateAPIKey(ctx context.Context, p *front.CreateAPIKeyPaylo
 
 	hashedApiKey := utils.HashApiKey(clearTextApiKey)
 
+	// by default grant all the scopes
+	scopes := p.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{constants.ScopeAll}
+	}
+	if err := validateApiKeyScopes(scopes); err != nil {
+		return nil, err
+	}
+
 	// insert to db
 	// create new endpoint instance
 	newApiKeyToCreate := &models.ApiKey{
 		Hash:        hashedApiKey,
 		Description: *p.Description,
 		Status:      constants.StatusEnabled,
+		Scopes:      scopes,
+	}
+	if p.Tenant != nil {
+		newApiKeyToCreate.TenantID = strings.TrimSpace(*p.Tenant)
 	}
 
 	s.db.Create(newApiKeyToCreate)
@@ -110,66 +173,503 @@ func (s *frontsrvc) CreateAPIKey(ctx context.Context, p *front.CreateAPIKeyPaylo
 	}, nil
 }
 
+// returns the idempotency key if used within the retention window,
+// expired keys are removed so that they can be used again
+func (s *frontsrvc) findIdempotencyKey(db *gorm.DB, auth authInfo, kind string, key string) *models.IdempotencyKey {
+	var idempotencyKeyFound models.IdempotencyKey
+	result := db.First(&idempotencyKeyFound, "api_key_id = ? AND tenant_id = ? AND subject = ? AND kind = ? AND key = ?", auth.apiKeyId, auth.tenantId, auth.subject, kind, key)
+
+	if result.Error != nil {
+		return nil
+	}
+
+	retentionSecs := viper.GetInt64("idempotency.retentionSecs")
+	if idempotencyKeyFound.CreatedAt < time.Now().Unix()-retentionSecs {
+		db.Unscoped().Delete(&models.IdempotencyKey{}, "id = ?", idempotencyKeyFound.Id)
+		return nil
+	}
+
+	return &idempotencyKeyFound
+}
+
+// locks the idempotency keys of the request until the end of the transaction
+// and returns errIdempotencyKeyUsed if a concurrent request stored one of them
+// after they were looked up
+func (s *frontsrvc) lockIdempotencyKeys(tx *gorm.DB, auth authInfo, requestKey *string, eventKeys map[string]int) error {
+	type idempotencyKey struct {
+		kind string
+		key  string
+	}
+	keys := []idempotencyKey{}
+	if requestKey != nil {
+		keys = append(keys, idempotencyKey{constants.IdempotencyKindRequest, *requestKey})
+	}
+	for key := range eventKeys {
+		keys = append(keys, idempotencyKey{constants.IdempotencyKindEvent, key})
+	}
+
+	// same order in every request, concurrent requests don't deadlock
+	lockNames := []string{}
+	for _, key := range keys {
+		lockNames = append(lockNames, fmt.Sprint(auth.apiKeyId, "/", auth.tenantId, "/", auth.subject, "/", key.kind, "/", key.key))
+	}
+	sort.Strings(lockNames)
+
+	for _, lockName := range lockNames {
+		if result := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", lockName); result.Error != nil {
+			return result.Error
+		}
+	}
+
+	for _, key := range keys {
+		if s.findIdempotencyKey(tx, auth, key.kind, key.key) != nil {
+			return errIdempotencyKeyUsed
+		}
+	}
+
+	return nil
+}
+
+// event to create with its position in the request
+type submittedEvent struct {
+	requestIndex   int
+	idempotencyKey *string
+	event          models.Event
+}
+
+// returned when an idempotency key has been stored by a concurrent request
+var errIdempotencyKeyUsed = errors.New("idempotency key used by a concurrent request")
+
 func (s *frontsrvc) SubmitNewEvents(ctx context.Context, p *front.SubmitNewEventsPayload) (res *front.SubmitNewEventsResult, err error) {
-	var eventsToInsert []models.Event
+	res, err = s.submitNewEvents(ctx, p)
+
+	// a concurrent request with the same idempotency keys won, submitting
+	// again returns (or skips) its events
+	if err == errIdempotencyKeyUsed {
+		s.logger.Info().Msg("idempotency key used by a concurrent request, submitting again")
+		res, err = s.submitNewEvents(ctx, p)
+	}
+	if err == errIdempotencyKeyUsed {
+		return nil, errors.New("error while submitting new events")
+	}
+
+	return res, err
+}
+
+func (s *frontsrvc) submitNewEvents(ctx context.Context, p *front.SubmitNewEventsPayload) (res *front.SubmitNewEventsResult, err error) {
+	authInfo := contextAuthInfo(ctx)
+
+	validatedEvents, err := s.validateEventRequests(ctx, p.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	// replay of a request already processed, return the same events
+	if p.IdempotencyKey != nil {
+		idempotencyKeyFound := s.findIdempotencyKey(s.db, authInfo, constants.IdempotencyKindRequest, *p.IdempotencyKey)
+		if idempotencyKeyFound != nil {
+			s.logger.Info().Str("idempotencyKey", *p.IdempotencyKey).Msg("idempotency key already used, returning previous events")
+
+			previousEventIds := make([]uint, len(p.Events))
+			for requestIndex, eventId := range idempotencyKeyFound.EventIds {
+				if requestIndex < len(previousEventIds) {
+					previousEventIds[requestIndex] = uint(eventId)
+				}
+			}
+
+			return s.submittedEventsResult(p, previousEventIds, validatedEvents)
+		}
+	}
+
+	// event identifiers in request order, 0 for rejected events
+	eventIds := make([]uint, len(p.Events))
+
+	// request index of the first event submitted with a given idempotency key
+	eventIdempotencyKeys := map[string]int{}
+	// request index of events that reuse the event of another request index
+	duplicatedEvents := map[int]int{}
+
+	var eventsToInsert []submittedEvent
+
+	for requestIndex, eventReq := range p.Events {
+		validatedEvent := validatedEvents[requestIndex]
+		if validatedEvent.errorCode != "" {
+			s.logger.Debug().Int("index", requestIndex).Str("errorCode", validatedEvent.errorCode).Msg("event rejected")
+			continue
+		}
+
+		if eventReq.IdempotencyKey != nil {
+			// same key used twice in this request
+			if firstRequestIndex, ok := eventIdempotencyKeys[*eventReq.IdempotencyKey]; ok {
+				duplicatedEvents[requestIndex] = firstRequestIndex
+				continue
+			}
+
+			// event already submitted in a previous request
+			idempotencyKeyFound := s.findIdempotencyKey(s.db, authInfo, constants.IdempotencyKindEvent, *eventReq.IdempotencyKey)
+			if idempotencyKeyFound != nil && len(idempotencyKeyFound.EventIds) > 0 {
+				s.logger.Debug().Str("idempotencyKey", *eventReq.IdempotencyKey).Msg("event idempotency key already used, skipping event")
+				eventIds[requestIndex] = uint(idempotencyKeyFound.EventIds[0])
+				continue
+			}
+
+			eventIdempotencyKeys[*eventReq.IdempotencyKey] = requestIndex
+		}
 
-	for _, eventReq := range p.Events {
 		// default priority
 		priority := 0
 
 		if eventReq.Priority != nil {
 			priority = *eventReq.Priority
 		}
-		eventContentJson, _ := json.Marshal(eventReq.EventContent)
 
 		newEvent := models.Event{
-			EventType:    eventReq.EventType,
-			EventContent: datatypes.JSON(eventContentJson),
+			EventType:    validatedEvent.eventType,
+			EventContent: validatedEvent.eventContent,
 			Priority:     priority,
+			TenantID:     authInfo.tenantId,
 		}
-		eventsToInsert = append(eventsToInsert, newEvent)
+		eventsToInsert = append(eventsToInsert, submittedEvent{
+			requestIndex:   requestIndex,
+			idempotencyKey: eventReq.IdempotencyKey,
+			event:          newEvent,
+		})
 	}
 
 	// sort by priority
 	sort.SliceStable(eventsToInsert, func(i, j int) bool {
-		return eventsToInsert[i].Priority > eventsToInsert[j].Priority
+		return eventsToInsert[i].event.Priority > eventsToInsert[j].event.Priority
 	})
 
 	rawDb, _ := s.db.DB()
 	worker := pgq.NewWorker(rawDb)
 
-	// create event delivery, first event attempt entry and trigger job
-	// TODO pack everything in one tranasaction
-
-	s.db.Create(&eventsToInsert)
+	events := []models.Event{}
+	for _, submitted := range eventsToInsert {
+		events = append(events, submitted.event)
+	}
 
+	// create events, trigger event mapping jobs and store idempotency keys
+	// in one transaction, pgq jobs live in the same database
 	var basicEventMapping []EventMapping
-	for _, event := range eventsToInsert {
-		eventMapping := EventMapping{
-			EventType: event.EventType,
-			EventId:   event.Id,
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, err := database.SqlTx(tx)
+		if err != nil {
+			return err
+		}
+
+		if err := s.lockIdempotencyKeys(tx, authInfo, p.IdempotencyKey, eventIdempotencyKeys); err != nil {
+			return err
+		}
+
+		if len(events) > 0 {
+			if result := tx.Create(&events); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		for i, event := range events {
+			eventsToInsert[i].event = event
+			eventIds[eventsToInsert[i].requestIndex] = event.Id
+
+			eventMapping := EventMapping{
+				EventType: event.EventType,
+				EventId:   event.Id,
+			}
+			s.logger.Debug().Interface("eventMapping", eventMapping).Msg("going to enqueue new event")
+			basicEventMapping = append(basicEventMapping, eventMapping)
+			encodedEventMapping, _ := json.Marshal(eventMapping)
+
+			jobID, err := worker.EnqueueJobInTx(sqlTx, constants.QueueEventMapping, encodedEventMapping)
+			if err != nil {
+				return err
+			}
+			s.logger.Debug().Int("jobId", jobID).Msg("enqueue successfully")
+		}
+
+		for requestIndex, firstRequestIndex := range duplicatedEvents {
+			eventIds[requestIndex] = eventIds[firstRequestIndex]
+		}
+
+		// remember idempotency keys used
+		idempotencyKeysToCreate := []models.IdempotencyKey{}
+		for _, submitted := range eventsToInsert {
+			if submitted.idempotencyKey != nil {
+				idempotencyKeysToCreate = append(idempotencyKeysToCreate, models.IdempotencyKey{
+					ApiKeyID: authInfo.apiKeyId,
+					TenantID: authInfo.tenantId,
+					Subject:  authInfo.subject,
+					Kind:     constants.IdempotencyKindEvent,
+					Key:      *submitted.idempotencyKey,
+					EventIds: pq.Int64Array{int64(submitted.event.Id)},
+				})
+			}
 		}
-		s.logger.Debug().Interface("eventMapping", eventMapping).Msg("going to enqueue new event")
-		basicEventMapping = append(basicEventMapping, eventMapping)
-		encodedEventMapping, _ := json.Marshal(eventMapping)
+		if p.IdempotencyKey != nil {
+			requestEventIds := pq.Int64Array{}
+			for _, eventId := range eventIds {
+				requestEventIds = append(requestEventIds, int64(eventId))
+			}
+			idempotencyKeysToCreate = append(idempotencyKeysToCreate, models.IdempotencyKey{
+				ApiKeyID: authInfo.apiKeyId,
+				TenantID: authInfo.tenantId,
+				Subject:  authInfo.subject,
+				Kind:     constants.IdempotencyKindRequest,
+				Key:      *p.IdempotencyKey,
+				EventIds: requestEventIds,
+			})
+		}
+		if len(idempotencyKeysToCreate) > 0 {
+			if result := tx.Create(&idempotencyKeysToCreate); result.Error != nil {
+				return result.Error
+			}
+		}
+
+		return nil
+	})
+
+	if err == errIdempotencyKeyUsed {
+		return nil, err
+	}
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to submit new events")
+		return nil, errors.New("error while submitting new events")
+	}
+
+	s.logger.Info().Interface("events", basicEventMapping).Msg(fmt.Sprintf("created %d event(s)", len(events)))
+
+	return s.submittedEventsResult(p, eventIds, validatedEvents)
+}
+
+// builds the result of each submitted event, `eventIds` and `validatedEvents`
+// are in request order, event id is set to 0 for rejected events
+func (s *frontsrvc) submittedEventsResult(p *front.SubmitNewEventsPayload, eventIds []uint, validatedEvents []validatedEventRequest) (res *front.SubmitNewEventsResult, err error) {
+	eventIdsToLoad := []uint{}
+	for _, eventId := range eventIds {
+		if eventId != 0 {
+			eventIdsToLoad = append(eventIdsToLoad, eventId)
+		}
+	}
 
-		jobID, _ := worker.EnqueueJob(constants.QueueEventMapping, encodedEventMapping)
-		s.logger.Debug().Int("jobId", jobID).Msg("enqueue successfully")
+	var eventsFound []models.Event
+	if len(eventIdsToLoad) > 0 {
+		if result := s.db.Where("id IN ?", eventIdsToLoad).Find(&eventsFound); result.Error != nil {
+			return nil, errors.New("error while querying submitted events")
+		}
 	}
+	eventsById := map[uint]models.Event{}
+	for _, event := range eventsFound {
+		eventsById[event.Id] = event
+	}
+
+	success := true
+	results := []*front.SubmittedEventResult{}
+	for requestIndex := range p.Events {
+		validatedEvent := validatedEvents[requestIndex]
+
+		eventResult := &front.SubmittedEventResult{
+			Index:     requestIndex,
+			EventType: validatedEvent.eventType,
+		}
+
+		event, ok := eventsById[eventIds[requestIndex]]
+		if !ok {
+			success = false
+			if validatedEvent.errorCode != "" {
+				eventResult.ErrorCode = &validatedEvent.errorCode
+				eventResult.ErrorMessage = &validatedEvent.errorMessage
+			}
+			results = append(results, eventResult)
+			continue
+		}
+
+		eventId := event.Id
+		eventResult.EventID = &eventId
+		eventResult.EventType = event.EventType
+
+		mappingStatus := constants.EventMappingPending
+		if event.MappedAt != nil {
+			mappingStatus = constants.EventMappingMapped
+			eventResult.EndpointsMatched = event.EndpointsMatched
+		}
+		eventResult.MappingStatus = &mappingStatus
 
-	s.logger.Info().Interface("events", basicEventMapping).Msg(fmt.Sprintf("created %d event(s)", len(eventsToInsert)))
+		results = append(results, eventResult)
+	}
 
 	return &front.SubmitNewEventsResult{
-		Success: utils.BoolPointer(true),
+		Success: success,
+		Results: results,
 	}, nil
 }
 
+// allowed event types, dot separated words e.g. merchant-93842.order.shipped
+var eventTypeRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-]+(\.[a-zA-Z0-9_\-]+)*$`)
+
+type validatedEventRequest struct {
+	// normalized event type
+	eventType    string
+	eventContent datatypes.JSON
+
+	// set if the event must be rejected
+	errorCode    string
+	errorMessage string
+}
+
+// normalizes and validates a submitted event
+func validateEventRequest(eventReq *front.EventRequest) validatedEventRequest {
+	validatedEvent := validatedEventRequest{
+		eventType: strings.TrimSpace(eventReq.EventType),
+	}
+
+	if !eventTypeRegex.MatchString(validatedEvent.eventType) {
+		validatedEvent.errorCode = constants.EventErrorInvalidEventType
+		validatedEvent.errorMessage = "event type must be a dot separated list of words (letters, numbers, `-` and `_`)"
+		return validatedEvent
+	}
+
+	// emitted only by zebrahook
+	if eventMapping.IsSystemEvent(validatedEvent.eventType) {
+		validatedEvent.errorCode = constants.EventErrorInvalidEventType
+		validatedEvent.errorMessage = "event types starting with " + constants.SystemEventPrefix + " are reserved"
+		return validatedEvent
+	}
+
+	eventContentJson, err := json.Marshal(eventReq.EventContent)
+	if err != nil {
+		validatedEvent.errorCode = constants.EventErrorInvalidEventContent
+		validatedEvent.errorMessage = "event content is not a valid json object"
+		return validatedEvent
+	}
+	validatedEvent.eventContent = datatypes.JSON(eventContentJson)
+
+	return validatedEvent
+}
+
+// validates the submitted events, based on `eventTypes.validation` events of
+// unregistered types are rejected and the content of the events of registered
+// types must satisfy their json schema
+func (s *frontsrvc) validateEventRequests(ctx context.Context, eventReqs []*front.EventRequest) ([]validatedEventRequest, error) {
+	validatedEvents := make([]validatedEventRequest, len(eventReqs))
+	eventTypeNames := []string{}
+	for requestIndex, eventReq := range eventReqs {
+		validatedEvents[requestIndex] = validateEventRequest(eventReq)
+		if validatedEvents[requestIndex].errorCode == "" {
+			eventTypeNames = append(eventTypeNames, validatedEvents[requestIndex].eventType)
+		}
+	}
+
+	validationMode := viper.GetString("eventTypes.validation")
+	if validationMode == constants.EventTypeValidationOff || len(eventTypeNames) == 0 {
+		return validatedEvents, nil
+	}
+
+	var eventTypesFound []models.EventType
+	if result := s.db.Scopes(tenantScope(ctx)).Where("name IN ?", eventTypeNames).Find(&eventTypesFound); result.Error != nil {
+		return nil, errors.New("error while querying event types")
+	}
+
+	eventTypesByName := map[string]models.EventType{}
+	// compiled schemas, event types without a schema are not included
+	schemasByName := map[string]*jsonschema.Schema{}
+	for _, eventType := range eventTypesFound {
+		eventTypesByName[eventType.Name] = eventType
+
+		if len(eventType.Schema) == 0 {
+			continue
+		}
+		schema, err := utils.CompileJsonSchema(eventType.Schema)
+		if err != nil {
+			// schema is validated on registration
+			s.logger.Error().Err(err).Str("eventType", eventType.Name).Msg("unable to compile event type schema")
+			continue
+		}
+		schemasByName[eventType.Name] = schema
+	}
+
+	for requestIndex := range validatedEvents {
+		validatedEvent := &validatedEvents[requestIndex]
+		if validatedEvent.errorCode != "" {
+			continue
+		}
+
+		eventType, ok := eventTypesByName[validatedEvent.eventType]
+		if !ok {
+			if validationMode == constants.EventTypeValidationStrict {
+				validatedEvent.errorCode = constants.EventErrorUnregisteredType
+				validatedEvent.errorMessage = "event type " + validatedEvent.eventType + " is not registered"
+			} else {
+				s.logger.Warn().Str("eventType", validatedEvent.eventType).Msg("submitted event of unregistered type")
+			}
+			continue
+		}
+
+		if eventType.Deprecated {
+			s.logger.Warn().Str("eventType", eventType.Name).Msg("submitted event of deprecated type")
+		}
+
+		schema, ok := schemasByName[eventType.Name]
+		if !ok {
+			continue
+		}
+
+		violations := utils.ValidateJsonSchema(schema, validatedEvent.eventContent)
+		if len(violations) > 0 {
+			validatedEvent.errorCode = constants.EventErrorSchemaValidation
+			validatedEvent.errorMessage = "event content does not satisfy the event type schema: " + strings.Join(violations, "; ")
+		}
+	}
+
+	return validatedEvents, nil
+}
+
+// returns the delivery policy overrides of the endpoint, nil if there are none
+func formatDeliveryPolicy(endpoint models.Endpoint) *front.DeliveryPolicy {
+	if endpoint.DeliveryTimeoutSecs == nil && endpoint.DeliveryMaxAttempts == nil && endpoint.BackoffType == nil &&
+		endpoint.BackoffBaseSecs == nil && endpoint.MaxBackoffSecs == nil && len(endpoint.BackoffScheduleSecs) == 0 {
+		return nil
+	}
+
+	deliveryPolicy := &front.DeliveryPolicy{
+		TimeoutSecs:     endpoint.DeliveryTimeoutSecs,
+		MaxAttempts:     endpoint.DeliveryMaxAttempts,
+		BackoffType:     endpoint.BackoffType,
+		BackoffBaseSecs: endpoint.BackoffBaseSecs,
+		MaxBackoffSecs:  endpoint.MaxBackoffSecs,
+	}
+	for _, delay := range endpoint.BackoffScheduleSecs {
+		deliveryPolicy.BackoffScheduleSecs = append(deliveryPolicy.BackoffScheduleSecs, uint(delay))
+	}
+
+	return deliveryPolicy
+}
+
+// sets the delivery policy overrides on the endpoint, returns an error
+// if the resulting backoff strategy is not valid
+func applyDeliveryPolicy(endpoint *models.Endpoint, deliveryPolicy *front.DeliveryPolicy) error {
+	endpoint.DeliveryTimeoutSecs = deliveryPolicy.TimeoutSecs
+	endpoint.DeliveryMaxAttempts = deliveryPolicy.MaxAttempts
+	endpoint.BackoffType = deliveryPolicy.BackoffType
+	endpoint.BackoffBaseSecs = deliveryPolicy.BackoffBaseSecs
+	endpoint.MaxBackoffSecs = deliveryPolicy.MaxBackoffSecs
+	endpoint.BackoffScheduleSecs = nil
+	for _, delay := range deliveryPolicy.BackoffScheduleSecs {
+		endpoint.BackoffScheduleSecs = append(endpoint.BackoffScheduleSecs, int64(delay))
+	}
+
+	if _, err := utils.GetDeliveryPolicy(*endpoint).BackoffStrategy(); err != nil {
+		return errors.New("Invalid delivery policy, " + err.Error())
+	}
+
+	return nil
+}
+
 func (s *frontsrvc) ListWebhookEndpoint(ctx context.Context, p *front.ListWebhookEndpointPayload) (res *front.ListWebhookEndpointResult, err error) {
 	s.logger.Debug().Interface("payload", p).Msg("front.ListWebhookEndpoint")
 
 	var webhookEndpointFoundList []models.Endpoint
 
-	query := s.db.Limit(int(p.Limit)).Offset(int(p.Offset))
+	query := s.db.Scopes(tenantScope(ctx)).Limit(int(p.Limit)).Offset(int(p.Offset))
 
 	// add additional where based on provided input
 	// metadata, for each key->value provided add to where query
@@ -202,18 +702,26 @@ func (s *frontsrvc) ListWebhookEndpoint(ctx context.Context, p *front.ListWebhoo
 
 	formattedResult := []*front.WebhookEndpointWithoutSecret{}
 	for _, element := range webhookEndpointFoundList {
+		// fields are referenced by pointer
+		element := element
+
 		// json to map
 		var metadataMapping map[string]string
 		json.Unmarshal([]byte(element.Metadata), &metadataMapping)
 
 		formattedResult = append(formattedResult, &front.WebhookEndpointWithoutSecret{
-			ID:            element.Id,
-			URL:           element.Url,
-			EnabledEvents: element.EnabledEvents,
-			Metadata:      metadataMapping,
-			Status:        &element.Status,
-			CreatedAt:     element.CreatedAt,
-			UpdatedAt:     element.UpdatedAt,
+			ID:              element.Id,
+			URL:             element.Url,
+			EnabledEvents:   element.EnabledEvents,
+			Metadata:        metadataMapping,
+			Headers:         utils.MaskHeaders(element.HeadersEncrypted),
+			DeliveryPolicy:  formatDeliveryPolicy(element),
+			Status:          &element.Status,
+			DisabledReason:  element.DisabledReason,
+			CircuitState:    &element.CircuitState,
+			CircuitOpenedAt: element.CircuitOpenedAt,
+			CreatedAt:       element.CreatedAt,
+			UpdatedAt:       element.UpdatedAt,
 		})
 	}
 
@@ -228,7 +736,7 @@ func (s *frontsrvc) GetWebhookEndpointByID(ctx context.Context, p *front.GetWebh
 	s.logger.Debug().Interface("payload", p).Msg("front.getWebhookEndpointById")
 
 	var webhookEndpointFound models.Endpoint
-	result := s.db.First(&webhookEndpointFound, "id = ?", p.ID)
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
 
 	if result.Error != nil {
 		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
@@ -254,14 +762,19 @@ func (s *frontsrvc) GetWebhookEndpointByID(ctx context.Context, p *front.GetWebh
 	json.Unmarshal([]byte(webhookEndpointFound.Metadata), &metadataMapping)
 
 	res = &front.WebhookEndpoint{
-		ID:            webhookEndpointFound.Id,
-		Secret:        string(decryptedSecret),
-		URL:           webhookEndpointFound.Url,
-		EnabledEvents: webhookEndpointFound.EnabledEvents,
-		Metadata:      metadataMapping,
-		Status:        &webhookEndpointFound.Status,
-		CreatedAt:     webhookEndpointFound.CreatedAt,
-		UpdatedAt:     webhookEndpointFound.UpdatedAt,
+		ID:              webhookEndpointFound.Id,
+		Secret:          string(decryptedSecret),
+		URL:             webhookEndpointFound.Url,
+		EnabledEvents:   webhookEndpointFound.EnabledEvents,
+		Metadata:        metadataMapping,
+		Headers:         utils.MaskHeaders(webhookEndpointFound.HeadersEncrypted),
+		DeliveryPolicy:  formatDeliveryPolicy(webhookEndpointFound),
+		Status:          &webhookEndpointFound.Status,
+		DisabledReason:  webhookEndpointFound.DisabledReason,
+		CircuitState:    &webhookEndpointFound.CircuitState,
+		CircuitOpenedAt: webhookEndpointFound.CircuitOpenedAt,
+		CreatedAt:       webhookEndpointFound.CreatedAt,
+		UpdatedAt:       webhookEndpointFound.UpdatedAt,
 	}
 	return res, nil
 }
@@ -270,15 +783,54 @@ func (s *frontsrvc) GetWebhookEndpointByID(ctx context.Context, p *front.GetWebh
 func (s *frontsrvc) JWTAuth(ctx context.Context, token string, scheme *security.JWTScheme) (context.Context, error) {
 	s.logger.Debug().Str("token", token).Interface("scheme", scheme).Msg("called jwt auth")
 
-	apiKeyFound, err := s.findApiKey(token)
+	var auth authInfo
+	var scopes []string
 
-	if err != nil {
-		return ctx, goa.PermanentError("unauthorized", "invalid token")
+	if strings.HasPrefix(token, constants.ZEBRAHOOK_API_KEY_PREFIX) || s.jwtVerifier == nil {
+		apiKeyFound, err := s.findApiKey(token)
+
+		if err != nil {
+			return ctx, goa.PermanentError("unauthorized", "invalid token")
+		}
+
+		// keep track of the last usage, updated_at is left untouched
+		s.db.Model(&models.ApiKey{}).Where("id = ?", apiKeyFound.Id).UpdateColumn("last_used_at", time.Now().Unix())
+
+		auth = authInfo{
+			tenantId: apiKeyFound.TenantID,
+			apiKeyId: apiKeyFound.Id,
+		}
+		scopes = apiKeyFound.Scopes
+	} else {
+		claims, err := s.jwtVerifier.parse(token)
+
+		if err != nil {
+			s.logger.Debug().Err(err).Msg("invalid jwt token")
+			return ctx, goa.PermanentError("unauthorized", "invalid token")
+		}
+
+		auth = authInfo{
+			tenantId: claims.tenantId,
+			subject:  claims.subject,
+		}
+		scopes = claims.scopes
 	}
 
-	ctx = contextWithAuthInfo(ctx, authInfo{
-		userId: "utestinguser_" + fmt.Sprint(apiKeyFound.ID),
-	})
+	// `*` grants all the scopes declared by the security scheme
+	grantedScopes := scopes
+	for _, scope := range scopes {
+		if scope == constants.ScopeAll {
+			grantedScopes = scheme.Scopes
+			break
+		}
+	}
+
+	if err := scheme.Validate(grantedScopes); err != nil {
+		return ctx, goa.PermanentError("forbidden", err.Error())
+	}
+
+	auth.scopes = grantedScopes
+	ctx = contextWithAuthInfo(ctx, auth)
 
 	return ctx, nil
 
@@ -301,12 +853,31 @@ func (s *frontsrvc) JWTAuth(ctx context.Context, token string, scheme *security.
 
 // Allows to register a new webhook URL with the specified enabled events
 func (s *frontsrvc) Register(ctx context.Context, p *front.RegisterPayload) (res *front.WebhookIDAndSecret, err error) {
-	s.logger.Debug().Interface("payload", p).Msg("front.register")
+	// header values are secrets, only their names are logged
+	loggedPayload := *p
+	loggedPayload.Headers = utils.MaskHeaderValues(p.Headers)
+	s.logger.Debug().Interface("payload", loggedPayload).Msg("front.register")
 
 	authInfo := contextAuthInfo(ctx)
 
 	s.logger.Debug().Interface("authInfo", authInfo).Msg("")
 
+	egressPolicy, err := utils.NewEgressPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if err := egressPolicy.CheckURL(p.URL, true); err != nil {
+		return nil, errors.New("Webhook url not allowed, " + err.Error())
+	}
+
+	if err := utils.ValidateCustomHeaders(p.Headers); err != nil {
+		return nil, err
+	}
+	headersEncrypted, err := utils.EncryptHeaders(p.Headers)
+	if err != nil {
+		return nil, err
+	}
+
 	// generate new webhook identifier
 	guid := xid.New()
 	newWebhookId := constants.ZEBRAHOOK_ID_WEBHOOK_ENDPOINT_PREFIX + guid.String()
@@ -316,19 +887,27 @@ func (s *frontsrvc) Register(ctx context.Context, p *front.RegisterPayload) (res
 	webhookSecret := constants.ZEBRAHOOK_ID_WEBHOOK_SECRET_PREFIX + utils.GenerateRandomString(30)
 
 	// encrypt secret, this is stored on our side
-	encryptKeyStr := viper.GetString("encryptionKey")
-	var encryptionKey [32]byte
-	copy(encryptionKey[:], encryptKeyStr)
-	webhookSecretEncrypted, _ := cryptopasta.Encrypt([]byte(webhookSecret), &encryptionKey)
-	webhookSecretEncryptedHex := hex.EncodeToString(webhookSecretEncrypted)
+	webhookSecretEncryptedHex, err := utils.EncryptSecret(webhookSecret)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to encrypt webhook secret")
+		return nil, errors.New("error while registering webhook endpoint")
+	}
 
 	// create new endpoint instance
 	newEndpointToCreate := &models.Endpoint{
-		Id:              newWebhookId,
-		Url:             p.URL,
-		SecretEncrypted: webhookSecretEncryptedHex,
-		EnabledEvents:   p.EnabledEvents,
-		Status:          constants.StatusEnabled,
+		Id:               newWebhookId,
+		Url:              p.URL,
+		SecretEncrypted:  webhookSecretEncryptedHex,
+		EnabledEvents:    p.EnabledEvents,
+		Status:           constants.StatusEnabled,
+		TenantID:         authInfo.tenantId,
+		HeadersEncrypted: headersEncrypted,
+	}
+
+	if p.DeliveryPolicy != nil {
+		if err := applyDeliveryPolicy(newEndpointToCreate, p.DeliveryPolicy); err != nil {
+			return nil, err
+		}
 	}
 
 	// encode map to json
@@ -350,12 +929,23 @@ func (s *frontsrvc) Register(ctx context.Context, p *front.RegisterPayload) (res
 }
 
 func (s *frontsrvc) Update(ctx context.Context, p *front.UpdatePayload) (res *front.UpdateResult, err error) {
-	s.logger.Debug().Interface("payload", p).Msg("front.update")
+	// header values are secrets, only their names are logged
+	loggedPayload := *p
+	loggedPayload.Headers = utils.MaskHeaderValues(p.Headers)
+	s.logger.Debug().Interface("payload", loggedPayload).Msg("front.update")
 
 	authInfo := contextAuthInfo(ctx)
 
 	s.logger.Debug().Interface("authInfo", authInfo).Msg("")
 
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
 	// add update based on input provided
 	endpointContent := models.Endpoint{}
 
@@ -368,17 +958,59 @@ func (s *frontsrvc) Update(ctx context.Context, p *front.UpdatePayload) (res *fr
 		endpointContent.Metadata = jsonMetadata
 	}
 
+	// status changes are recorded in the endpoint status history
+	var newStatusToUse string
 	if p.Disabled != nil {
-		var newStatusToUse string
 		if *p.Disabled {
 			newStatusToUse = constants.StatusDisabled
 		} else {
 			newStatusToUse = constants.StatusEnabled
 		}
-		endpointContent.Status = newStatusToUse
 	}
 
-	s.db.Model(models.Endpoint{}).Where("id = ?", p.ID).Updates(endpointContent)
+	// headers are replaced, an empty map removes them
+	var headersEncrypted *string
+	if p.Headers != nil {
+		if err := utils.ValidateCustomHeaders(p.Headers); err != nil {
+			return nil, err
+		}
+		headersEncrypted, err = utils.EncryptHeaders(p.Headers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var deliveryPolicyEndpoint models.Endpoint
+	if p.DeliveryPolicy != nil {
+		if err := applyDeliveryPolicy(&deliveryPolicyEndpoint, p.DeliveryPolicy); err != nil {
+			return nil, err
+		}
+	}
+
+	s.db.Model(models.Endpoint{}).Where("id = ?", webhookEndpointFound.Id).Updates(endpointContent)
+
+	if p.Headers != nil {
+		s.db.Model(models.Endpoint{}).Where("id = ?", webhookEndpointFound.Id).Update("headers_encrypted", headersEncrypted)
+	}
+
+	if newStatusToUse != "" {
+		if _, err := utils.SetEndpointStatus(s.db, webhookEndpointFound.Id, authInfo.endpointStatusChange(newStatusToUse, constants.StatusReasonManual)); err != nil {
+			s.logger.Error().Stack().Err(err).Str("endpointId", webhookEndpointFound.Id).Msg("unable to update webhook endpoint status")
+			return nil, errors.New("error while updating webhook endpoint status")
+		}
+	}
+
+	// delivery policy is replaced, settings not provided fall back to the global configuration
+	if p.DeliveryPolicy != nil {
+		s.db.Model(models.Endpoint{}).Where("id = ?", webhookEndpointFound.Id).Updates(map[string]interface{}{
+			"delivery_timeout_secs": deliveryPolicyEndpoint.DeliveryTimeoutSecs,
+			"delivery_max_attempts": deliveryPolicyEndpoint.DeliveryMaxAttempts,
+			"backoff_type":          deliveryPolicyEndpoint.BackoffType,
+			"backoff_base_secs":     deliveryPolicyEndpoint.BackoffBaseSecs,
+			"max_backoff_secs":      deliveryPolicyEndpoint.MaxBackoffSecs,
+			"backoff_schedule_secs": deliveryPolicyEndpoint.BackoffScheduleSecs,
+		})
+	}
 
 	success := true
 	res = &front.UpdateResult{
@@ -387,3 +1019,1358 @@ func (s *frontsrvc) Update(ctx context.Context, p *front.UpdatePayload) (res *fr
 
 	return res, nil
 }
+
+// Allows to delete a webhook endpoint, the endpoint is soft deleted and
+// all the deliveries not yet completed (pending or held) are cancelled
+func (s *frontsrvc) DeleteWebhookEndpoint(ctx context.Context, p *front.DeleteWebhookEndpointPayload) (res *front.DeleteWebhookEndpointResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.deleteWebhookEndpoint")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	// held deliveries (circuit open) are cancelled as well
+	openDeliveryStatuses := []string{constants.DeliveryStatusPending, constants.DeliveryStatusHeld}
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// remove queued delivery and circuit probe jobs that have not run yet
+		// for this endpoint, job data is the json encoded dispatcher job
+		if err := tx.Exec(`DELETE FROM pgq_jobs
+		WHERE queue_name IN ? AND ran_at IS NULL
+		AND convert_from(data, 'UTF8')::json->>'EndpointId' = ?`, []string{constants.QueueWebhookDelivery, constants.QueueCircuitProbe}, p.ID).Error; err != nil {
+			return err
+		}
+
+		// cancel attempts not yet made
+		if err := tx.Model(&models.EventDeliveryAttempt{}).
+			Where("status = ? AND event_delivery_id IN (SELECT id FROM event_deliveries WHERE endpoint_id = ? AND status IN ?)", constants.AttemptStatusPending, p.ID, openDeliveryStatuses).
+			Update("status", constants.AttemptStatusCancelled).Error; err != nil {
+			return err
+		}
+
+		// cancel open deliveries
+		if err := tx.Model(&models.EventDelivery{}).
+			Where("endpoint_id = ? AND status IN ?", p.ID, openDeliveryStatuses).
+			Updates(map[string]interface{}{
+				"status":                    constants.DeliveryStatusCancelled,
+				"attempts_remaining":        0,
+				"next_attempt_scheduled_at": nil,
+			}).Error; err != nil {
+			return err
+		}
+
+		// soft delete endpoint (sets deleted_at)
+		return tx.Where("id = ?", p.ID).Delete(&models.Endpoint{}).Error
+	})
+
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Str("endpointId", p.ID).Msg("unable to delete webhook endpoint")
+		return nil, errors.New("error while deleting webhook endpoint " + p.ID)
+	}
+
+	s.logger.Info().Msg("deleted webhook endpoint " + p.ID)
+
+	success := true
+	res = &front.DeleteWebhookEndpointResult{
+		Success: &success,
+	}
+
+	return res, nil
+}
+
+func formatEvent(event models.Event) *front.Event {
+	// json to map
+	var eventContent map[string]interface{}
+	json.Unmarshal([]byte(event.EventContent), &eventContent)
+
+	return &front.Event{
+		ID:           event.Id,
+		EventType:    event.EventType,
+		EventContent: eventContent,
+		Priority:     event.Priority,
+		CreatedAt:    event.CreatedAt,
+	}
+}
+
+func (s *frontsrvc) ListEvents(ctx context.Context, p *front.ListEventsPayload) (res *front.ListEventsResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.listEvents")
+
+	var eventsFoundList []models.Event
+
+	// fetch one more item to know if there are more results
+	query := s.db.Scopes(tenantScope(ctx)).Order("id DESC").Limit(int(p.Limit) + 1)
+
+	// cursor based pagination
+	if p.StartingAfter != nil {
+		query = query.Where("id < ?", *p.StartingAfter)
+	}
+
+	if p.EventType != nil {
+		query = query.Where("event_type = ?", *p.EventType)
+	}
+	if p.Priority != nil {
+		query = query.Where("priority = ?", *p.Priority)
+	}
+	// date related
+	if p.CreatedAtGte != nil {
+		query = query.Where("created_at >= ?", *p.CreatedAtGte)
+	}
+	if p.CreatedAtLt != nil {
+		query = query.Where("created_at < ?", *p.CreatedAtLt)
+	}
+
+	// execute query
+	result := query.Find(&eventsFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying events")
+		return nil, err
+	}
+
+	s.logger.Debug().Msg(fmt.Sprintf("results found %d", len(eventsFoundList)))
+
+	hasMore := len(eventsFoundList) > int(p.Limit)
+	if hasMore {
+		eventsFoundList = eventsFoundList[:p.Limit]
+	}
+
+	formattedResult := []*front.Event{}
+	for _, element := range eventsFoundList {
+		formattedResult = append(formattedResult, formatEvent(element))
+	}
+
+	res = &front.ListEventsResult{
+		Result:  formattedResult,
+		HasMore: hasMore,
+	}
+
+	return res, nil
+}
+
+func (s *frontsrvc) GetEventByID(ctx context.Context, p *front.GetEventByIDPayload) (res *front.EventWithDeliveries, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.getEventById")
+
+	var eventFound models.Event
+	result := s.db.Scopes(tenantScope(ctx)).First(&eventFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Event identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	var eventDeliveries []models.EventDelivery
+	result = s.db.Order("id ASC").Find(&eventDeliveries, "event_id = ?", eventFound.Id)
+
+	if result.Error != nil {
+		err := errors.New("error while querying event deliveries")
+		return nil, err
+	}
+
+	// load endpoints of the deliveries, including deleted ones
+	endpointIds := []string{}
+	for _, element := range eventDeliveries {
+		endpointIds = append(endpointIds, element.EndpointID)
+	}
+	var endpointsFound []models.Endpoint
+	if len(endpointIds) > 0 {
+		s.db.Unscoped().Where("id IN ?", endpointIds).Find(&endpointsFound)
+	}
+	endpointUrls := map[string]string{}
+	for _, endpoint := range endpointsFound {
+		endpointUrls[endpoint.Id] = endpoint.Url
+	}
+
+	formattedDeliveries := []*front.EventDelivery{}
+	for _, element := range eventDeliveries {
+		formattedDelivery := formatEventDelivery(element)
+		if url, ok := endpointUrls[element.EndpointID]; ok {
+			formattedDelivery.EndpointURL = &url
+		}
+		formattedDeliveries = append(formattedDeliveries, formattedDelivery)
+	}
+
+	formattedEvent := formatEvent(eventFound)
+
+	res = &front.EventWithDeliveries{
+		ID:           formattedEvent.ID,
+		EventType:    formattedEvent.EventType,
+		EventContent: formattedEvent.EventContent,
+		Priority:     formattedEvent.Priority,
+		CreatedAt:    formattedEvent.CreatedAt,
+		Deliveries:   formattedDeliveries,
+	}
+
+	return res, nil
+}
+
+func formatEventDelivery(eventDelivery models.EventDelivery) *front.EventDelivery {
+	return &front.EventDelivery{
+		ID:                     eventDelivery.Id,
+		EndpointID:             eventDelivery.EndpointID,
+		Status:                 eventDelivery.Status,
+		AttemptsCounter:        eventDelivery.AttemptsCounter,
+		AttemptsRemaining:      eventDelivery.AttemptsRemaining,
+		NextAttemptScheduledAt: eventDelivery.NextAttemptScheduledAt,
+		CreatedAt:              eventDelivery.CreatedAt,
+		UpdatedAt:              eventDelivery.UpdatedAt,
+	}
+}
+
+// event delivery attempt joined with its delivery and event
+type endpointAttempt struct {
+	Id                   uint
+	EventDeliveryId      uint
+	EventId              uint
+	EventType            string
+	Status               string
+	AttemptMadeAt        *int64
+	HttpStatusCode       *int
+	HttpBodyResponse     *string
+	HttpResponseTimeSecs *float32
+	CreatedAt            int64
+}
+
+func (s *frontsrvc) ListWebhookEndpointAttempts(ctx context.Context, p *front.ListWebhookEndpointAttemptsPayload) (res *front.ListWebhookEndpointAttemptsResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.listWebhookEndpointAttempts")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	var attemptsFoundList []endpointAttempt
+
+	// fetch one more item to know if there are more results
+	query := s.db.Table("event_delivery_attempts AS a").
+		Select(`a.id, a.event_delivery_id, d.event_id, e.event_type, a.status, a.attempt_made_at,
+		a.http_status_code, a.http_body_response, a.http_response_time_secs, a.created_at`).
+		Joins("JOIN event_deliveries d ON d.id = a.event_delivery_id").
+		Joins("JOIN events e ON e.id = d.event_id").
+		Where("d.endpoint_id = ?", p.ID).
+		Order("a.id DESC").
+		Limit(int(p.Limit) + 1)
+
+	// cursor based pagination
+	if p.StartingAfter != nil {
+		query = query.Where("a.id < ?", *p.StartingAfter)
+	}
+
+	if p.Status != nil {
+		query = query.Where("a.status = ?", *p.Status)
+	}
+	if p.EventType != nil {
+		query = query.Where("e.event_type = ?", *p.EventType)
+	}
+	// date related
+	if p.CreatedAtGte != nil {
+		query = query.Where("a.created_at >= ?", *p.CreatedAtGte)
+	}
+	if p.CreatedAtLt != nil {
+		query = query.Where("a.created_at < ?", *p.CreatedAtLt)
+	}
+
+	// execute query
+	result = query.Scan(&attemptsFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying webhook endpoint attempts")
+		return nil, err
+	}
+
+	s.logger.Debug().Msg(fmt.Sprintf("results found %d", len(attemptsFoundList)))
+
+	hasMore := len(attemptsFoundList) > int(p.Limit)
+	if hasMore {
+		attemptsFoundList = attemptsFoundList[:p.Limit]
+	}
+
+	formattedResult := []*front.EventDeliveryAttempt{}
+	for _, element := range attemptsFoundList {
+		formattedResult = append(formattedResult, &front.EventDeliveryAttempt{
+			ID:                   element.Id,
+			EventDeliveryID:      element.EventDeliveryId,
+			EventID:              element.EventId,
+			EventType:            element.EventType,
+			Status:               element.Status,
+			AttemptMadeAt:        element.AttemptMadeAt,
+			HTTPStatusCode:       element.HttpStatusCode,
+			HTTPBodyResponse:     element.HttpBodyResponse,
+			HTTPResponseTimeSecs: element.HttpResponseTimeSecs,
+			CreatedAt:            element.CreatedAt,
+		})
+	}
+
+	res = &front.ListWebhookEndpointAttemptsResult{
+		Result:  formattedResult,
+		HasMore: hasMore,
+	}
+
+	return res, nil
+}
+
+// Allows to deliver again an event to a webhook endpoint, a fresh event delivery
+// is created regardless of the previous delivery outcome and endpoint status
+func (s *frontsrvc) RetryEventDelivery(ctx context.Context, p *front.RetryEventDeliveryPayload) (res *front.EventDelivery, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.retryEventDelivery")
+
+	var eventFound models.Event
+	result := s.db.Scopes(tenantScope(ctx)).First(&eventFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Event identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	// deleted endpoints are not returned
+	var webhookEndpointFound models.Endpoint
+	result = s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.EndpointID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.EndpointID + " not found")
+		return nil, err
+	}
+
+	// the event must have been delivered to this endpoint before
+	var previousEventDelivery models.EventDelivery
+	result = s.db.First(&previousEventDelivery, "event_id = ? AND endpoint_id = ?", eventFound.Id, webhookEndpointFound.Id)
+
+	if result.Error != nil {
+		err := errors.New("Event " + fmt.Sprint(p.ID) + " was never delivered to webhook endpoint " + p.EndpointID)
+		return nil, err
+	}
+
+	rawDb, _ := s.db.DB()
+	worker := pgq.NewWorker(rawDb)
+
+	var eventDeliveries []models.EventDelivery
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		eventDeliveries, err = eventMapping.CreateEventDeliveries(tx, worker, *s.logger, eventFound.Id, []string{webhookEndpointFound.Id})
+		if err != nil {
+			return err
+		}
+
+		return eventMapping.ResolveDeadLetters(tx, eventDeliveries[0])
+	})
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to create event delivery")
+		return nil, errors.New("error while creating event delivery")
+	}
+
+	s.logger.Info().Uint("eventId", eventFound.Id).Str("endpointId", webhookEndpointFound.Id).Msg("event delivery retry enqueued")
+
+	return formatEventDelivery(eventDeliveries[0]), nil
+}
+
+func formatEndpointRecovery(recovery models.EndpointRecovery) *front.EndpointRecovery {
+	return &front.EndpointRecovery{
+		ID:                 recovery.Id,
+		EndpointID:         recovery.EndpointID,
+		Since:              recovery.Since,
+		Status:             recovery.Status,
+		DeliveriesFound:    recovery.DeliveriesFound,
+		DeliveriesEnqueued: recovery.DeliveriesEnqueued,
+		CreatedAt:          recovery.CreatedAt,
+		UpdatedAt:          recovery.UpdatedAt,
+	}
+}
+
+// Allows to deliver again all the failed event deliveries of a webhook endpoint,
+// the actual work is done asynchronously by the event mapping worker
+func (s *frontsrvc) RecoverWebhookEndpoint(ctx context.Context, p *front.RecoverWebhookEndpointPayload) (res *front.EndpointRecovery, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.recoverWebhookEndpoint")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	// re-enable endpoint so that it can receive new events too
+	if webhookEndpointFound.Status != constants.StatusEnabled {
+		s.logger.Info().Str("endpointId", p.ID).Msg("enabling webhook endpoint before recovery")
+		if _, err := utils.SetEndpointStatus(s.db, p.ID, contextAuthInfo(ctx).endpointStatusChange(constants.StatusEnabled, constants.StatusReasonRecovery)); err != nil {
+			s.logger.Error().Stack().Err(err).Str("endpointId", p.ID).Msg("unable to enable webhook endpoint")
+			return nil, errors.New("error while enabling webhook endpoint")
+		}
+	}
+
+	// generate new recovery identifier
+	guid := xid.New()
+	newRecoveryToCreate := models.EndpointRecovery{
+		Id:         constants.ZEBRAHOOK_ID_ENDPOINT_RECOVERY_PREFIX + guid.String(),
+		EndpointID: p.ID,
+		Since:      p.Since,
+		Status:     constants.RecoveryStatusPending,
+	}
+
+	rawDb, _ := s.db.DB()
+	worker := pgq.NewWorker(rawDb)
+
+	// create the recovery and its job in one transaction, a recovery
+	// is never left pending without job
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, err := database.SqlTx(tx)
+		if err != nil {
+			return err
+		}
+
+		if result := tx.Create(&newRecoveryToCreate); result.Error != nil {
+			return result.Error
+		}
+
+		// partial recoveries must not be retried automatically
+		encodedRecovery, _ := json.Marshal(eventMapping.EndpointRecovery{
+			RecoveryId: newRecoveryToCreate.Id,
+		})
+		_, err = worker.EnqueueJobInTx(sqlTx, constants.QueueEndpointRecovery, encodedRecovery, pgq.RetryWaits([]time.Duration{}))
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to create and enqueue endpoint recovery")
+		return nil, errors.New("error while creating webhook endpoint recovery")
+	}
+
+	s.logger.Info().Str("endpointId", p.ID).Msg("enqueued webhook endpoint recovery " + newRecoveryToCreate.Id)
+
+	return formatEndpointRecovery(newRecoveryToCreate), nil
+}
+
+func (s *frontsrvc) GetWebhookEndpointRecovery(ctx context.Context, p *front.GetWebhookEndpointRecoveryPayload) (res *front.EndpointRecovery, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.getWebhookEndpointRecovery")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	var recoveryFound models.EndpointRecovery
+	result = s.db.First(&recoveryFound, "id = ? AND endpoint_id = ?", p.RecoveryID, webhookEndpointFound.Id)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint recovery identifier " + p.RecoveryID + " not found")
+		return nil, err
+	}
+
+	return formatEndpointRecovery(recoveryFound), nil
+}
+
+// Allows to list the status changes of a webhook endpoint, most recent first
+func (s *frontsrvc) GetWebhookEndpointStatusHistory(ctx context.Context, p *front.GetWebhookEndpointStatusHistoryPayload) (res *front.GetWebhookEndpointStatusHistoryResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.getWebhookEndpointStatusHistory")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	var statusEventsFoundList []models.EndpointStatusEvent
+
+	// fetch one more item to know if there are more results
+	query := s.db.Where("endpoint_id = ?", p.ID).Order("id DESC").Limit(int(p.Limit) + 1)
+
+	// cursor based pagination
+	if p.StartingAfter != nil {
+		query = query.Where("id < ?", *p.StartingAfter)
+	}
+
+	result = query.Find(&statusEventsFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying webhook endpoint status history")
+		return nil, err
+	}
+
+	hasMore := len(statusEventsFoundList) > int(p.Limit)
+	if hasMore {
+		statusEventsFoundList = statusEventsFoundList[:p.Limit]
+	}
+
+	formattedResult := []*front.EndpointStatusEvent{}
+	for _, element := range statusEventsFoundList {
+		formattedResult = append(formattedResult, &front.EndpointStatusEvent{
+			ID:            element.Id,
+			OldStatus:     element.OldStatus,
+			NewStatus:     element.NewStatus,
+			Reason:        element.Reason,
+			ActorAPIKeyID: element.ActorApiKeyID,
+			ActorSubject:  element.ActorSubject,
+			CreatedAt:     element.CreatedAt,
+		})
+	}
+
+	res = &front.GetWebhookEndpointStatusHistoryResult{
+		Result:  formattedResult,
+		HasMore: hasMore,
+	}
+
+	return res, nil
+}
+
+// Allows to retrieve the delivery stats of a webhook endpoint over a time window,
+// computed from the stats rollup table maintained by the event mapping worker
+func (s *frontsrvc) GetWebhookEndpointStats(ctx context.Context, p *front.GetWebhookEndpointStatsPayload) (res *front.EndpointStats, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.getWebhookEndpointStats")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	windowSecs, found := utils.StatsWindows[p.Window]
+	if !found {
+		err := errors.New("Invalid stats window " + p.Window)
+		return nil, err
+	}
+
+	to := time.Now().Unix()
+	from := to - windowSecs
+	from -= from % utils.StatsBucketSecs
+
+	var rollupsFoundList []models.EndpointStatsRollup
+	result = s.db.Where("endpoint_id = ? AND bucket_start >= ?", p.ID, from).Find(&rollupsFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying webhook endpoint stats")
+		return nil, err
+	}
+
+	stats := utils.MergeStatsRollups(rollupsFoundList)
+
+	res = &front.EndpointStats{
+		EndpointID:      p.ID,
+		Window:          p.Window,
+		From:            from,
+		To:              to,
+		Attempts:        stats.Attempts,
+		SuccessRate:     stats.SuccessRate(),
+		StatusCounts:    stats.StatusCounts,
+		HTTPStatusCodes: stats.HttpStatusCounts,
+	}
+
+	if stats.ResponseTimes > 0 {
+		res.ResponseTimeSecs = &front.ResponseTimePercentiles{
+			P50: *stats.ResponseTimePercentile(50),
+			P95: *stats.ResponseTimePercentile(95),
+			P99: *stats.ResponseTimePercentile(99),
+		}
+	}
+
+	return res, nil
+}
+
+func formatDeadLetter(deadLetter models.DeadLetter) *front.DeadLetter {
+	return &front.DeadLetter{
+		ID:                    deadLetter.Id,
+		EventDeliveryID:       deadLetter.EventDeliveryID,
+		EventID:               deadLetter.EventID,
+		EventType:             deadLetter.EventType,
+		EndpointID:            deadLetter.EndpointID,
+		Reason:                deadLetter.Reason,
+		AttemptsCounter:       deadLetter.AttemptsCounter,
+		LastAttemptStatus:     deadLetter.LastAttemptStatus,
+		LastHTTPStatusCode:    deadLetter.LastHttpStatusCode,
+		LastAttemptMadeAt:     deadLetter.LastAttemptMadeAt,
+		Status:                deadLetter.Status,
+		ReplayEventDeliveryID: deadLetter.ReplayEventDeliveryID,
+		ReplayedAt:            deadLetter.ReplayedAt,
+		CreatedAt:             deadLetter.CreatedAt,
+	}
+}
+
+// Allows to list the event deliveries that failed without more attempts, most recent first
+func (s *frontsrvc) ListDeadLetters(ctx context.Context, p *front.ListDeadLettersPayload) (res *front.ListDeadLettersResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.listDeadLetters")
+
+	var deadLettersFoundList []models.DeadLetter
+
+	// fetch one more item to know if there are more results
+	query := s.db.Scopes(tenantScope(ctx)).Where("status = ?", p.Status).Order("id DESC").Limit(int(p.Limit) + 1)
+
+	// cursor based pagination
+	if p.StartingAfter != nil {
+		query = query.Where("id < ?", *p.StartingAfter)
+	}
+
+	if p.EndpointID != nil {
+		query = query.Where("endpoint_id = ?", *p.EndpointID)
+	}
+	if p.EventType != nil {
+		query = query.Where("event_type = ?", *p.EventType)
+	}
+	// date related
+	if p.CreatedAtGte != nil {
+		query = query.Where("created_at >= ?", *p.CreatedAtGte)
+	}
+	if p.CreatedAtLt != nil {
+		query = query.Where("created_at < ?", *p.CreatedAtLt)
+	}
+
+	result := query.Find(&deadLettersFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying dead letters")
+		return nil, err
+	}
+
+	hasMore := len(deadLettersFoundList) > int(p.Limit)
+	if hasMore {
+		deadLettersFoundList = deadLettersFoundList[:p.Limit]
+	}
+
+	formattedResult := []*front.DeadLetter{}
+	for _, element := range deadLettersFoundList {
+		formattedResult = append(formattedResult, formatDeadLetter(element))
+	}
+
+	res = &front.ListDeadLettersResult{
+		Result:  formattedResult,
+		HasMore: hasMore,
+	}
+
+	return res, nil
+}
+
+// Allows to inspect a dead letter with its event and the last endpoint response
+func (s *frontsrvc) GetDeadLetter(ctx context.Context, p *front.GetDeadLetterPayload) (res *front.DeadLetterDetails, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.getDeadLetter")
+
+	var deadLetterFound models.DeadLetter
+	result := s.db.Scopes(tenantScope(ctx)).First(&deadLetterFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Dead letter identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	var eventFound models.Event
+	result = s.db.First(&eventFound, "id = ?", deadLetterFound.EventID)
+
+	if result.Error != nil {
+		err := errors.New("Event identifier " + fmt.Sprint(deadLetterFound.EventID) + " not found")
+		return nil, err
+	}
+
+	formattedDeadLetter := formatDeadLetter(deadLetterFound)
+
+	res = &front.DeadLetterDetails{
+		ID:                    formattedDeadLetter.ID,
+		EventDeliveryID:       formattedDeadLetter.EventDeliveryID,
+		EventID:               formattedDeadLetter.EventID,
+		EventType:             formattedDeadLetter.EventType,
+		EndpointID:            formattedDeadLetter.EndpointID,
+		Reason:                formattedDeadLetter.Reason,
+		AttemptsCounter:       formattedDeadLetter.AttemptsCounter,
+		LastAttemptStatus:     formattedDeadLetter.LastAttemptStatus,
+		LastHTTPStatusCode:    formattedDeadLetter.LastHTTPStatusCode,
+		LastHTTPBodyResponse:  deadLetterFound.LastHttpBodyResponse,
+		LastAttemptMadeAt:     formattedDeadLetter.LastAttemptMadeAt,
+		Status:                formattedDeadLetter.Status,
+		ReplayEventDeliveryID: formattedDeadLetter.ReplayEventDeliveryID,
+		ReplayedAt:            formattedDeadLetter.ReplayedAt,
+		CreatedAt:             formattedDeadLetter.CreatedAt,
+		Event:                 formatEvent(eventFound),
+	}
+
+	return res, nil
+}
+
+// returned when a dead letter has already been replayed (e.g. by a concurrent replay)
+var errDeadLetterReplayed = errors.New("dead letter already replayed")
+
+// delivers again the event of the dead letter to its endpoint (even if disabled)
+// and returns the dead letter marked as replayed
+func (s *frontsrvc) replayDeadLetter(worker *pgq.Worker, deadLetter models.DeadLetter) (models.DeadLetter, error) {
+	// deleted endpoints are not returned
+	var webhookEndpointFound models.Endpoint
+	result := s.db.First(&webhookEndpointFound, "id = ?", deadLetter.EndpointID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + deadLetter.EndpointID + " not found")
+		return deadLetter, err
+	}
+
+	var eventDeliveries []models.EventDelivery
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// claim the dead letter first, concurrent replays don't deliver the event twice
+		result := tx.Model(&models.DeadLetter{}).
+			Where("id = ? AND status = ?", deadLetter.Id, constants.DeadLetterStatusDead).
+			Updates(map[string]interface{}{
+				"status":      constants.DeadLetterStatusReplayed,
+				"replayed_at": time.Now().Unix(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return errDeadLetterReplayed
+		}
+
+		var err error
+		eventDeliveries, err = eventMapping.CreateEventDeliveries(tx, worker, *s.logger, deadLetter.EventID, []string{webhookEndpointFound.Id})
+		if err != nil {
+			return err
+		}
+
+		result = tx.Model(&models.DeadLetter{}).Where("id = ?", deadLetter.Id).Update("replay_event_delivery_id", eventDeliveries[0].Id)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// other dead letters of the same event to the same endpoint
+		return eventMapping.ResolveDeadLetters(tx, eventDeliveries[0])
+	})
+	if err == errDeadLetterReplayed {
+		return deadLetter, err
+	}
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Uint("deadLetterId", deadLetter.Id).Msg("unable to create event delivery")
+		return deadLetter, errors.New("error while creating event delivery")
+	}
+
+	s.logger.Info().Uint("deadLetterId", deadLetter.Id).Uint("eventDeliveryId", eventDeliveries[0].Id).Msg("dead letter replayed")
+
+	s.db.First(&deadLetter, "id = ?", deadLetter.Id)
+
+	return deadLetter, nil
+}
+
+// Allows to deliver again the event of a dead letter to its webhook endpoint
+func (s *frontsrvc) ReplayDeadLetter(ctx context.Context, p *front.ReplayDeadLetterPayload) (res *front.DeadLetter, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.replayDeadLetter")
+
+	var deadLetterFound models.DeadLetter
+	result := s.db.Scopes(tenantScope(ctx)).First(&deadLetterFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Dead letter identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	rawDb, _ := s.db.DB()
+	worker := pgq.NewWorker(rawDb)
+
+	deadLetterReplayed, err := s.replayDeadLetter(worker, deadLetterFound)
+	if err == errDeadLetterReplayed {
+		err := errors.New("Dead letter " + fmt.Sprint(p.ID) + " has already been replayed")
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return formatDeadLetter(deadLetterReplayed), nil
+}
+
+// Allows to deliver again the events of the dead letters matching the filters,
+// oldest first, in batches of `limit`
+func (s *frontsrvc) ReplayDeadLetters(ctx context.Context, p *front.ReplayDeadLettersPayload) (res *front.ReplayDeadLettersResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.replayDeadLetters")
+
+	var deadLettersFoundList []models.DeadLetter
+
+	// dead letters of deleted endpoints can't be replayed,
+	// fetch one more item to know if there are more results
+	query := s.db.Scopes(tenantScope(ctx)).
+		Where("status = ?", constants.DeadLetterStatusDead).
+		Where("endpoint_id IN (?)", s.db.Model(&models.Endpoint{}).Select("id")).
+		Order("id ASC").Limit(int(p.Limit) + 1)
+
+	if len(p.Ids) > 0 {
+		query = query.Where("id IN ?", p.Ids)
+	}
+	if p.EndpointID != nil {
+		query = query.Where("endpoint_id = ?", *p.EndpointID)
+	}
+	if p.EventType != nil {
+		query = query.Where("event_type = ?", *p.EventType)
+	}
+	// date related
+	if p.CreatedAtGte != nil {
+		query = query.Where("created_at >= ?", *p.CreatedAtGte)
+	}
+	if p.CreatedAtLt != nil {
+		query = query.Where("created_at < ?", *p.CreatedAtLt)
+	}
+
+	result := query.Find(&deadLettersFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying dead letters")
+		return nil, err
+	}
+
+	hasMore := len(deadLettersFoundList) > int(p.Limit)
+	if hasMore {
+		deadLettersFoundList = deadLettersFoundList[:p.Limit]
+	}
+
+	rawDb, _ := s.db.DB()
+	worker := pgq.NewWorker(rawDb)
+
+	formattedResult := []*front.DeadLetter{}
+	for _, element := range deadLettersFoundList {
+		deadLetterReplayed, err := s.replayDeadLetter(worker, element)
+
+		// already replayed concurrently or together with a previous
+		// dead letter of the same event to the same endpoint
+		if err == errDeadLetterReplayed {
+			deadLetterReplayed = models.DeadLetter{}
+			s.db.First(&deadLetterReplayed, "id = ?", element.Id)
+		} else if err != nil {
+			return nil, err
+		}
+
+		formattedResult = append(formattedResult, formatDeadLetter(deadLetterReplayed))
+	}
+
+	res = &front.ReplayDeadLettersResult{
+		Result:  formattedResult,
+		HasMore: hasMore,
+	}
+
+	return res, nil
+}
+
+// Allows to permanently remove a dead letter
+func (s *frontsrvc) DeleteDeadLetter(ctx context.Context, p *front.DeleteDeadLetterPayload) (res *front.DeleteDeadLetterResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.deleteDeadLetter")
+
+	result := s.db.Unscoped().Scopes(tenantScope(ctx)).Where("id = ?", p.ID).Delete(&models.DeadLetter{})
+
+	if result.Error != nil || result.RowsAffected == 0 {
+		err := errors.New("Dead letter identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	success := true
+	res = &front.DeleteDeadLetterResult{
+		Success: &success,
+	}
+
+	return res, nil
+}
+
+// Allows to permanently remove the dead letters matching the filters
+func (s *frontsrvc) PurgeDeadLetters(ctx context.Context, p *front.PurgeDeadLettersPayload) (res *front.PurgeDeadLettersResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.purgeDeadLetters")
+
+	query := s.db.Unscoped().Scopes(tenantScope(ctx))
+
+	if p.Status != nil {
+		query = query.Where("status = ?", *p.Status)
+	}
+	if p.EndpointID != nil {
+		query = query.Where("endpoint_id = ?", *p.EndpointID)
+	}
+	if p.EventType != nil {
+		query = query.Where("event_type = ?", *p.EventType)
+	}
+	if p.CreatedAtLt != nil {
+		query = query.Where("created_at < ?", *p.CreatedAtLt)
+	}
+
+	result := query.Delete(&models.DeadLetter{})
+
+	if result.Error != nil {
+		err := errors.New("error while purging dead letters")
+		return nil, err
+	}
+
+	s.logger.Info().Int64("purged", result.RowsAffected).Msg("dead letters purged")
+
+	res = &front.PurgeDeadLettersResult{
+		Purged: result.RowsAffected,
+	}
+
+	return res, nil
+}
+
+// Allows to rotate the secret of a webhook endpoint, the current secret becomes
+// the previous one and is still used to sign events until the grace period ends
+func (s *frontsrvc) RotateWebhookEndpointSecret(ctx context.Context, p *front.RotateWebhookEndpointSecretPayload) (res *front.RotateWebhookEndpointSecretResult, err error) {
+	s.logger.Debug().Str("id", p.ID).Msg("front.rotateWebhookEndpointSecret")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	gracePeriodSecs := viper.GetUint("webhookSecret.rotationGracePeriodSecs")
+	if p.GracePeriodSecs != nil {
+		gracePeriodSecs = *p.GracePeriodSecs
+	}
+
+	// generate new webhook secret token, this is returned to the
+	// client in clear text
+	webhookSecret := constants.ZEBRAHOOK_ID_WEBHOOK_SECRET_PREFIX + utils.GenerateRandomString(30)
+
+	webhookSecretEncryptedHex, err := utils.EncryptSecret(webhookSecret)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to encrypt webhook secret")
+		return nil, errors.New("error while rotating webhook endpoint secret")
+	}
+
+	// only the last replaced secret is kept, a secret still in its grace
+	// period is not overwritten (checked again by the update for concurrent rotations)
+	now := time.Now().Unix()
+	if webhookEndpointFound.PreviousSecretExpiresAt != nil && *webhookEndpointFound.PreviousSecretExpiresAt > now {
+		return nil, errors.New("Previous secret of webhook endpoint " + p.ID + " is valid until " + fmt.Sprint(*webhookEndpointFound.PreviousSecretExpiresAt) + ", it can't be rotated before")
+	}
+
+	previousSecretExpiresAt := now + int64(gracePeriodSecs)
+	result = s.db.Model(models.Endpoint{}).
+		Where("id = ? AND secret_encrypted = ?", p.ID, webhookEndpointFound.SecretEncrypted).
+		Where("previous_secret_expires_at IS NULL OR previous_secret_expires_at <= ?", now).
+		Updates(map[string]interface{}{
+			"secret_encrypted":           webhookSecretEncryptedHex,
+			"previous_secret_encrypted":  webhookEndpointFound.SecretEncrypted,
+			"previous_secret_expires_at": previousSecretExpiresAt,
+		})
+
+	if result.Error != nil {
+		s.logger.Error().Stack().Err(result.Error).Msg("unable to update webhook endpoint secret")
+		return nil, errors.New("error while rotating webhook endpoint secret")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("Secret of webhook endpoint " + p.ID + " rotated by a concurrent request")
+	}
+
+	s.logger.Info().Int64("previousSecretExpiresAt", previousSecretExpiresAt).Msg("rotated secret of webhook endpoint " + p.ID)
+
+	res = &front.RotateWebhookEndpointSecretResult{
+		ID:                      p.ID,
+		Secret:                  webhookSecret,
+		PreviousSecretExpiresAt: previousSecretExpiresAt,
+	}
+
+	return res, nil
+}
+
+// Allows to send a test event to a webhook endpoint, the request is made
+// synchronously and nothing is stored (endpoint status is never updated)
+func (s *frontsrvc) TestWebhookEndpoint(ctx context.Context, p *front.TestWebhookEndpointPayload) (res *front.TestWebhookEndpointResult, err error) {
+	s.logger.Debug().Interface("payload", p).Msg("front.testWebhookEndpoint")
+
+	var webhookEndpointFound models.Endpoint
+	result := s.db.Scopes(tenantScope(ctx)).First(&webhookEndpointFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Webhook endpoint identifier " + p.ID + " not found")
+		return nil, err
+	}
+
+	// deliveries send only the event content, the event type can't be
+	// carried along a provided content
+	if p.EventContent != nil && p.EventType != nil {
+		return nil, errors.New("event_type can't be used with event_content")
+	}
+
+	eventContent := p.EventContent
+	if eventContent == nil {
+		eventType := "zebrahook.test"
+		if p.EventType != nil {
+			eventType = *p.EventType
+		}
+		eventContent = map[string]interface{}{
+			"event_type": eventType,
+			"test":       true,
+			"created":    time.Now().Unix(),
+		}
+	}
+	eventContentJson, err := json.Marshal(eventContent)
+	if err != nil {
+		return nil, errors.New("event content is not a valid json object")
+	}
+
+	webhookResponse, err := dispatcher.SendWebhookRequest(webhookEndpointFound, string(eventContentJson), *s.logger)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to send test event")
+		return nil, errors.New("error while sending test event to webhook endpoint " + p.ID)
+	}
+
+	s.logger.Info().Str("endpointId", p.ID).Str("status", webhookResponse.Status).Msg("test event sent")
+
+	res = &front.TestWebhookEndpointResult{
+		Status:               webhookResponse.Status,
+		HTTPStatusCode:       webhookResponse.HttpStatusCode,
+		HTTPBodyResponse:     webhookResponse.HttpBodyResponse,
+		HTTPResponseTimeSecs: webhookResponse.HttpResponseTimeSecs,
+	}
+
+	return res, nil
+}
+
+func formatApiKey(apiKey models.ApiKey) *front.APIKey {
+	return &front.APIKey{
+		ID:          apiKey.Id,
+		Description: apiKey.Description,
+		Status:      apiKey.Status,
+		Scopes:      apiKey.Scopes,
+		Tenant:      apiKey.TenantID,
+		ExpiresAt:   apiKey.ExpiresAt,
+		LastUsedAt:  apiKey.LastUsedAt,
+		CreatedAt:   apiKey.CreatedAt,
+		UpdatedAt:   apiKey.UpdatedAt,
+	}
+}
+
+func (s *frontsrvc) ListAPIKeys(ctx context.Context, p *front.ListAPIKeysPayload) (res *front.ListAPIKeysResult, err error) {
+	s.logger.Debug().Msg("front.listApiKeys")
+
+	var apiKeysFoundList []models.ApiKey
+
+	query := s.db.Scopes(tenantScope(ctx)).Order("id ASC")
+
+	if p.Status != nil {
+		query = query.Where("status = ?", *p.Status)
+	}
+
+	// execute query
+	result := query.Find(&apiKeysFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying api keys")
+		return nil, err
+	}
+
+	formattedResult := []*front.APIKey{}
+	for _, element := range apiKeysFoundList {
+		formattedResult = append(formattedResult, formatApiKey(element))
+	}
+
+	res = &front.ListAPIKeysResult{
+		Result: formattedResult,
+	}
+
+	return res, nil
+}
+
+func (s *frontsrvc) GetAPIKey(ctx context.Context, p *front.GetAPIKeyPayload) (res *front.APIKey, err error) {
+	s.logger.Debug().Uint("id", p.ID).Msg("front.getApiKey")
+
+	var apiKeyFound models.ApiKey
+	result := s.db.Scopes(tenantScope(ctx)).First(&apiKeyFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Api key identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	return formatApiKey(apiKeyFound), nil
+}
+
+func (s *frontsrvc) UpdateAPIKey(ctx context.Context, p *front.UpdateAPIKeyPayload) (res *front.APIKey, err error) {
+	s.logger.Debug().Uint("id", p.ID).Msg("front.updateApiKey")
+
+	var apiKeyFound models.ApiKey
+	result := s.db.Scopes(tenantScope(ctx)).First(&apiKeyFound, "id = ?", p.ID)
+
+	if result.Error != nil {
+		err := errors.New("Api key identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	// add update based on input provided
+	apiKeyContent := map[string]interface{}{}
+
+	if p.Description != nil {
+		apiKeyContent["description"] = *p.Description
+	}
+
+	if p.ExpiresAt != nil {
+		if *p.ExpiresAt == 0 {
+			apiKeyContent["expires_at"] = nil
+		} else {
+			apiKeyContent["expires_at"] = *p.ExpiresAt
+		}
+	}
+
+	if len(p.Scopes) > 0 {
+		if err := validateApiKeyScopes(p.Scopes); err != nil {
+			return nil, err
+		}
+		apiKeyContent["scopes"] = pq.StringArray(p.Scopes)
+	}
+
+	if len(apiKeyContent) > 0 {
+		result := s.db.Model(&models.ApiKey{}).Scopes(tenantScope(ctx)).Where("id = ?", p.ID).Updates(apiKeyContent)
+
+		if result.Error != nil {
+			err := errors.New("error while updating api key " + fmt.Sprint(p.ID))
+			return nil, err
+		}
+	}
+
+	s.db.First(&apiKeyFound, "id = ?", p.ID)
+
+	return formatApiKey(apiKeyFound), nil
+}
+
+func (s *frontsrvc) RevokeAPIKey(ctx context.Context, p *front.RevokeAPIKeyPayload) (res *front.RevokeAPIKeyResult, err error) {
+	s.logger.Debug().Uint("id", p.ID).Msg("front.revokeApiKey")
+
+	result := s.db.Model(&models.ApiKey{}).Scopes(tenantScope(ctx)).Where("id = ?", p.ID).Update("status", constants.StatusDisabled)
+
+	if result.Error != nil || result.RowsAffected == 0 {
+		err := errors.New("Api key identifier " + fmt.Sprint(p.ID) + " not found")
+		return nil, err
+	}
+
+	s.logger.Info().Msg("revoked api key " + fmt.Sprint(p.ID))
+
+	success := true
+	res = &front.RevokeAPIKeyResult{
+		Success: &success,
+	}
+
+	return res, nil
+}
+
+func formatEventType(eventType models.EventType) *front.EventType {
+	formattedEventType := &front.EventType{
+		Name:       eventType.Name,
+		Deprecated: eventType.Deprecated,
+		CreatedAt:  eventType.CreatedAt,
+		UpdatedAt:  eventType.UpdatedAt,
+	}
+
+	if eventType.Description != "" {
+		description := eventType.Description
+		formattedEventType.Description = &description
+	}
+
+	// json to map
+	if len(eventType.Schema) > 0 {
+		json.Unmarshal([]byte(eventType.Schema), &formattedEventType.Schema)
+	}
+	if len(eventType.ExamplePayload) > 0 {
+		json.Unmarshal([]byte(eventType.ExamplePayload), &formattedEventType.Example)
+	}
+
+	return formattedEventType
+}
+
+// encodes the json schema and the example of an event type, the schema
+// must be valid and the example must satisfy it (empty if not provided)
+func encodeEventTypeSchema(schema map[string]interface{}, example map[string]interface{}) (schemaJson datatypes.JSON, exampleJson datatypes.JSON, err error) {
+	if len(example) > 0 {
+		exampleJson, _ = json.Marshal(example)
+	}
+
+	if len(schema) == 0 {
+		return nil, exampleJson, nil
+	}
+
+	schemaJson, _ = json.Marshal(schema)
+	compiledSchema, err := utils.CompileJsonSchema(schemaJson)
+	if err != nil {
+		return nil, nil, errors.New("invalid json schema: " + err.Error())
+	}
+
+	if len(exampleJson) > 0 {
+		violations := utils.ValidateJsonSchema(compiledSchema, exampleJson)
+		if len(violations) > 0 {
+			return nil, nil, errors.New("example does not satisfy the json schema: " + strings.Join(violations, "; "))
+		}
+	}
+
+	return schemaJson, exampleJson, nil
+}
+
+// Allows to declare a new event type in the registry
+func (s *frontsrvc) RegisterEventType(ctx context.Context, p *front.RegisterEventTypePayload) (res *front.EventType, err error) {
+	s.logger.Debug().Str("name", p.Name).Msg("front.registerEventType")
+
+	authInfo := contextAuthInfo(ctx)
+
+	name := strings.TrimSpace(p.Name)
+	if !eventTypeRegex.MatchString(name) {
+		return nil, errors.New("event type must be a dot separated list of words (letters, numbers, `-` and `_`)")
+	}
+
+	// reserved by the catalog route (/event-types/catalog)
+	if name == "catalog" {
+		return nil, errors.New("event type catalog is reserved")
+	}
+	if eventMapping.IsSystemEvent(name) {
+		return nil, errors.New("event types starting with " + constants.SystemEventPrefix + " are reserved")
+	}
+
+	var eventTypeFound models.EventType
+	if result := s.db.Scopes(tenantScope(ctx)).First(&eventTypeFound, "name = ?", name); result.Error == nil {
+		return nil, errors.New("Event type " + name + " already registered")
+	}
+
+	schemaJson, exampleJson, err := encodeEventTypeSchema(p.Schema, p.Example)
+	if err != nil {
+		return nil, err
+	}
+
+	newEventTypeToCreate := models.EventType{
+		TenantID:       authInfo.tenantId,
+		Name:           name,
+		Schema:         schemaJson,
+		ExamplePayload: exampleJson,
+		Deprecated:     p.Deprecated,
+	}
+	if p.Description != nil {
+		newEventTypeToCreate.Description = *p.Description
+	}
+
+	if result := s.db.Create(&newEventTypeToCreate); result.Error != nil {
+		return nil, errors.New("error while registering event type " + name)
+	}
+
+	s.logger.Info().Msg("registered new event type " + name)
+
+	return formatEventType(newEventTypeToCreate), nil
+}
+
+func (s *frontsrvc) ListEventTypes(ctx context.Context, p *front.ListEventTypesPayload) (res *front.ListEventTypesResult, err error) {
+	s.logger.Debug().Msg("front.listEventTypes")
+
+	var eventTypesFoundList []models.EventType
+
+	query := s.db.Scopes(tenantScope(ctx)).Order("name ASC")
+
+	if p.Deprecated != nil {
+		query = query.Where("deprecated = ?", *p.Deprecated)
+	}
+
+	// execute query
+	result := query.Find(&eventTypesFoundList)
+
+	if result.Error != nil {
+		err := errors.New("error while querying event types")
+		return nil, err
+	}
+
+	formattedResult := []*front.EventType{}
+	for _, element := range eventTypesFoundList {
+		formattedResult = append(formattedResult, formatEventType(element))
+	}
+
+	res = &front.ListEventTypesResult{
+		Result: formattedResult,
+	}
+
+	return res, nil
+}
+
+func (s *frontsrvc) GetEventType(ctx context.Context, p *front.GetEventTypePayload) (res *front.EventType, err error) {
+	s.logger.Debug().Str("name", p.Name).Msg("front.getEventType")
+
+	var eventTypeFound models.EventType
+	result := s.db.Scopes(tenantScope(ctx)).First(&eventTypeFound, "name = ?", p.Name)
+
+	if result.Error != nil {
+		err := errors.New("Event type " + p.Name + " not found")
+		return nil, err
+	}
+
+	return formatEventType(eventTypeFound), nil
+}
+
+func (s *frontsrvc) UpdateEventType(ctx context.Context, p *front.UpdateEventTypePayload) (res *front.EventType, err error) {
+	s.logger.Debug().Str("name", p.Name).Msg("front.updateEventType")
+
+	var eventTypeFound models.EventType
+	result := s.db.Scopes(tenantScope(ctx)).First(&eventTypeFound, "name = ?", p.Name)
+
+	if result.Error != nil {
+		err := errors.New("Event type " + p.Name + " not found")
+		return nil, err
+	}
+
+	// add update based on input provided
+	eventTypeContent := map[string]interface{}{}
+
+	if p.Description != nil {
+		eventTypeContent["description"] = *p.Description
+	}
+
+	if p.Deprecated != nil {
+		eventTypeContent["deprecated"] = *p.Deprecated
+	}
+
+	// the example must satisfy the schema, current values are used when not provided
+	if p.Schema != nil || p.Example != nil {
+		currentEventType := formatEventType(eventTypeFound)
+		schema := currentEventType.Schema
+		if p.Schema != nil {
+			schema = p.Schema
+		}
+		example := currentEventType.Example
+		if p.Example != nil {
+			example = p.Example
+		}
+
+		schemaJson, exampleJson, err := encodeEventTypeSchema(schema, example)
+		if err != nil {
+			return nil, err
+		}
+		eventTypeContent["schema"] = schemaJson
+		eventTypeContent["example_payload"] = exampleJson
+	}
+
+	if len(eventTypeContent) > 0 {
+		s.db.Model(&models.EventType{}).Where("id = ?", eventTypeFound.Id).Updates(eventTypeContent)
+	}
+
+	s.db.First(&eventTypeFound, "id = ?", eventTypeFound.Id)
+
+	return formatEventType(eventTypeFound), nil
+}
+
+// Allows to export the catalog of the registered event types
+func (s *frontsrvc) GetEventTypesCatalog(ctx context.Context, p *front.GetEventTypesCatalogPayload) (res *front.GetEventTypesCatalogResult, body io.ReadCloser, err error) {
+	s.logger.Debug().Str("format", p.Format).Msg("front.getEventTypesCatalog")
+
+	var eventTypesFoundList []models.EventType
+	if result := s.db.Scopes(tenantScope(ctx)).Order("name ASC").Find(&eventTypesFoundList); result.Error != nil {
+		return nil, nil, errors.New("error while querying event types")
+	}
+
+	eventCatalog := newCatalog(eventTypesFoundList)
+
+	var content []byte
+	res = &front.GetEventTypesCatalogResult{}
+	switch p.Format {
+	case "markdown":
+		res.ContentType = "text/markdown; charset=utf-8"
+		content = eventCatalog.markdown()
+	case "html":
+		res.ContentType = "text/html; charset=utf-8"
+		content, err = eventCatalog.html()
+	default:
+		res.ContentType = "application/json"
+		content, err = eventCatalog.asyncApi()
+	}
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("unable to build event types catalog")
+		return nil, nil, errors.New("error while building event types catalog")
+	}
+
+	return res, io.NopCloser(bytes.NewReader(content)), nil
+}
@@ -1,6 +1,9 @@
 package models
 
-import "gorm.io/gorm"
+import (
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
 
 type ApiKey struct {
 	gorm.Model
@@ -10,6 +13,19 @@ type ApiKey struct {
 
 	Status string `gorm:"not null;default:enabled"`
 
+	// tenant owning the key, endpoints and events created with the key
+	// belong to the same tenant (empty for the default tenant)
+	TenantID string `gorm:"index;not null;default:'';<-:create"`
+
+	// scopes granted, `*` grants all the scopes (keys created before scopes were introduced)
+	Scopes pq.StringArray `gorm:"type:text[];not null;default:'{*}'"`
+
+	// optional expiration (unix timestamp seconds), expired keys are rejected
+	ExpiresAt *int64 `gorm:"default:null"`
+
+	// last successful authentication (unix timestamp seconds)
+	LastUsedAt *int64 `gorm:"default:null"`
+
 	// unix timestamp (seconds)
 	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
 	CreatedAt int64 `gorm:"autoCreateTime;not null"`
@@ -0,0 +1,45 @@
+package models
+
+import "gorm.io/gorm"
+
+// Event delivery that failed without more attempts, kept until it is
+// replayed or purged (see `deadLetters.retentionDays`)
+type DeadLetter struct {
+	gorm.Model
+	Id uint `gorm:"primaryKey;not null;<-:create"`
+
+	// tenant of the endpoint
+	TenantID string `gorm:"index;not null;default:'';<-:create"`
+
+	// reference to the failed EventDelivery
+	EventDeliveryID uint `gorm:"not null;<-:create;uniqueIndex"`
+
+	// reference to Event
+	EventID   uint   `gorm:"index;not null;<-:create"`
+	EventType string `gorm:"not null;<-:create"`
+
+	// reference to Endpoint
+	EndpointID string `gorm:"index;not null;<-:create"`
+
+	// why the delivery failed: max_attempts_exceeded or http_410_gone
+	Reason string `gorm:"not null;<-:create"`
+
+	AttemptsCounter uint `gorm:"not null;<-:create"`
+
+	// final error, outcome of the last attempt
+	LastAttemptStatus    string  `gorm:"not null;<-:create"`
+	LastHttpStatusCode   *int    `gorm:"<-:create"`
+	LastHttpBodyResponse *string `gorm:"<-:create"`
+	LastAttemptMadeAt    *int64  `gorm:"<-:create"`
+
+	// dead -> replayed (delivered again with a new event delivery)
+	Status string `gorm:"index;not null;default:'dead'"`
+
+	ReplayEventDeliveryID *uint
+	// unix timestamp (seconds)
+	ReplayedAt *int64
+
+	// unix timestamp (seconds)
+	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
+	CreatedAt int64 `gorm:"autoCreateTime;not null"`
+}
@@ -10,11 +10,45 @@ type Endpoint struct {
 	gorm.Model
 	Id              string         `gorm:"primaryKey;not null;<-:create"`
 	Url             string         `gorm:"not null"`
-	SecretEncrypted string         `gorm:"not null;<-:create;unique_index:uidx_secret_encrypted"`
+	SecretEncrypted string         `gorm:"not null;unique_index:uidx_secret_encrypted"`
 	EnabledEvents   pq.StringArray `gorm:"type:text[];not null"`
 	Metadata        datatypes.JSON `gorm:"type:json;null"`
 
+	// tenant owning the endpoint, only events of the same tenant are delivered to it
+	TenantID string `gorm:"index;not null;default:'';<-:create"`
+
+	// secret replaced by the last rotation, still used to sign
+	// events until PreviousSecretExpiresAt (unix timestamp seconds)
+	PreviousSecretEncrypted *string `gorm:"default:null"`
+	PreviousSecretExpiresAt *int64  `gorm:"default:null"`
+
+	// custom http headers (json encoded map) added to every request, encrypted
+	HeadersEncrypted *string `gorm:"default:null"`
+
+	// delivery policy overrides, global configuration is used when not set
+	DeliveryTimeoutSecs *uint   `gorm:"default:null"`
+	DeliveryMaxAttempts *uint   `gorm:"default:null"`
+	BackoffType         *string `gorm:"default:null"`
+	BackoffBaseSecs     *uint   `gorm:"default:null"`
+	MaxBackoffSecs      *uint   `gorm:"default:null"`
+	// delays of the schedule backoff type, seconds
+	BackoffScheduleSecs pq.Int64Array `gorm:"type:bigint[];default:null"`
+
 	Status string `gorm:"not null;default:enabled"`
+	// why the endpoint was disabled (see EndpointStatusEvent), empty when enabled
+	DisabledReason *string `gorm:"default:null"`
+
+	// circuit breaker, deliveries are held while open
+	// closed -> open -> half_open (a single probe delivery) -> closed | open
+	CircuitState string `gorm:"not null;default:closed"`
+	// when the circuit was opened and when the next probe is due (unix timestamp seconds)
+	CircuitOpenedAt    *int64 `gorm:"default:null"`
+	CircuitNextProbeAt *int64 `gorm:"default:null"`
+	// event delivery sent as probe while half open
+	CircuitProbeDeliveryID *uint `gorm:"default:null"`
+	// when the circuit was last closed after a probe (unix timestamp seconds), failures
+	// before it are not counted in the failure ratio window
+	CircuitClosedAt *int64 `gorm:"default:null"`
 
 	// unix timestamp (seconds)
 	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
@@ -0,0 +1,25 @@
+package models
+
+import "gorm.io/gorm"
+
+// Change of the status of an endpoint (write only table)
+type EndpointStatusEvent struct {
+	gorm.Model
+	Id uint `gorm:"primaryKey;not null;<-:create"`
+
+	// reference to Endpoint
+	EndpointID string `gorm:"index;not null;<-:create"`
+
+	OldStatus string `gorm:"not null;<-:create"`
+	NewStatus string `gorm:"not null;<-:create"`
+
+	// why the status changed: manual, recovery, max_attempts_exceeded or http_410_gone
+	Reason string `gorm:"not null;<-:create"`
+
+	// who made the change, both empty for automatic changes
+	ActorApiKeyID *uint   `gorm:"default:null;<-:create"`
+	ActorSubject  *string `gorm:"default:null;<-:create"`
+
+	// unix timestamp (seconds)
+	CreatedAt int64 `gorm:"autoCreateTime;not null"`
+}
@@ -14,6 +14,14 @@ type Event struct {
 
 	Priority int `gorm:"not null;default:0"`
 
+	// tenant that submitted the event
+	TenantID string `gorm:"index;not null;default:'';<-:create"`
+
+	// when the event was mapped to the subscribed endpoints (unix timestamp seconds)
+	// and how many endpoints matched
+	MappedAt         *int64 `gorm:"default:null"`
+	EndpointsMatched *uint  `gorm:"default:null"`
+
 	// TODO metadata, content type
 
 	UpdatedAt int64 `gorm:"autoUpdateTime;not null"` // remove / disable
@@ -35,6 +43,14 @@ type EventDelivery struct {
 	// and decremented
 	AttemptsRemaining uint `gorm:"not null"`
 
+	// overall outcome of this delivery
+	// pending ->
+	//			 success (event delivered)
+	//			 failed (no more attempts remaining)
+	//			 cancelled (endpoint deleted before the event was delivered)
+	//			 held (endpoint circuit open, delivered when the circuit closes)
+	Status string `gorm:"not null;default:'pending'"`
+
 	// reference to Endpoint
 	EndpointID string
 	Endpoint   Endpoint
@@ -63,6 +79,9 @@ type EventDeliveryAttempt struct {
 	//			 error_timeout (http request in timeout error)
 	//			 error_response (http response status code != 2xx)
 	//			 error_network (generic error, unable to make a connection to the endpoint)
+	//			 error_blocked (endpoint url or address denied by the egress policy)
+	//			 error_internal (request not sent, e.g. endpoint secret or headers not decryptable)
+	//			 cancelled (delivery cancelled before the request was made)
 	//
 	Status string `gorm:"not null;default:'pending'"`
 
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Event type declared in the registry, content of the submitted
+// events of this type is validated against the json schema
+type EventType struct {
+	gorm.Model
+	Id uint `gorm:"primaryKey;not null;<-:create"`
+
+	// tenant owning the event type, names are unique per tenant
+	TenantID string `gorm:"not null;default:'';<-:create;uniqueIndex:uidx_event_type_name"`
+	Name     string `gorm:"not null;<-:create;uniqueIndex:uidx_event_type_name"`
+
+	Description string `gorm:"not null;default:''"`
+
+	// optional json schema of the event content and example payload
+	Schema         datatypes.JSON `gorm:"type:json;null"`
+	ExamplePayload datatypes.JSON `gorm:"type:json;null"`
+
+	Deprecated bool `gorm:"not null;default:false"`
+
+	// unix timestamp (seconds)
+	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
+	CreatedAt int64 `gorm:"autoCreateTime;not null"`
+}
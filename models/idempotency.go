@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// Idempotency key provided on events submission, scoped to the api key
+// or, for jwt tokens, to the tenant and subject of the token
+type IdempotencyKey struct {
+	gorm.Model
+	Id uint `gorm:"primaryKey;not null;<-:create"`
+
+	// reference to ApiKey
+	ApiKeyID uint `gorm:"not null;<-:create;uniqueIndex:uidx_idempotency_key"`
+
+	// tenant and jwt token subject (`sub`), subject is empty for api keys
+	TenantID string `gorm:"not null;default:'';<-:create;uniqueIndex:uidx_idempotency_key"`
+	Subject  string `gorm:"not null;default:'';<-:create;uniqueIndex:uidx_idempotency_key"`
+
+	// request (Idempotency-Key header) or event (per event idempotency key)
+	Kind string `gorm:"not null;<-:create;uniqueIndex:uidx_idempotency_key"`
+	Key  string `gorm:"not null;<-:create;uniqueIndex:uidx_idempotency_key"`
+
+	// events created the first time this key was used
+	EventIds pq.Int64Array `gorm:"type:bigint[];not null;<-:create"`
+
+	// unix timestamp (seconds)
+	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
+	CreatedAt int64 `gorm:"autoCreateTime;not null"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "gorm.io/gorm"
+
+// Bulk recovery of the failed event deliveries of an endpoint
+type EndpointRecovery struct {
+	gorm.Model
+	Id string `gorm:"primaryKey;not null;<-:create"`
+
+	// reference to Endpoint
+	EndpointID string `gorm:"index;not null;<-:create"`
+
+	// recover event deliveries created since (unix timestamp seconds)
+	Since int64 `gorm:"not null;<-:create"`
+
+	// pending -> running -> completed | failed
+	Status string `gorm:"not null;default:'pending'"`
+
+	// how many event deliveries need to be delivered again
+	DeliveriesFound uint `gorm:"not null;default:0"`
+
+	// how many event deliveries have been enqueued so far
+	DeliveriesEnqueued uint `gorm:"not null;default:0"`
+
+	// unix timestamp (seconds)
+	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
+	CreatedAt int64 `gorm:"autoCreateTime;not null"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Delivery attempts made to an endpoint in a time bucket,
+// maintained by the stats rollup job of the event mapping worker
+type EndpointStatsRollup struct {
+	gorm.Model
+	Id uint `gorm:"primaryKey;not null;<-:create"`
+
+	// reference to Endpoint
+	EndpointID string `gorm:"not null;<-:create;uniqueIndex:uidx_endpoint_stats_bucket"`
+
+	// start of the bucket (unix timestamp seconds)
+	BucketStart int64 `gorm:"not null;<-:create;uniqueIndex:uidx_endpoint_stats_bucket"`
+
+	Attempts  uint `gorm:"not null;default:0"`
+	Successes uint `gorm:"not null;default:0"`
+
+	// attempts per attempt status and per http status code (json encoded maps)
+	StatusCounts     datatypes.JSON `gorm:"type:json;not null"`
+	HttpStatusCounts datatypes.JSON `gorm:"type:json;not null"`
+
+	// attempts per response time bucket (see utils.ResponseTimeBounds)
+	// and slowest response time in seconds
+	ResponseTimeHistogram pq.Int64Array `gorm:"type:bigint[];not null"`
+	ResponseTimeMaxSecs   float64       `gorm:"not null;default:0"`
+
+	// unix timestamp (seconds)
+	UpdatedAt int64 `gorm:"autoUpdateTime;not null"`
+	CreatedAt int64 `gorm:"autoCreateTime;not null"`
+}
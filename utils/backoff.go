@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"zebrahook/constants"
+)
+
+// computes the delay between two delivery attempts
+type BackoffStrategy interface {
+	// seconds to wait before the attempt number `attemptCounter` (starting from 2)
+	NextRetrySeconds(attemptCounter int) float64
+	// human readable description, used in the event catalog
+	Description() string
+}
+
+// random delay between 0 and baseSecs*2^(attempt-2) ("full jitter"), capped to maxSecs
+type exponentialBackoff struct {
+	baseSecs uint
+	maxSecs  uint
+}
+
+func (b exponentialBackoff) NextRetrySeconds(attemptCounter int) float64 {
+	delay := capDelay(float64(b.baseSecs)*math.Pow(2, float64(retryNumber(attemptCounter)-1)), b.maxSecs)
+	return rand.Float64() * delay
+}
+
+func (b exponentialBackoff) Description() string {
+	return fmt.Sprintf("a random delay between 0 and %d*2^(retry - 1) seconds", b.baseSecs) + maxDescription(b.maxSecs)
+}
+
+// baseSecs, 2*baseSecs, 3*baseSecs... capped to maxSecs
+type linearBackoff struct {
+	baseSecs uint
+	maxSecs  uint
+}
+
+func (b linearBackoff) NextRetrySeconds(attemptCounter int) float64 {
+	return capDelay(float64(b.baseSecs)*float64(retryNumber(attemptCounter)), b.maxSecs)
+}
+
+func (b linearBackoff) Description() string {
+	return fmt.Sprintf("a delay of %d*retry seconds", b.baseSecs) + maxDescription(b.maxSecs)
+}
+
+// always baseSecs
+type fixedBackoff struct {
+	baseSecs uint
+}
+
+func (b fixedBackoff) NextRetrySeconds(attemptCounter int) float64 {
+	return float64(b.baseSecs)
+}
+
+func (b fixedBackoff) Description() string {
+	return fmt.Sprintf("a delay of %d seconds", b.baseSecs)
+}
+
+// explicit list of delays, the last one is used for the remaining attempts
+type scheduleBackoff struct {
+	scheduleSecs []uint
+	maxSecs      uint
+}
+
+func (b scheduleBackoff) NextRetrySeconds(attemptCounter int) float64 {
+	index := retryNumber(attemptCounter) - 1
+	if index >= len(b.scheduleSecs) {
+		index = len(b.scheduleSecs) - 1
+	}
+	return capDelay(float64(b.scheduleSecs[index]), b.maxSecs)
+}
+
+func (b scheduleBackoff) Description() string {
+	delays := []string{}
+	for _, delay := range b.scheduleSecs {
+		delays = append(delays, fmt.Sprint(delay))
+	}
+	return "delays of " + strings.Join(delays, ", ") + " seconds (the last one is repeated)" + maxDescription(b.maxSecs)
+}
+
+// number of the retry, 1 for the second attempt
+func retryNumber(attemptCounter int) int {
+	if attemptCounter < 2 {
+		return 1
+	}
+	return attemptCounter - 1
+}
+
+// maxSecs 0 means no limit
+func capDelay(delay float64, maxSecs uint) float64 {
+	if maxSecs > 0 && delay > float64(maxSecs) {
+		return float64(maxSecs)
+	}
+	return delay
+}
+
+func maxDescription(maxSecs uint) string {
+	if maxSecs == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", at most %d seconds", maxSecs)
+}
+
+// returns the backoff strategy of type `backoffType`, baseSecs is not used
+// by the schedule strategy, scheduleSecs is used only by it
+func NewBackoffStrategy(backoffType string, baseSecs uint, maxBackoffSecs uint, scheduleSecs []uint) (BackoffStrategy, error) {
+	if backoffType != constants.BackoffSchedule && baseSecs == 0 {
+		return nil, errors.New("backoff base seconds must be above 0")
+	}
+
+	switch backoffType {
+	case constants.BackoffExponential:
+		return exponentialBackoff{baseSecs: baseSecs, maxSecs: maxBackoffSecs}, nil
+	case constants.BackoffLinear:
+		return linearBackoff{baseSecs: baseSecs, maxSecs: maxBackoffSecs}, nil
+	case constants.BackoffFixed:
+		return fixedBackoff{baseSecs: baseSecs}, nil
+	case constants.BackoffSchedule:
+		if len(scheduleSecs) == 0 {
+			return nil, errors.New("backoff schedule must contain at least one delay")
+		}
+		for _, delay := range scheduleSecs {
+			if delay == 0 {
+				return nil, errors.New("backoff schedule delays must be above 0")
+			}
+		}
+		return scheduleBackoff{scheduleSecs: scheduleSecs, maxSecs: maxBackoffSecs}, nil
+	}
+
+	return nil, errors.New("unknown backoff type " + backoffType + ", expected one of " + strings.Join(constants.BackoffTypes, ","))
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"math"
+	"testing"
+	"zebrahook/constants"
+)
+
+func TestExponentialBackoffJitterCap(t *testing.T) {
+	strategy, err := NewBackoffStrategy(constants.BackoffExponential, 60, 86400, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 60*2^(retry-1) overflows float64 after ~1024 retries, the cap
+	// must still hold for attempt counters configured far above
+	for _, attemptCounter := range []int{2, 3, 12, 64, 1025, 1100, 1 << 20, math.MaxInt32, math.MaxInt} {
+		for i := 0; i < 200; i++ {
+			delay := strategy.NextRetrySeconds(attemptCounter)
+			if math.IsNaN(delay) || delay < 0 || delay > 86400 {
+				t.Fatalf("attempt %d: delay %v out of [0, 86400]", attemptCounter, delay)
+			}
+		}
+	}
+
+	// below the cap the jitter upper bound is base*2^(retry-1)
+	for i := 0; i < 200; i++ {
+		if delay := strategy.NextRetrySeconds(4); delay > 240 {
+			t.Fatalf("third retry: delay %v above 240", delay)
+		}
+	}
+}
+
+func TestBackoffSchedules(t *testing.T) {
+	linear, _ := NewBackoffStrategy(constants.BackoffLinear, 30, 100, nil)
+	fixed, _ := NewBackoffStrategy(constants.BackoffFixed, 45, 0, nil)
+	schedule, _ := NewBackoffStrategy(constants.BackoffSchedule, 0, 300, []uint{5, 60, 600})
+
+	// attempt counters start at 2 for the first retry
+	for _, expected := range []struct {
+		strategy BackoffStrategy
+		delays   []float64
+	}{
+		{linear, []float64{30, 60, 90, 100, 100}},
+		{fixed, []float64{45, 45, 45, 45, 45}},
+		{schedule, []float64{5, 60, 300, 300, 300}},
+	} {
+		for retry, delay := range expected.delays {
+			if got := expected.strategy.NextRetrySeconds(retry + 2); got != delay {
+				t.Errorf("%s, retry %d: expected %v, got %v", expected.strategy.Description(), retry+1, delay, got)
+			}
+		}
+	}
+}
+
+func TestNewBackoffStrategyErrors(t *testing.T) {
+	if _, err := NewBackoffStrategy("random", 10, 0, nil); err == nil {
+		t.Error("expected an error with an unknown type")
+	}
+	if _, err := NewBackoffStrategy(constants.BackoffExponential, 0, 0, nil); err == nil {
+		t.Error("expected an error without base seconds")
+	}
+	if _, err := NewBackoffStrategy(constants.BackoffSchedule, 0, 0, []uint{}); err == nil {
+		t.Error("expected an error with an empty schedule")
+	}
+	if _, err := NewBackoffStrategy(constants.BackoffSchedule, 0, 0, []uint{5, 0}); err == nil {
+		t.Error("expected an error with a zero delay in the schedule")
+	}
+}
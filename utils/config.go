@@ -2,6 +2,8 @@ package utils
 
 import (
 	"fmt"
+	"net/mail"
+	"strings"
 	"zebrahook/constants"
 
 	"github.com/spf13/viper"
@@ -41,10 +43,40 @@ func LoadConfig() {
 	viper.SetDefault("worker.dispatcher.parallelJobs", 3)
 	viper.SetDefault("worker.eventMapping.parallelJobs", 1)
 
-	// backoff and max attempt
-	viper.SetDefault("backoffStrategy.type", "exponential")
+	// backoff and max attempt, type is one of exponential, linear, fixed or schedule
+	viper.SetDefault("backoffStrategy.type", constants.BackoffExponential)
 	viper.SetDefault("backoffStrategy.maxAttempts", 3)
 	viper.SetDefault("backoffStrategy.baseSecs", 60)
+	// maximum seconds between two attempts, 0 means no limit
+	viper.SetDefault("backoffStrategy.maxBackoffSecs", 86400)
+	// delays (seconds) of the schedule type, the last one is repeated for the remaining attempts
+	viper.SetDefault("backoffStrategy.scheduleSecs", []int{})
+
+	// circuit breaker per endpoint, opens when at least minRequests attempts were made
+	// in the last windowSecs with a failure ratio of at least failureRatio, deliveries
+	// are held while open and a probe delivery is sent every probeIntervalSecs
+	viper.SetDefault("circuitBreaker.enabled", true)
+	viper.SetDefault("circuitBreaker.windowSecs", 600)
+	viper.SetDefault("circuitBreaker.minRequests", 10)
+	viper.SetDefault("circuitBreaker.failureRatio", 0.5)
+	viper.SetDefault("circuitBreaker.probeIntervalSecs", 60)
+
+	// operator notifications, system events (`zebrahook.*`) are routed to the subscribed
+	// endpoints, with smtp enabled they are also emailed to the address in the endpoint
+	// metadata `ownerMetadataKey` and to the `to` addresses
+	viper.SetDefault("notifications.systemEvents", true)
+	viper.SetDefault("notifications.smtp.enabled", false)
+	viper.SetDefault("notifications.smtp.port", 25)
+	viper.SetDefault("notifications.smtp.ownerMetadataKey", "owner_email")
+	viper.SetDefault("notifications.smtp.ownerDomains", []string{})
+	viper.SetDefault("notifications.smtp.to", []string{})
+	viper.SetDefault("notifications.smtp.eventTypes", []string{constants.SystemEventEndpointDisabled, constants.SystemEventEndpointFailing})
+
+	// how often the endpoint stats rollup table is updated
+	viper.SetDefault("stats.rollupIntervalSecs", 60)
+
+	// how long dead letters are kept, 0 keeps them until purged
+	viper.SetDefault("deadLetters.retentionDays", 30)
 
 	// webhook request options
 	viper.SetDefault("webhookRequest.timeoutSecs", 30)
@@ -52,6 +84,29 @@ func LoadConfig() {
 	viper.SetDefault("webhookRequest.userAgent", "Zebrahook")
 	viper.SetDefault("webhookRequest.signatureHeaderName", "Zebrahook-Signature")
 
+	// egress policy of webhook requests, private networks are denied unless
+	// explicitly allowed (allowedCidrs, allowedHosts), empty allowedPorts allows any port
+	viper.SetDefault("egressPolicy.allowedSchemes", []string{"https", "http"})
+	viper.SetDefault("egressPolicy.allowedPorts", []int{})
+	viper.SetDefault("egressPolicy.denyPrivateNetworks", true)
+	viper.SetDefault("egressPolicy.deniedCidrs", []string{})
+	viper.SetDefault("egressPolicy.allowedCidrs", []string{})
+	viper.SetDefault("egressPolicy.allowedHosts", []string{})
+
+	// how long the previous webhook secret is still used to sign events after a rotation
+	viper.SetDefault("webhookSecret.rotationGracePeriodSecs", 86400)
+
+	// how long an idempotency key is remembered after the first use
+	viper.SetDefault("idempotency.retentionSecs", 86400)
+
+	// event type registry validation of submitted events (strict, warn or off)
+	viper.SetDefault("eventTypes.validation", constants.EventTypeValidationOff)
+
+	// jwt authentication, claims used for the tenant and the granted scopes
+	viper.SetDefault("jwt.tenantClaim", "tenant")
+	viper.SetDefault("jwt.scopeClaim", "scope")
+	viper.SetDefault("jwt.allowDefaultTenant", false)
+
 	err := viper.ReadInConfig()
 
 	if err != nil { // Handle errors reading the config file
@@ -73,6 +128,69 @@ func LoadConfig() {
 	if viper.GetString("webhookRequest.signatureHeaderName") == "" {
 		panic(fmt.Errorf("expected configuration %s to be set", "webhookRequest.signatureHeaderName"))
 	}
+
+	if viper.GetUint("backoffStrategy.maxAttempts") <= 0 {
+		panic(fmt.Errorf("expected configuration %s to have a value above 0", "backoffStrategy.maxAttempts"))
+	}
+	if _, err := NewBackoffStrategy(viper.GetString("backoffStrategy.type"), viper.GetUint("backoffStrategy.baseSecs"),
+		viper.GetUint("backoffStrategy.maxBackoffSecs"), configScheduleSecs()); err != nil {
+		panic(fmt.Errorf("invalid configuration %s: %w", "backoffStrategy", err))
+	}
+
+	if viper.GetBool("circuitBreaker.enabled") {
+		if viper.GetUint("circuitBreaker.windowSecs") <= 0 {
+			panic(fmt.Errorf("expected configuration %s to have a value above 0", "circuitBreaker.windowSecs"))
+		}
+		if viper.GetUint("circuitBreaker.minRequests") <= 0 {
+			panic(fmt.Errorf("expected configuration %s to have a value above 0", "circuitBreaker.minRequests"))
+		}
+		if failureRatio := viper.GetFloat64("circuitBreaker.failureRatio"); failureRatio <= 0 || failureRatio > 1 {
+			panic(fmt.Errorf("expected configuration %s to be above 0 and at most 1", "circuitBreaker.failureRatio"))
+		}
+		if viper.GetUint("circuitBreaker.probeIntervalSecs") <= 0 {
+			panic(fmt.Errorf("expected configuration %s to have a value above 0", "circuitBreaker.probeIntervalSecs"))
+		}
+	}
+
+	if _, err := NewEgressPolicy(); err != nil {
+		panic(fmt.Errorf("invalid configuration %s: %w", "egressPolicy", err))
+	}
+
+	eventTypesValidation := viper.GetString("eventTypes.validation")
+	if contains(constants.EventTypeValidationModes, eventTypesValidation) == -1 {
+		panic(fmt.Errorf("expected configuration %s to be one of %s", "eventTypes.validation", strings.Join(constants.EventTypeValidationModes, ",")))
+	}
+
+	if viper.GetBool("notifications.smtp.enabled") {
+		if viper.GetString("notifications.smtp.host") == "" {
+			panic(fmt.Errorf("expected configuration %s to be set", "notifications.smtp.host"))
+		}
+		if _, err := mail.ParseAddress(viper.GetString("notifications.smtp.from")); err != nil {
+			panic(fmt.Errorf("invalid configuration %s: %w", "notifications.smtp.from", err))
+		}
+		for _, to := range viper.GetStringSlice("notifications.smtp.to") {
+			if _, err := mail.ParseAddress(to); err != nil {
+				panic(fmt.Errorf("invalid configuration %s: %w", "notifications.smtp.to", err))
+			}
+		}
+		for _, eventType := range viper.GetStringSlice("notifications.smtp.eventTypes") {
+			if contains(constants.SystemEventTypes, eventType) == -1 {
+				panic(fmt.Errorf("expected configuration %s to contain only %s", "notifications.smtp.eventTypes", strings.Join(constants.SystemEventTypes, ",")))
+			}
+		}
+	}
+
+	if viper.GetUint("stats.rollupIntervalSecs") <= 0 {
+		panic(fmt.Errorf("expected configuration %s to have a value above 0", "stats.rollupIntervalSecs"))
+	}
+
+	if viper.GetInt("deadLetters.retentionDays") < 0 {
+		panic(fmt.Errorf("expected configuration %s to have a value of 0 or above", "deadLetters.retentionDays"))
+	}
+
+	if viper.IsSet("jwt.hmacSecret") && len(viper.GetString("jwt.hmacSecret")) < 32 {
+		panic(fmt.Errorf("expected configuration %s to have a length of at least 32", "jwt.hmacSecret"))
+	}
 }
 
 func getPollingIntervalConfig(workerName *string) PollingIntervalConfig {
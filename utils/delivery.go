@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"zebrahook/models"
+
+	"github.com/spf13/viper"
+)
+
+// delivery settings of an endpoint, endpoint overrides
+// fall back to the global configuration
+type DeliveryPolicy struct {
+	TimeoutSecs     uint
+	MaxAttempts     uint
+	BackoffType     string
+	BackoffBaseSecs uint
+	// 0 means no limit
+	MaxBackoffSecs uint
+	// delays of the schedule backoff type
+	BackoffScheduleSecs []uint
+}
+
+func uintOrDefault(value *uint, defaultValue uint) uint {
+	if value != nil {
+		return *value
+	}
+	return defaultValue
+}
+
+// `backoffStrategy.scheduleSecs` configuration, negative delays are
+// returned as 0 so they are rejected by the strategy validation
+func configScheduleSecs() []uint {
+	scheduleSecs := []uint{}
+	for _, delay := range viper.GetIntSlice("backoffStrategy.scheduleSecs") {
+		if delay < 0 {
+			delay = 0
+		}
+		scheduleSecs = append(scheduleSecs, uint(delay))
+	}
+	return scheduleSecs
+}
+
+// returns the delivery policy to use for the endpoint
+func GetDeliveryPolicy(endpoint models.Endpoint) DeliveryPolicy {
+	policy := DeliveryPolicy{
+		TimeoutSecs:     uintOrDefault(endpoint.DeliveryTimeoutSecs, viper.GetUint("webhookRequest.timeoutSecs")),
+		MaxAttempts:     uintOrDefault(endpoint.DeliveryMaxAttempts, viper.GetUint("backoffStrategy.maxAttempts")),
+		BackoffType:     viper.GetString("backoffStrategy.type"),
+		BackoffBaseSecs: uintOrDefault(endpoint.BackoffBaseSecs, viper.GetUint("backoffStrategy.baseSecs")),
+		MaxBackoffSecs:  uintOrDefault(endpoint.MaxBackoffSecs, viper.GetUint("backoffStrategy.maxBackoffSecs")),
+	}
+	if endpoint.BackoffType != nil {
+		policy.BackoffType = *endpoint.BackoffType
+	}
+	if len(endpoint.BackoffScheduleSecs) > 0 {
+		policy.BackoffScheduleSecs = []uint{}
+		for _, delay := range endpoint.BackoffScheduleSecs {
+			policy.BackoffScheduleSecs = append(policy.BackoffScheduleSecs, uint(delay))
+		}
+	} else {
+		policy.BackoffScheduleSecs = configScheduleSecs()
+	}
+
+	return policy
+}
+
+// returns the backoff strategy of the policy, an error if the policy is not valid
+func (p DeliveryPolicy) BackoffStrategy() (BackoffStrategy, error) {
+	return NewBackoffStrategy(p.BackoffType, p.BackoffBaseSecs, p.MaxBackoffSecs, p.BackoffScheduleSecs)
+}
@@ -0,0 +1,247 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// ranges denied by default: loopback, link-local (including cloud metadata
+// 169.254.169.254), private, shared address space, multicast and reserved
+var defaultDeniedCidrs = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.0.2.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"198.51.100.0/24",
+	"203.0.113.0/24",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+}
+
+// returned when a webhook request is not allowed by the egress policy
+type EgressDeniedError struct {
+	Reason string
+}
+
+func (e *EgressDeniedError) Error() string {
+	return "denied by egress policy: " + e.Reason
+}
+
+// policy applied to the outgoing webhook requests
+type EgressPolicy struct {
+	allowedSchemes []string
+	// empty allows any port
+	allowedPorts []int
+
+	deniedNetworks []*net.IPNet
+
+	// trusted receivers, allowed even if in a denied network
+	allowedNetworks []*net.IPNet
+	allowedHosts    []string
+
+	// used by CheckURL, the dialer resolves the host again on its own
+	resolver *net.Resolver
+}
+
+func parseCidrs(cidrs []string) ([]*net.IPNet, error) {
+	networks := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// builds the egress policy based on `egressPolicy` configuration
+func NewEgressPolicy() (*EgressPolicy, error) {
+	policy := &EgressPolicy{resolver: net.DefaultResolver}
+
+	for _, scheme := range viper.GetStringSlice("egressPolicy.allowedSchemes") {
+		policy.allowedSchemes = append(policy.allowedSchemes, strings.ToLower(scheme))
+	}
+	if len(policy.allowedSchemes) == 0 {
+		return nil, errors.New("expected at least one allowed scheme")
+	}
+
+	policy.allowedPorts = viper.GetIntSlice("egressPolicy.allowedPorts")
+
+	deniedCidrs := viper.GetStringSlice("egressPolicy.deniedCidrs")
+	if viper.GetBool("egressPolicy.denyPrivateNetworks") {
+		deniedCidrs = append(deniedCidrs, defaultDeniedCidrs...)
+	}
+	deniedNetworks, err := parseCidrs(deniedCidrs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid denied cidr: %w", err)
+	}
+	policy.deniedNetworks = deniedNetworks
+
+	allowedNetworks, err := parseCidrs(viper.GetStringSlice("egressPolicy.allowedCidrs"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid allowed cidr: %w", err)
+	}
+	policy.allowedNetworks = allowedNetworks
+
+	for _, host := range viper.GetStringSlice("egressPolicy.allowedHosts") {
+		policy.allowedHosts = append(policy.allowedHosts, strings.ToLower(host))
+	}
+
+	return policy, nil
+}
+
+func (p *EgressPolicy) isAllowedHost(host string) bool {
+	return contains(p.allowedHosts, strings.ToLower(strings.TrimSuffix(host, "."))) != -1
+}
+
+// returns an error if the ip address is in a denied network
+// and not in an allowed one
+func (p *EgressPolicy) CheckIP(ip net.IP) error {
+	for _, network := range p.allowedNetworks {
+		if network.Contains(ip) {
+			return nil
+		}
+	}
+
+	for _, network := range p.deniedNetworks {
+		if network.Contains(ip) {
+			return &EgressDeniedError{Reason: "address " + ip.String() + " is in denied network " + network.String()}
+		}
+	}
+
+	return nil
+}
+
+// checks scheme and port of the url, when `resolve` is true the host is
+// resolved and all its addresses are checked (addresses are checked again
+// at dial time, a host may resolve to different addresses later)
+func (p *EgressPolicy) CheckURL(rawUrl string, resolve bool) error {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return &EgressDeniedError{Reason: "invalid url"}
+	}
+
+	scheme := strings.ToLower(parsedUrl.Scheme)
+	if contains(p.allowedSchemes, scheme) == -1 {
+		return &EgressDeniedError{Reason: "scheme " + scheme + " not allowed, allowed: " + strings.Join(p.allowedSchemes, ",")}
+	}
+
+	host := parsedUrl.Hostname()
+	if host == "" {
+		return &EgressDeniedError{Reason: "missing host"}
+	}
+
+	port := parsedUrl.Port()
+	if port == "" {
+		if scheme == "https" {
+			port = "443"
+		} else {
+			port = "80"
+		}
+	}
+	if err := p.checkPort(port); err != nil {
+		return err
+	}
+
+	if p.isAllowedHost(host) {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return p.CheckIP(ip)
+	}
+
+	if !resolve {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ipAddrs, err := p.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		// not resolvable yet, checked at dial time
+		return nil
+	}
+	for _, ipAddr := range ipAddrs {
+		if err := p.CheckIP(ipAddr.IP); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *EgressPolicy) checkPort(port string) error {
+	if len(p.allowedPorts) == 0 {
+		return nil
+	}
+
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return &EgressDeniedError{Reason: "invalid port " + port}
+	}
+	for _, allowedPort := range p.allowedPorts {
+		if allowedPort == portNumber {
+			return nil
+		}
+	}
+
+	return &EgressDeniedError{Reason: "port " + port + " not allowed"}
+}
+
+// returns a dial function that checks the address actually connected
+// to, after DNS resolution, so a host can't be rebound to a denied address
+func (p *EgressPolicy) DialContext(dialer *net.Dialer) func(ctx context.Context, network, address string) (net.Conn, error) {
+	checkedDialer := *dialer
+	checkedDialer.Control = func(network, address string, c syscall.RawConn) error {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		if err := p.checkPort(port); err != nil {
+			return err
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return &EgressDeniedError{Reason: "unexpected address " + host}
+		}
+		return p.CheckIP(ip)
+	}
+
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		// trusted receivers are not checked
+		if p.isAllowedHost(host) {
+			return dialer.DialContext(ctx, network, address)
+		}
+
+		return checkedDialer.DialContext(ctx, network, address)
+	}
+}
@@ -0,0 +1,268 @@
+package utils
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// minimal DNS server answering every A query with the current address
+// and AAAA queries without record, to rebind a host between two lookups
+type rebindingDns struct {
+	conn    net.PacketConn
+	mu      sync.Mutex
+	address net.IP
+}
+
+func startRebindingDns(t *testing.T, address string) *rebindingDns {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	dns := &rebindingDns{conn: conn, address: net.ParseIP(address)}
+	go dns.serve()
+	return dns
+}
+
+func (d *rebindingDns) rebind(address string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.address = net.ParseIP(address)
+}
+
+func (d *rebindingDns) serve() {
+	query := make([]byte, 512)
+	for {
+		n, client, err := d.conn.ReadFrom(query)
+		if err != nil {
+			return
+		}
+
+		// the question (name labels, type and class) follows the 12 bytes header
+		questionEnd := 12
+		for questionEnd < n && query[questionEnd] != 0 {
+			questionEnd += int(query[questionEnd]) + 1
+		}
+		questionEnd += 5
+		if questionEnd > n {
+			continue
+		}
+		questionType := binary.BigEndian.Uint16(query[questionEnd-4:])
+
+		// same id, response with recursion available, one question
+		response := append([]byte{query[0], query[1]}, 0x81, 0x80, 0, 1, 0, 0, 0, 0, 0, 0)
+		response = append(response, query[12:questionEnd]...)
+		if questionType == 1 {
+			d.mu.Lock()
+			address := d.address.To4()
+			d.mu.Unlock()
+
+			response[7] = 1
+			// name pointer to the question, type A, class IN, ttl 0, 4 bytes
+			response = append(response, 0xc0, 12, 0, 1, 0, 1, 0, 0, 0, 0, 0, 4)
+			response = append(response, address...)
+		}
+		d.conn.WriteTo(response, client)
+	}
+}
+
+func (d *rebindingDns) resolver() *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "udp", d.conn.LocalAddr().String())
+		},
+	}
+}
+
+func mustParseCidrs(t *testing.T, cidrs ...string) []*net.IPNet {
+	networks, err := parseCidrs(cidrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return networks
+}
+
+func isEgressDenied(err error) bool {
+	var deniedErr *EgressDeniedError
+	return errors.As(err, &deniedErr)
+}
+
+func TestEgressPolicyDeniedAddresses(t *testing.T) {
+	policy := &EgressPolicy{
+		deniedNetworks:  mustParseCidrs(t, defaultDeniedCidrs...),
+		allowedNetworks: mustParseCidrs(t, "10.20.0.0/16"),
+	}
+
+	for address, denied := range map[string]bool{
+		"93.184.216.34":                      false,
+		"2606:2800:220:1:248:1893:25c8:1946": false,
+		"127.0.0.1":                          true,
+		"::1":                                true,
+		"::ffff:127.0.0.1":                   true,
+		"0.0.0.0":                            true,
+		"10.1.2.3":                           true,
+		"172.31.255.255":                     true,
+		"192.168.1.1":                        true,
+		"100.64.0.1":                         true,
+		"169.254.169.254":                    true,
+		"224.0.0.1":                          true,
+		"fd00::1":                            true,
+		"fe80::1":                            true,
+		// private but explicitly allowed
+		"10.20.30.40": false,
+	} {
+		if err := policy.CheckIP(net.ParseIP(address)); isEgressDenied(err) != denied {
+			t.Errorf("address %s: expected denied %v, got %v", address, denied, err)
+		}
+	}
+}
+
+func TestEgressPolicyCheckURL(t *testing.T) {
+	policy := &EgressPolicy{
+		allowedSchemes: []string{"https", "http"},
+		allowedPorts:   []int{80, 443},
+		deniedNetworks: mustParseCidrs(t, defaultDeniedCidrs...),
+		allowedHosts:   []string{"receiver.internal"},
+	}
+
+	tests := []struct {
+		url    string
+		denied bool
+	}{
+		{"https://example.com/hook", false},
+		{"http://93.184.216.34/hook", false},
+		{"ftp://example.com/hook", true},
+		{"https:///hook", true},
+		{"https://example.com:8443/hook", true},
+		{"http://127.0.0.1/hook", true},
+		{"http://[::1]/hook", true},
+		{"http://169.254.169.254/latest/meta-data", true},
+		{"https://RECEIVER.internal./hook", false},
+		{"https://receiver.internal:8443/hook", true},
+	}
+	for _, test := range tests {
+		if err := policy.CheckURL(test.url, false); isEgressDenied(err) != test.denied {
+			t.Errorf("%s: expected denied %v, got %v", test.url, test.denied, err)
+		}
+	}
+}
+
+func TestNewEgressPolicyConfiguration(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	viper.Set("egressPolicy.allowedSchemes", []string{"HTTPS"})
+	viper.Set("egressPolicy.denyPrivateNetworks", false)
+	viper.Set("egressPolicy.deniedCidrs", []string{"198.51.100.0/24"})
+	policy, err := NewEgressPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := policy.CheckURL("https://10.0.0.1/hook", false); err != nil {
+		t.Errorf("private networks allowed by configuration, got %v", err)
+	}
+	if err := policy.CheckURL("https://198.51.100.7/hook", false); !isEgressDenied(err) {
+		t.Errorf("expected configured denied cidr to be denied, got %v", err)
+	}
+
+	viper.Set("egressPolicy.allowedCidrs", []string{"10.0.0.0/33"})
+	if _, err := NewEgressPolicy(); err == nil {
+		t.Error("expected an error with an invalid allowed cidr")
+	}
+
+	viper.Set("egressPolicy.allowedSchemes", []string{})
+	if _, err := NewEgressPolicy(); err == nil {
+		t.Error("expected an error without allowed schemes")
+	}
+}
+
+// the Control hook checks the address the socket connects to, whatever
+// the url check saw
+func TestEgressPolicyDialControlHook(t *testing.T) {
+	policy := &EgressPolicy{
+		allowedPorts:   []int{443},
+		deniedNetworks: mustParseCidrs(t, defaultDeniedCidrs...),
+	}
+	dial := policy.DialContext(&net.Dialer{Timeout: time.Second})
+
+	for _, address := range []string{"169.254.169.254:443", "10.0.0.1:443", "127.0.0.1:443", "93.184.216.34:8080"} {
+		if _, err := dial(context.Background(), "tcp4", address); !isEgressDenied(err) {
+			t.Errorf("expected dial to %s to be denied, got %v", address, err)
+		}
+	}
+}
+
+func TestEgressPolicyDialRechecksRebindedHost(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer receiver.Close()
+	_, port, _ := net.SplitHostPort(receiver.Listener.Addr().String())
+
+	dns := startRebindingDns(t, "93.184.216.34")
+	policy := &EgressPolicy{
+		allowedSchemes: []string{"http"},
+		deniedNetworks: mustParseCidrs(t, defaultDeniedCidrs...),
+		resolver:       dns.resolver(),
+	}
+
+	hookUrl := "http://rebind.example:" + port + "/hook"
+	if err := policy.CheckURL(hookUrl, true); err != nil {
+		t.Fatalf("expected public address to be allowed, got %v", err)
+	}
+
+	// between the registration check and the delivery the host is
+	// rebound to the loopback address of the receiver
+	dns.rebind("127.0.0.1")
+	if err := policy.CheckURL(hookUrl, true); !isEgressDenied(err) {
+		t.Fatalf("expected rebound address to be denied at resolution, got %v", err)
+	}
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: policy.DialContext(&net.Dialer{Timeout: time.Second, Resolver: dns.resolver()}),
+	}}
+	if _, err := client.Get(hookUrl); !isEgressDenied(err) {
+		t.Fatalf("expected delivery to the rebound address to be denied, got %v", err)
+	}
+
+	// the denied address was the one really connected to: once allowed,
+	// the same request reaches the receiver
+	policy.allowedNetworks = mustParseCidrs(t, "127.0.0.0/8")
+	resp, err := client.Get(hookUrl)
+	if err != nil {
+		t.Fatalf("expected delivery to an allowed address, got %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestEgressPolicyRedirectToDeniedAddress(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+	}))
+	defer receiver.Close()
+
+	policy := &EgressPolicy{
+		allowedSchemes:  []string{"http"},
+		deniedNetworks:  mustParseCidrs(t, defaultDeniedCidrs...),
+		allowedNetworks: mustParseCidrs(t, "127.0.0.1/32"),
+	}
+	client := &http.Client{
+		Transport: &http.Transport{DialContext: policy.DialContext(&net.Dialer{Timeout: time.Second})},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return policy.CheckURL(req.URL.String(), false)
+		},
+	}
+
+	if _, err := client.Get(receiver.URL); !isEgressDenied(err) {
+		t.Errorf("expected redirect to the metadata address to be denied, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"zebrahook/constants"
+	"zebrahook/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// new status of an endpoint with the reason of the change,
+// actor fields are empty for automatic changes
+type EndpointStatusChange struct {
+	Status        string
+	Reason        string
+	ActorApiKeyID *uint
+	ActorSubject  *string
+}
+
+// updates the endpoint status and records the change in the endpoint status
+// history, nothing is done if the status doesn't change (false returned)
+func SetEndpointStatus(db *gorm.DB, endpointId string, change EndpointStatusChange) (bool, error) {
+	changed := false
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// row locked so concurrent changes are recorded in order
+		var endpoint models.Endpoint
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status").Where("id = ?", endpointId).First(&endpoint).Error; err != nil {
+			return err
+		}
+
+		if endpoint.Status == change.Status {
+			return nil
+		}
+
+		var disabledReason *string
+		if change.Status == constants.StatusDisabled {
+			disabledReason = &change.Reason
+		}
+
+		if err := tx.Model(&models.Endpoint{}).Where("id = ?", endpointId).Updates(map[string]interface{}{
+			"status":          change.Status,
+			"disabled_reason": disabledReason,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&models.EndpointStatusEvent{
+			EndpointID:    endpointId,
+			OldStatus:     endpoint.Status,
+			NewStatus:     change.Status,
+			Reason:        change.Reason,
+			ActorApiKeyID: change.ActorApiKeyID,
+			ActorSubject:  change.ActorSubject,
+		}).Error; err != nil {
+			return err
+		}
+
+		changed = true
+		return nil
+	})
+
+	return changed && err == nil, err
+}
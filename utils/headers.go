@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// maximum number of custom headers per endpoint
+const MaxCustomHeaders = 20
+
+// value returned in place of the custom header values
+const MaskedHeaderValue = "********"
+
+// headers set by zebrahook or by the http client, can't be set per endpoint
+var reservedHeaders = []string{
+	"Host",
+	"Content-Type",
+	"Content-Length",
+	"Content-Encoding",
+	"Transfer-Encoding",
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Upgrade",
+	"User-Agent",
+}
+
+// header field name token (RFC 7230)
+var headerNameRegex = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// returns true if the header can't be set per endpoint,
+// the configured signature header is reserved as well
+func IsReservedHeader(name string) bool {
+	canonicalName := http.CanonicalHeaderKey(name)
+	if canonicalName == http.CanonicalHeaderKey(viper.GetString("webhookRequest.signatureHeaderName")) {
+		return true
+	}
+	return contains(reservedHeaders, canonicalName) != -1
+}
+
+// returns an error if the custom headers of an endpoint are not valid
+func ValidateCustomHeaders(headers map[string]string) error {
+	if len(headers) > MaxCustomHeaders {
+		return fmt.Errorf("too many headers, maximum allowed is %d", MaxCustomHeaders)
+	}
+
+	for name, value := range headers {
+		if !headerNameRegex.MatchString(name) {
+			return errors.New("invalid header name " + name)
+		}
+		if IsReservedHeader(name) {
+			return errors.New("header " + name + " is reserved")
+		}
+		if value == "" || strings.ContainsAny(value, "\r\n\x00") {
+			return errors.New("invalid value for header " + name)
+		}
+	}
+
+	return nil
+}
+
+// encrypts the custom headers of an endpoint, nil if there are no headers
+func EncryptHeaders(headers map[string]string) (*string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	headersEncrypted, err := EncryptSecret(string(encodedHeaders))
+	if err != nil {
+		return nil, err
+	}
+
+	return &headersEncrypted, nil
+}
+
+// decrypts the custom headers encrypted by `EncryptHeaders`
+func DecryptHeaders(headersEncrypted *string) (map[string]string, error) {
+	headers := map[string]string{}
+	if headersEncrypted == nil {
+		return headers, nil
+	}
+
+	decryptedHeaders, err := DecryptSecret(*headersEncrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(decryptedHeaders), &headers); err != nil {
+		return nil, err
+	}
+
+	return headers, nil
+}
+
+// returns the custom header names with masked values, nil if there are no headers
+func MaskHeaders(headersEncrypted *string) map[string]string {
+	headers, err := DecryptHeaders(headersEncrypted)
+	if err != nil || len(headers) == 0 {
+		return nil
+	}
+
+	return MaskHeaderValues(headers)
+}
+
+// returns the header names with masked values, e.g. to log a request payload
+func MaskHeaderValues(headers map[string]string) map[string]string {
+	if headers == nil {
+		return nil
+	}
+
+	maskedHeaders := map[string]string{}
+	for name := range headers {
+		maskedHeaders[name] = MaskedHeaderValue
+	}
+
+	return maskedHeaders
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// SMTP client of the email notifications
+type Mailer struct {
+	// host:port the connection is made to
+	address string
+	// server name used by STARTTLS and the authentication
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// builds a mailer based on the `notifications.smtp` configuration
+func NewMailer() *Mailer {
+	host := viper.GetString("notifications.smtp.host")
+	return &Mailer{
+		address:  net.JoinHostPort(host, strconv.Itoa(viper.GetInt("notifications.smtp.port"))),
+		host:     host,
+		username: viper.GetString("notifications.smtp.username"),
+		password: viper.GetString("notifications.smtp.password"),
+		from:     viper.GetString("notifications.smtp.from"),
+	}
+}
+
+// checks an email address set by a tenant (e.g. in the endpoint metadata): a
+// single plain address, without display name, in one of the domains if any
+func CheckTenantEmail(address string, domains []string) error {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return err
+	}
+	if parsed.Name != "" || parsed.Address != address {
+		return errors.New("expected a plain email address")
+	}
+	if len(domains) == 0 {
+		return nil
+	}
+
+	domain := address[strings.LastIndex(address, "@")+1:]
+	for _, allowedDomain := range domains {
+		if strings.EqualFold(domain, allowedDomain) {
+			return nil
+		}
+	}
+	return errors.New("email domain " + domain + " not allowed")
+}
+
+// sends a plain text email, STARTTLS is used when supported by the server
+func (m *Mailer) Send(to []string, subject string, body string) error {
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return err
+	}
+
+	recipients := []string{}
+	for _, address := range to {
+		recipient, err := mail.ParseAddress(address)
+		if err != nil {
+			return err
+		}
+		recipients = append(recipients, recipient.Address)
+	}
+
+	conn, err := net.DialTimeout("tcp", m.address, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(30 * time.Second))
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+
+	if m.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return err
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+
+	// subject can't contain line breaks, they would start new headers
+	subject = strings.NewReplacer("\r", " ", "\n", " ").Replace(subject)
+
+	message := "From: " + from.String() + "\r\n" +
+		"To: " + strings.Join(recipients, ", ") + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"Date: " + time.Now().Format(time.RFC1123Z) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		strings.ReplaceAll(body, "\n", "\r\n")
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write([]byte(message)); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+type receivedEmail struct {
+	from       string
+	recipients []string
+	data       string
+}
+
+// minimal SMTP server (no STARTTLS, no auth) keeping the received emails,
+// recipients of the `rejected.example` domain are refused
+type smtpStub struct {
+	listener net.Listener
+	emails   chan receivedEmail
+}
+
+func startSmtpStub(t *testing.T) *smtpStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	stub := &smtpStub{listener: listener, emails: make(chan receivedEmail, 10)}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go stub.serve(conn)
+		}
+	}()
+	return stub
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	text := textproto.NewConn(conn)
+	defer text.Close()
+
+	text.PrintfLine("220 stub ESMTP")
+	email := receivedEmail{}
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command, argument, _ := strings.Cut(line, " ")
+		// MAIL FROM:<address> [parameters] and RCPT TO:<address>
+		_, address, _ := strings.Cut(argument, "<")
+		address, _, _ = strings.Cut(address, ">")
+
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			text.PrintfLine("250-stub")
+			text.PrintfLine("250 8BITMIME")
+		case "MAIL":
+			email.from = address
+			text.PrintfLine("250 OK")
+		case "RCPT":
+			if strings.HasSuffix(address, "@rejected.example") {
+				text.PrintfLine("550 mailbox unavailable")
+				continue
+			}
+			email.recipients = append(email.recipients, address)
+			text.PrintfLine("250 OK")
+		case "DATA":
+			text.PrintfLine("354 end with <CRLF>.<CRLF>")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			email.data = string(data)
+			s.emails <- email
+			email = receivedEmail{}
+			text.PrintfLine("250 OK")
+		case "QUIT":
+			text.PrintfLine("221 bye")
+			return
+		default:
+			text.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func (s *smtpStub) mailer() *Mailer {
+	return &Mailer{
+		address: s.listener.Addr().String(),
+		host:    "127.0.0.1",
+		from:    "Zebrahook <zebrahook@example.com>",
+	}
+}
+
+func TestMailerSend(t *testing.T) {
+	stub := startSmtpStub(t)
+
+	err := stub.mailer().Send(
+		[]string{"ops@example.com", "Owner <owner@example.org>"},
+		"[Zebrahook] zebrahook.endpoint.disabled\r\nBcc: victim@example.net",
+		"first line\nsecond line\n",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	email := <-stub.emails
+	if email.from != "zebrahook@example.com" {
+		t.Errorf("unexpected sender %q", email.from)
+	}
+	if strings.Join(email.recipients, ",") != "ops@example.com,owner@example.org" {
+		t.Errorf("unexpected recipients %v", email.recipients)
+	}
+
+	// the line break of the subject must not add a Bcc header
+	headers, body, _ := strings.Cut(email.data, "\n\n")
+	for _, expected := range []string{
+		"From: \"Zebrahook\" <zebrahook@example.com>",
+		"To: ops@example.com, owner@example.org",
+		"Subject: [Zebrahook] zebrahook.endpoint.disabled  Bcc: victim@example.net",
+		"Content-Type: text/plain; charset=utf-8",
+	} {
+		if !strings.Contains(headers+"\n", expected+"\n") {
+			t.Errorf("expected header %q in\n%s", expected, headers)
+		}
+	}
+	if strings.Contains(headers, "\nBcc:") {
+		t.Errorf("unexpected Bcc header in\n%s", headers)
+	}
+	if body != "first line\nsecond line\n" {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestMailerSendErrors(t *testing.T) {
+	stub := startSmtpStub(t)
+
+	if err := stub.mailer().Send([]string{"ops@example.com", "owner@rejected.example"}, "subject", "body"); err == nil {
+		t.Error("expected an error when a recipient is refused")
+	}
+	if err := stub.mailer().Send([]string{"not an address"}, "subject", "body"); err == nil {
+		t.Error("expected an error with an invalid recipient")
+	}
+
+	unreachable := stub.mailer()
+	stub.listener.Close()
+	if err := unreachable.Send([]string{"ops@example.com"}, "subject", "body"); err == nil {
+		t.Error("expected an error when the server is unreachable")
+	}
+
+	select {
+	case email := <-stub.emails:
+		t.Errorf("unexpected email sent %+v", email)
+	default:
+	}
+}
+
+func TestCheckTenantEmail(t *testing.T) {
+	tests := []struct {
+		address string
+		domains []string
+		valid   bool
+	}{
+		{"owner@example.com", nil, true},
+		{"owner@Example.COM", []string{"example.com"}, true},
+		{"owner@example.org", []string{"example.com", "example.org"}, true},
+		{"owner@example.net", []string{"example.com"}, false},
+		{"owner@example.com.attacker.net", []string{"example.com"}, false},
+		{"Owner <owner@example.com>", nil, false},
+		{"owner@example.com, victim@example.net", nil, false},
+		{"<owner@example.com>", nil, false},
+		{"owner", nil, false},
+		{"", nil, false},
+	}
+	for _, test := range tests {
+		if err := CheckTenantEmail(test.address, test.domains); (err == nil) != test.valid {
+			t.Errorf("%q with domains %v: expected valid %v, got %v", test.address, test.domains, test.valid, err)
+		}
+	}
+}
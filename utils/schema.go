@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// compiles a JSON Schema (draft 2020-12 unless `$schema` is provided),
+// references to external documents are not resolved
+func CompileJsonSchema(schema []byte) (*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, errors.New("external schema references are not allowed: " + url)
+	}
+
+	// absolute url, relative ones are resolved against the working directory
+	const schemaUrl = "zebrahook:///schema.json"
+	if err := compiler.AddResource(schemaUrl, bytes.NewReader(schema)); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile(schemaUrl)
+}
+
+// validates a json document against the schema, returns
+// one message for each violation (empty if valid)
+func ValidateJsonSchema(schema *jsonschema.Schema, document []byte) []string {
+	decoder := json.NewDecoder(bytes.NewReader(document))
+	// keep numbers as they are
+	decoder.UseNumber()
+
+	var decodedDocument interface{}
+	if err := decoder.Decode(&decodedDocument); err != nil {
+		return []string{"invalid json: " + err.Error()}
+	}
+
+	err := schema.Validate(decodedDocument)
+	if err == nil {
+		return []string{}
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []string{err.Error()}
+	}
+
+	// report only the innermost errors, e.g. `/order/id: expected string, but got number`
+	violations := []string{}
+	var collect func(*jsonschema.ValidationError)
+	collect = func(ve *jsonschema.ValidationError) {
+		if len(ve.Causes) == 0 {
+			location := ve.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			violations = append(violations, location+": "+ve.Message)
+			return
+		}
+		for _, cause := range ve.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+
+	return violations
+}
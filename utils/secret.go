@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"encoding/hex"
+	"zebrahook/cryptopasta"
+
+	"github.com/spf13/viper"
+)
+
+func getEncryptionKey() *[32]byte {
+	encryptKeyStr := viper.GetString("encryptionKey")
+	var encryptionKey [32]byte
+	copy(encryptionKey[:], encryptKeyStr)
+
+	return &encryptionKey
+}
+
+// encrypt a webhook secret with the configured encryption key,
+// result is hex encoded to be stored into the database
+func EncryptSecret(secret string) (string, error) {
+	secretEncrypted, err := cryptopasta.Encrypt([]byte(secret), getEncryptionKey())
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secretEncrypted), nil
+}
+
+// decrypt a hex encoded webhook secret encrypted by `EncryptSecret`
+func DecryptSecret(secretEncryptedHex string) (string, error) {
+	secretEncrypted, err := hex.DecodeString(secretEncryptedHex)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := cryptopasta.Decrypt(secretEncrypted, getEncryptionKey())
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
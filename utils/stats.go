@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"zebrahook/models"
+)
+
+// size of the endpoint stats rollup buckets
+const StatsBucketSecs int64 = 300
+
+// upper bounds (seconds) of the response time histogram buckets, indexed
+// like postgres width_bucket: the first histogram bucket counts the responses
+// faster than the first bound, the last one the responses slower than the last bound
+var ResponseTimeBounds = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// selectable endpoint stats windows
+var StatsWindows = map[string]int64{
+	"1h":  3600,
+	"24h": 86400,
+	"7d":  604800,
+}
+
+// delivery attempts stats of an endpoint merged from the rollup buckets
+type EndpointStats struct {
+	Attempts            uint
+	Successes           uint
+	StatusCounts        map[string]uint
+	HttpStatusCounts    map[string]uint
+	ResponseTimes       uint
+	ResponseTimeBuckets []int64
+	ResponseTimeMaxSecs float64
+}
+
+func MergeStatsRollups(rollups []models.EndpointStatsRollup) EndpointStats {
+	stats := EndpointStats{
+		StatusCounts:        map[string]uint{},
+		HttpStatusCounts:    map[string]uint{},
+		ResponseTimeBuckets: make([]int64, len(ResponseTimeBounds)+1),
+	}
+
+	for _, rollup := range rollups {
+		stats.Attempts += rollup.Attempts
+		stats.Successes += rollup.Successes
+
+		var statusCounts, httpStatusCounts map[string]uint
+		json.Unmarshal(rollup.StatusCounts, &statusCounts)
+		json.Unmarshal(rollup.HttpStatusCounts, &httpStatusCounts)
+		for status, count := range statusCounts {
+			stats.StatusCounts[status] += count
+		}
+		for httpStatus, count := range httpStatusCounts {
+			stats.HttpStatusCounts[httpStatus] += count
+		}
+
+		for i, count := range rollup.ResponseTimeHistogram {
+			if i < len(stats.ResponseTimeBuckets) {
+				stats.ResponseTimeBuckets[i] += count
+				stats.ResponseTimes += uint(count)
+			}
+		}
+		stats.ResponseTimeMaxSecs = math.Max(stats.ResponseTimeMaxSecs, rollup.ResponseTimeMaxSecs)
+	}
+
+	return stats
+}
+
+// share of successful attempts, nil without attempts
+func (s EndpointStats) SuccessRate() *float64 {
+	if s.Attempts == 0 {
+		return nil
+	}
+	rate := float64(s.Successes) / float64(s.Attempts)
+	return &rate
+}
+
+// response time percentile (0-100) estimated from the histogram by linear
+// interpolation within the bucket holding it (the last bucket ends with the
+// slowest response), nil without response times
+func (s EndpointStats) ResponseTimePercentile(percentile float64) *float64 {
+	if s.ResponseTimes == 0 {
+		return nil
+	}
+
+	rank := percentile / 100 * float64(s.ResponseTimes)
+	var seen int64
+	for i, count := range s.ResponseTimeBuckets {
+		if count == 0 || float64(seen+count) < rank {
+			seen += count
+			continue
+		}
+
+		lowerBound := 0.0
+		if i > 0 {
+			lowerBound = ResponseTimeBounds[i-1]
+		}
+		upperBound := s.ResponseTimeMaxSecs
+		if i < len(ResponseTimeBounds) {
+			upperBound = math.Min(ResponseTimeBounds[i], s.ResponseTimeMaxSecs)
+		}
+
+		value := lowerBound + (upperBound-lowerBound)*(rank-float64(seen))/float64(count)
+		value = math.Max(lowerBound, math.Min(value, upperBound))
+		return &value
+	}
+
+	return &s.ResponseTimeMaxSecs
+}
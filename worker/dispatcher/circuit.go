@@ -0,0 +1,363 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"time"
+	"zebrahook/constants"
+	"zebrahook/database"
+	"zebrahook/models"
+
+	"github.com/nya1/pgq"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+type CircuitProbe struct {
+	EndpointId string
+}
+
+// held delivery with its attempt not yet made
+type heldDelivery struct {
+	EventDeliveryId        uint
+	EventDeliveryAttemptId uint
+	EventId                uint
+	AttemptsCounter        uint
+}
+
+// returns true if the delivery can be sent to the endpoint based on the circuit
+// state, while half open only the delivery that claims the probe is sent
+func (app *workerPgGo) circuitAllowsDelivery(endpoint models.Endpoint, eventDeliveryId uint) bool {
+	now := time.Now().Unix()
+
+	switch endpoint.CircuitState {
+	case constants.CircuitOpen:
+		if endpoint.CircuitNextProbeAt == nil || *endpoint.CircuitNextProbeAt > now {
+			return false
+		}
+		// the probe is due and its job didn't run yet (or was lost), the delivery becomes the probe
+		result := app.gormDb.Model(&models.Endpoint{}).
+			Where("id = ? AND circuit_state = ? AND circuit_next_probe_at <= ?", endpoint.Id, constants.CircuitOpen, now).
+			Updates(map[string]interface{}{
+				"circuit_state":             constants.CircuitHalfOpen,
+				"circuit_probe_delivery_id": eventDeliveryId,
+				"circuit_next_probe_at":     now + int64(viper.GetUint("circuitBreaker.probeIntervalSecs")),
+			})
+		return result.Error == nil && result.RowsAffected == 1
+	case constants.CircuitHalfOpen:
+		// a probe not completed within the probe interval can be claimed again
+		result := app.gormDb.Model(&models.Endpoint{}).
+			Where("id = ? AND circuit_state = ?", endpoint.Id, constants.CircuitHalfOpen).
+			Where("circuit_probe_delivery_id IS NULL OR circuit_probe_delivery_id = ? OR circuit_next_probe_at <= ?", eventDeliveryId, now).
+			Updates(map[string]interface{}{
+				"circuit_probe_delivery_id": eventDeliveryId,
+				"circuit_next_probe_at":     now + int64(viper.GetUint("circuitBreaker.probeIntervalSecs")),
+			})
+		return result.Error == nil && result.RowsAffected == 1
+	}
+
+	return true
+}
+
+// holds the delivery until the circuit closes, the attempt is left pending
+func (app *workerPgGo) holdDelivery(eventDeliveryId uint) {
+	app.gormDb.Model(&models.EventDelivery{}).Where("id = ? AND status = ?", eventDeliveryId, constants.DeliveryStatusPending).
+		Updates(map[string]interface{}{
+			"status":                    constants.DeliveryStatusHeld,
+			"next_attempt_scheduled_at": nil,
+		})
+}
+
+// attempts not sent to the receiver (denied by the egress policy or not
+// prepared) don't tell anything about its health
+var nonReceiverAttemptStatuses = []string{constants.AttemptStatusErrorBlocked, constants.AttemptStatusErrorInternal}
+
+// updates the circuit of the endpoint with the outcome of a delivery attempt
+func (app *workerPgGo) recordCircuitOutcome(endpointId string, eventDeliveryId uint, attemptStatus string, logger zerolog.Logger) {
+	thisLogger := logger.With().Str("endpointId", endpointId).Logger()
+
+	for _, nonReceiverStatus := range nonReceiverAttemptStatuses {
+		if attemptStatus == nonReceiverStatus {
+			// a probe not sent is given up, the next delivery probes the endpoint
+			app.gormDb.Model(&models.Endpoint{}).
+				Where("id = ? AND circuit_state = ? AND circuit_probe_delivery_id = ?", endpointId, constants.CircuitHalfOpen, eventDeliveryId).
+				Update("circuit_probe_delivery_id", nil)
+			return
+		}
+	}
+	success := attemptStatus == constants.AttemptStatusSuccess
+
+	var endpoint models.Endpoint
+	if result := app.gormDb.Where("id = ?", endpointId).First(&endpoint); result.Error != nil {
+		return
+	}
+
+	// outcome of the probe
+	if endpoint.CircuitState == constants.CircuitHalfOpen {
+		if endpoint.CircuitProbeDeliveryID == nil || *endpoint.CircuitProbeDeliveryID != eventDeliveryId {
+			return
+		}
+
+		if success {
+			result := app.gormDb.Model(&models.Endpoint{}).Where("id = ? AND circuit_state = ?", endpointId, constants.CircuitHalfOpen).
+				Updates(map[string]interface{}{
+					"circuit_state":             constants.CircuitClosed,
+					"circuit_opened_at":         nil,
+					"circuit_closed_at":         time.Now().Unix(),
+					"circuit_next_probe_at":     nil,
+					"circuit_probe_delivery_id": nil,
+				})
+			if result.RowsAffected == 1 {
+				thisLogger.Info().Msg("probe delivered, closing circuit")
+				app.releaseHeldDeliveries(endpointId, 0, thisLogger)
+			}
+		} else {
+			thisLogger.Info().Msg("probe failed, opening circuit again")
+			app.openCircuit(endpointId, constants.CircuitHalfOpen, thisLogger)
+		}
+		return
+	}
+
+	if success || endpoint.CircuitState != constants.CircuitClosed {
+		return
+	}
+
+	// failure ratio over the sliding window
+	var windowStats struct {
+		Total    int64
+		Failures int64
+	}
+	// the failures that opened the circuit before it closed again are not counted
+	windowStart := time.Now().Unix() - int64(viper.GetUint("circuitBreaker.windowSecs"))
+	if endpoint.CircuitClosedAt != nil && *endpoint.CircuitClosedAt > windowStart {
+		windowStart = *endpoint.CircuitClosedAt
+	}
+	result := app.gormDb.Raw(`SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE a.status <> ?) AS failures
+	FROM event_delivery_attempts a
+	JOIN event_deliveries d ON d.id = a.event_delivery_id
+	WHERE d.endpoint_id = ? AND a.attempt_made_at >= ? AND a.status NOT IN ?
+	`, constants.AttemptStatusSuccess, endpointId, windowStart, nonReceiverAttemptStatuses).Scan(&windowStats)
+	if result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to compute endpoint failure ratio")
+		return
+	}
+
+	if windowStats.Total < int64(viper.GetUint("circuitBreaker.minRequests")) {
+		return
+	}
+	failureRatio := float64(windowStats.Failures) / float64(windowStats.Total)
+	if failureRatio < viper.GetFloat64("circuitBreaker.failureRatio") {
+		return
+	}
+
+	thisLogger.Warn().Int64("attempts", windowStats.Total).Float64("failureRatio", failureRatio).Msg("failure ratio above threshold, opening circuit")
+	if app.openCircuit(endpointId, constants.CircuitClosed, thisLogger) {
+		app.emitSystemEvent(endpoint, constants.SystemEventEndpointFailing, map[string]interface{}{
+			"failure_ratio":     failureRatio,
+			"attempts":          windowStats.Total,
+			"window_secs":       viper.GetUint("circuitBreaker.windowSecs"),
+			"circuit_opened_at": time.Now().Unix(),
+		}, thisLogger)
+	}
+}
+
+// opens the circuit if still in `fromState` and schedules the next probe in
+// the same transaction (an open circuit only closes through its probe),
+// returns false if the circuit was not in `fromState` or couldn't be opened
+func (app *workerPgGo) openCircuit(endpointId string, fromState string, logger zerolog.Logger) bool {
+	now := time.Now()
+	nextProbeAt := now.Add(time.Duration(viper.GetUint("circuitBreaker.probeIntervalSecs")) * time.Second)
+
+	circuitUpdate := map[string]interface{}{
+		"circuit_state":             constants.CircuitOpen,
+		"circuit_next_probe_at":     nextProbeAt.Unix(),
+		"circuit_probe_delivery_id": nil,
+	}
+	if fromState == constants.CircuitClosed {
+		circuitUpdate["circuit_opened_at"] = now.Unix()
+	}
+
+	opened := false
+	err := app.gormDb.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Endpoint{}).Where("id = ? AND circuit_state = ?", endpointId, fromState).Updates(circuitUpdate)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			// already opened by another attempt
+			return nil
+		}
+
+		sqlTx, err := database.SqlTx(tx)
+		if err != nil {
+			return err
+		}
+		encodedProbe, _ := json.Marshal(CircuitProbe{EndpointId: endpointId})
+		if _, err := app.worker.EnqueueJobInTx(sqlTx, constants.QueueCircuitProbe, encodedProbe, pgq.After(nextProbeAt), pgq.RetryWaits([]time.Duration{})); err != nil {
+			return err
+		}
+
+		opened = true
+		return nil
+	})
+	if err != nil {
+		logger.Error().Stack().Err(err).Msg("unable to open circuit and schedule its probe")
+		return false
+	}
+	if !opened {
+		return false
+	}
+
+	logger.Info().Time("nextProbeAt", nextProbeAt).Msg("circuit opened, deliveries held")
+
+	return true
+}
+
+// job that moves an open circuit to half open and sends the oldest held
+// delivery as probe, without held deliveries the next new delivery is the probe
+func (app *workerPgGo) CircuitProbeJob(data []byte) error {
+	var decodedData CircuitProbe
+	json.Unmarshal(data, &decodedData)
+
+	thisLogger := app.logger.With().Str("endpointId", decodedData.EndpointId).Logger()
+
+	thisLogger.Info().Msg("started work")
+
+	// circuit breaker disabled since the circuit opened
+	if !viper.GetBool("circuitBreaker.enabled") {
+		app.resetCircuit(decodedData.EndpointId, thisLogger)
+		thisLogger.Info().Msg("job processed")
+		return nil
+	}
+
+	result := app.gormDb.Model(&models.Endpoint{}).Where("id = ? AND circuit_state = ?", decodedData.EndpointId, constants.CircuitOpen).
+		Updates(map[string]interface{}{
+			"circuit_state":             constants.CircuitHalfOpen,
+			"circuit_probe_delivery_id": nil,
+		})
+	if result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to update circuit state")
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		thisLogger.Info().Msg("circuit not open or endpoint deleted, skipping")
+		return nil
+	}
+
+	app.releaseHeldDeliveries(decodedData.EndpointId, 1, thisLogger)
+
+	thisLogger.Info().Msg("job processed")
+
+	return nil
+}
+
+// enqueues again the held deliveries of the endpoint (oldest first), 0 releases all
+func (app *workerPgGo) releaseHeldDeliveries(endpointId string, limit int, logger zerolog.Logger) {
+	query := app.gormDb.Table("event_deliveries d").
+		Select("d.id AS event_delivery_id, a.id AS event_delivery_attempt_id, d.event_id, d.attempts_counter").
+		Joins("JOIN event_delivery_attempts a ON a.event_delivery_id = d.id").
+		Where("d.endpoint_id = ? AND d.status = ? AND d.deleted_at IS NULL", endpointId, constants.DeliveryStatusHeld).
+		Where("a.status = ? AND a.attempt_made_at IS NULL", constants.AttemptStatusPending).
+		Order("d.id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var heldDeliveries []heldDelivery
+	if result := query.Scan(&heldDeliveries); result.Error != nil {
+		logger.Error().Stack().Err(result.Error).Msg("unable to query held deliveries")
+		return
+	}
+
+	now := uint(time.Now().Unix())
+	released := 0
+	for _, held := range heldDeliveries {
+		// the delivery stays held if its job can't be enqueued
+		releasedDelivery := false
+		err := app.gormDb.Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&models.EventDelivery{}).Where("id = ? AND status = ?", held.EventDeliveryId, constants.DeliveryStatusHeld).
+				Updates(map[string]interface{}{
+					"status":                    constants.DeliveryStatusPending,
+					"next_attempt_scheduled_at": now,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected != 1 {
+				// released by another job in the meantime
+				return nil
+			}
+
+			sqlTx, err := database.SqlTx(tx)
+			if err != nil {
+				return err
+			}
+			attemptCounter := int(held.AttemptsCounter) + 1
+			encodedEventData, _ := json.Marshal(EventDeliveryAttempt{
+				EventDeliveryAttemptId: held.EventDeliveryAttemptId,
+				EventId:                held.EventId,
+				EndpointId:             endpointId,
+				AttemptCounter:         &attemptCounter,
+			})
+			if _, err := app.worker.EnqueueJobInTx(sqlTx, constants.QueueWebhookDelivery, encodedEventData, pgq.RetryWaits([]time.Duration{})); err != nil {
+				return err
+			}
+
+			releasedDelivery = true
+			return nil
+		})
+		if err != nil {
+			logger.Error().Stack().Err(err).Uint("eventDeliveryId", held.EventDeliveryId).Msg("unable to release held delivery")
+			continue
+		}
+		if releasedDelivery {
+			released++
+		}
+	}
+
+	logger.Info().Int("released", released).Msg("held deliveries released")
+}
+
+// closes the circuit of the endpoint whatever its state and releases all its
+// held deliveries, used when the circuit breaker is disabled
+func (app *workerPgGo) resetCircuit(endpointId string, logger zerolog.Logger) {
+	result := app.gormDb.Model(&models.Endpoint{}).Where("id = ? AND circuit_state <> ?", endpointId, constants.CircuitClosed).
+		Updates(map[string]interface{}{
+			"circuit_state":             constants.CircuitClosed,
+			"circuit_opened_at":         nil,
+			"circuit_closed_at":         time.Now().Unix(),
+			"circuit_next_probe_at":     nil,
+			"circuit_probe_delivery_id": nil,
+		})
+	if result.Error != nil {
+		logger.Error().Stack().Err(result.Error).Msg("unable to close circuit")
+		return
+	}
+
+	app.releaseHeldDeliveries(endpointId, 0, logger)
+}
+
+// with the circuit breaker disabled nothing releases the deliveries held
+// while it was enabled, the circuits are closed when the worker starts
+func (app *workerPgGo) releaseCircuitsWhenDisabled() {
+	if viper.GetBool("circuitBreaker.enabled") {
+		return
+	}
+
+	heldDeliveries := app.gormDb.Model(&models.EventDelivery{}).Select("endpoint_id").Where("status = ?", constants.DeliveryStatusHeld)
+
+	var endpointIds []string
+	result := app.gormDb.Model(&models.Endpoint{}).
+		Where("circuit_state <> ? OR id IN (?)", constants.CircuitClosed, heldDeliveries).
+		Pluck("id", &endpointIds)
+	if result.Error != nil {
+		app.logger.Error().Stack().Err(result.Error).Msg("unable to query endpoints with held deliveries")
+		return
+	}
+
+	for _, endpointId := range endpointIds {
+		thisLogger := app.logger.With().Str("endpointId", endpointId).Logger()
+		thisLogger.Info().Msg("circuit breaker disabled, closing circuit")
+		app.resetCircuit(endpointId, thisLogger)
+	}
+}
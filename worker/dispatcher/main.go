@@ -7,19 +7,21 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"math"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"time"
 	"zebrahook/constants"
-	"zebrahook/cryptopasta"
 	"zebrahook/database"
 	"zebrahook/models"
 	"zebrahook/utils"
+	"zebrahook/worker/eventMapping"
 
 	gormLogger "gorm.io/gorm/logger"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/spf13/viper"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type workerPgGo struct {
@@ -44,124 +47,249 @@ type EventDeliveryAttempt struct {
 	AttemptCounter         *int
 }
 
-func (app *workerPgGo) CallWebhookEndpointJob(data []byte) error {
-	var decodedData EventDeliveryAttempt
-	json.Unmarshal(data, &decodedData)
-
-	thisLogger := app.logger.With().Uint("eventDeliveryAttemptId", decodedData.EventDeliveryAttemptId).Logger()
+// sign `<timestamp>.<payload>` with HMAC SHA256, result is hex encoded
+func signPayload(secret string, timestamp int64, payload string) string {
+	h := hmac.New(sha256.New, []byte(secret))
 
-	thisLogger.Info().Msg("started work")
+	payloadToSign := strconv.Itoa(int(timestamp)) + "." + payload
 
-	eventDeliveryAttemptId := decodedData.EventDeliveryAttemptId
-	eventId := decodedData.EventId
-	endpointId := decodedData.EndpointId
+	// Write Data to it
+	h.Write([]byte(payloadToSign))
 
-	var eventDeliveryAttempt models.EventDeliveryAttempt
-	app.gormDb.Find(&eventDeliveryAttempt, eventDeliveryAttemptId)
+	// Get result and encode as hexadecimal string
+	return hex.EncodeToString(h.Sum(nil))
+}
 
-	if eventDeliveryAttempt.AttemptMadeAt != nil {
-		panic("expected AttemptMadeAt to be empty")
-	}
+// outcome of an http request made to a webhook endpoint
+type WebhookResponse struct {
+	// unix timestamp (seconds) used to sign the request
+	Timestamp int64
 
-	// get event data
-	var eventData models.Event
-	app.gormDb.Find(&eventData, eventId)
+	// success, error_timeout, error_response, error_network, error_blocked or error_internal
+	Status string
 
-	// get endpoint url
-	var endpointToCall models.Endpoint
-	app.gormDb.Where("id = ?", endpointId).First(&endpointToCall)
+	// http status code and truncated body of the response (if any)
+	HttpStatusCode   *int
+	HttpBodyResponse *string
 
-	// sign event and make http request
+	// response time in seconds
+	HttpResponseTimeSecs float64
+}
 
+// signs the payload with the endpoint secret and sends it to the webhook endpoint,
+// only the outcome is returned, no event delivery is updated
+func SendWebhookRequest(endpoint models.Endpoint, payload string, logger zerolog.Logger) (*WebhookResponse, error) {
 	//    load webhook secret and decrypt
-	encryptKeyStr := viper.GetString("encryptionKey")
-	var encryptionKey [32]byte
-	copy(encryptionKey[:], encryptKeyStr)
-
-	webhookSecretStr, err := hex.DecodeString(endpointToCall.SecretEncrypted)
+	decryptedWebhookSecret, err := utils.DecryptSecret(endpoint.SecretEncrypted)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	logger.Debug().Msg("successfully decrypted webhook secret")
 
 	timestamp := time.Now().Unix()
 
-	decryptedWebhookSecret, _ := cryptopasta.Decrypt(webhookSecretStr, &encryptionKey)
-	thisLogger.Debug().Msg("successfully decrypted webhook secret")
+	logger.Debug().Str("signPayload", payload).Int64("sign_timestamp", timestamp).Msg("signing payload")
+	signatureHeader := "t=" + strconv.Itoa(int(timestamp)) + ",v1=" + signPayload(decryptedWebhookSecret, timestamp, payload)
 
-	h := hmac.New(sha256.New, decryptedWebhookSecret)
+	// during the grace period after a secret rotation sign also
+	// with the previous secret, receivers can accept either signature
+	if endpoint.PreviousSecretEncrypted != nil && endpoint.PreviousSecretExpiresAt != nil && *endpoint.PreviousSecretExpiresAt > timestamp {
+		decryptedPreviousSecret, err := utils.DecryptSecret(*endpoint.PreviousSecretEncrypted)
+		if err != nil {
+			return nil, err
+		}
+		signatureHeader += ",v1=" + signPayload(decryptedPreviousSecret, timestamp, payload)
+	}
+	logger.Debug().Str("signature", signatureHeader).Msg("sign process complete")
 
-	thisLogger.Debug().Str("signPayload", eventData.EventContent.String()).Int64("sign_timestamp", timestamp).Msg("signing payload")
-	payloadToSign := strconv.Itoa(int(timestamp)) + "." + eventData.EventContent.String()
+	webhookResponse := &WebhookResponse{
+		Timestamp: timestamp,
+	}
 
-	// Write Data to it
-	h.Write([]byte(payloadToSign))
+	egressPolicy, err := utils.NewEgressPolicy()
+	if err != nil {
+		return nil, err
+	}
 
-	// Get result and encode as hexadecimal string
-	signedPayload := hex.EncodeToString(h.Sum(nil))
-	thisLogger.Debug().Str("signature", signedPayload).Msg("sign process complete")
+	// fail fast on urls not allowed, resolved addresses are checked at dial time
+	if err := egressPolicy.CheckURL(endpoint.Url, false); err != nil {
+		logger.Warn().Err(err).Str("endpointId", endpoint.Id).Msg("webhook request blocked")
+		webhookResponse.Status = "error_blocked"
+		return webhookResponse, nil
+	}
 
 	//     http request
-	var jsonStr = []byte(eventData.EventContent.String())
-	req, _ := http.NewRequest("POST", endpointToCall.Url, bytes.NewBuffer(jsonStr))
+	var jsonStr = []byte(payload)
+	req, _ := http.NewRequest("POST", endpoint.Url, bytes.NewBuffer(jsonStr))
+
+	// custom headers of the endpoint, reserved ones can't be overridden
+	customHeaders, err := utils.DecryptHeaders(endpoint.HeadersEncrypted)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range customHeaders {
+		if utils.IsReservedHeader(name) {
+			logger.Warn().Str("header", name).Msg("skipping reserved custom header")
+			continue
+		}
+		req.Header.Set(name, value)
+	}
+
 	req.Header.Set("User-Agent", viper.GetString("webhookRequest.userAgent"))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set(viper.GetString("webhookRequest.signatureHeaderName"), "t="+strconv.Itoa(int(timestamp))+",v1="+signedPayload)
+	req.Header.Set(viper.GetString("webhookRequest.signatureHeaderName"), signatureHeader)
 
-	thisLogger.Debug().Interface("requestHeaders", req.Header).Msg("preparing request")
+	// custom header values may contain credentials
+	loggedHeaders := req.Header.Clone()
+	for name := range customHeaders {
+		if !utils.IsReservedHeader(name) {
+			loggedHeaders.Set(name, utils.MaskedHeaderValue)
+		}
+	}
+	logger.Debug().Interface("requestHeaders", loggedHeaders).Msg("preparing request")
 
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
 	client := &http.Client{
-		Timeout: time.Duration(viper.GetUint("webhookRequest.timeoutSecs")) * time.Second,
+		Timeout: time.Duration(utils.GetDeliveryPolicy(endpoint).TimeoutSecs) * time.Second,
+		// no proxy, the dialed address must be the receiver one
+		Transport: &http.Transport{
+			DialContext:         egressPolicy.DialContext(dialer),
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		// redirects are checked as well
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return egressPolicy.CheckURL(req.URL.String(), false)
+		},
 	}
 
 	httpRequestStartTime := time.Now()
 	resp, err := client.Do(req)
 	httpTimeElapsedSeconds := math.Round(time.Since(httpRequestStartTime).Seconds()*100) / 100
 
-	thisLogger.Info().Float64("httpTimeElapsedSeconds", httpTimeElapsedSeconds).Msg("http request sent")
+	logger.Info().Float64("httpTimeElapsedSeconds", httpTimeElapsedSeconds).Msg("http request sent")
 
-	var body *string
-	var httpStatusCodeResponse *int
-	var attemptResultStatus string
+	webhookResponse.HttpResponseTimeSecs = httpTimeElapsedSeconds
 
 	if err == nil {
 		defer resp.Body.Close()
 
-		thisLogger.Debug().Msg("no error, reading response body")
+		logger.Debug().Msg("no error, reading response body")
 		// read only first 200 bytes
 		byteBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 200))
 		stringBody := string(byteBody)
-		body = &stringBody
+		webhookResponse.HttpBodyResponse = &stringBody
 
-		thisLogger.Debug().Str("responseBody", *body).Int("responseHttpStatusCode", resp.StatusCode).Msg("decoded body")
+		logger.Debug().Str("responseBody", stringBody).Int("responseHttpStatusCode", resp.StatusCode).Msg("decoded body")
 
-		httpStatusCodeResponse = &resp.StatusCode
+		webhookResponse.HttpStatusCode = &resp.StatusCode
 
-		if *httpStatusCodeResponse >= 200 && *httpStatusCodeResponse <= 299 {
-			attemptResultStatus = "success"
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			webhookResponse.Status = "success"
 		} else {
-			attemptResultStatus = "error_response"
+			webhookResponse.Status = "error_response"
 		}
 	} else {
-		thisLogger.Warn().Bool("webhookEndpointError", true).Stack().Err(err).Msg("error while sending request")
+		logger.Warn().Bool("webhookEndpointError", true).Stack().Err(err).Msg("error while sending request")
 
 		// in case of errors during request (e.g. timeout, connection etc.)
-		// we don't have access to response body/status code, set nil
-		body = nil
-		httpStatusCodeResponse = nil
+		// we don't have access to response body/status code, left nil
 
 		// set status based on error type
-		if os.IsTimeout(err) {
-			attemptResultStatus = "error_timeout"
+		var egressDeniedErr *utils.EgressDeniedError
+		if errors.As(err, &egressDeniedErr) {
+			webhookResponse.Status = "error_blocked"
+		} else if os.IsTimeout(err) {
+			webhookResponse.Status = "error_timeout"
 		} else {
 			// using error_network as a generic error
-			attemptResultStatus = "error_network"
+			webhookResponse.Status = "error_network"
 		}
 
 		// TODO save detailed error message for internal use?
 	}
 
+	return webhookResponse, nil
+}
+
+func (app *workerPgGo) CallWebhookEndpointJob(data []byte) error {
+	var decodedData EventDeliveryAttempt
+	json.Unmarshal(data, &decodedData)
+
+	thisLogger := app.logger.With().Uint("eventDeliveryAttemptId", decodedData.EventDeliveryAttemptId).Logger()
+
+	thisLogger.Info().Msg("started work")
+
+	eventDeliveryAttemptId := decodedData.EventDeliveryAttemptId
+	eventId := decodedData.EventId
+	endpointId := decodedData.EndpointId
+
+	var eventDeliveryAttempt models.EventDeliveryAttempt
+	app.gormDb.Find(&eventDeliveryAttempt, eventDeliveryAttemptId)
+
+	if eventDeliveryAttempt.AttemptMadeAt != nil {
+		panic("expected AttemptMadeAt to be empty")
+	}
+
+	// get event data
+	var eventData models.Event
+	app.gormDb.Find(&eventData, eventId)
+
+	var eventDelivery models.EventDelivery
+	app.gormDb.Find(&eventDelivery, eventDeliveryAttempt.EventDeliveryID)
+
+	// get endpoint url, deleted endpoints are not returned
+	var endpointToCall models.Endpoint
+	result := app.gormDb.Where("id = ?", endpointId).First(&endpointToCall)
+
+	// skip deliveries cancelled or for endpoints deleted in the meantime
+	if result.Error != nil || eventDelivery.Status == constants.DeliveryStatusCancelled {
+		thisLogger.Info().Str("endpointId", endpointId).Msg("webhook endpoint deleted or delivery cancelled, skipping")
+
+		app.gormDb.Model(&models.EventDeliveryAttempt{Id: eventDeliveryAttemptId}).Update("status", constants.AttemptStatusCancelled)
+
+		return nil
+	}
+
+	// deliveries are held while the endpoint circuit is open
+	circuitBreakerEnabled := viper.GetBool("circuitBreaker.enabled")
+	if circuitBreakerEnabled && !app.circuitAllowsDelivery(endpointToCall, eventDelivery.Id) {
+		thisLogger.Info().Str("endpointId", endpointId).Str("circuitState", endpointToCall.CircuitState).Msg("endpoint circuit not closed, holding delivery")
+
+		app.holdDelivery(eventDelivery.Id)
+
+		return nil
+	}
+
+	// sign event and make http request
+	webhookResponse, err := SendWebhookRequest(endpointToCall, eventData.EventContent.String(), thisLogger)
+	if err != nil {
+		// failed like any other attempt, the delivery is retried with backoff
+		thisLogger.Error().Stack().Err(err).Str("endpointId", endpointId).Msg("unable to prepare webhook request")
+		webhookResponse = &WebhookResponse{
+			Timestamp: time.Now().Unix(),
+			Status:    constants.AttemptStatusErrorInternal,
+		}
+	}
+
+	timestamp := webhookResponse.Timestamp
+	body := webhookResponse.HttpBodyResponse
+	httpStatusCodeResponse := webhookResponse.HttpStatusCode
+	httpTimeElapsedSeconds := webhookResponse.HttpResponseTimeSecs
+	attemptResultStatus := webhookResponse.Status
+
 	thisLogger.Debug().Str("attemptResultStatus", attemptResultStatus).Msg("computed status")
 
+	// outcome notified with system events once committed
+	endpointDisabledReason := ""
+	deliveryExhausted := false
+
 	app.gormDb.Transaction(func(tx *gorm.DB) error {
 		var eventDelivery models.EventDelivery
 		tx.Find(&eventDelivery, eventDeliveryAttempt.EventDeliveryID)
@@ -175,9 +303,10 @@ func (app *workerPgGo) CallWebhookEndpointJob(data []byte) error {
 
 		// check if status code is 2xx, if yes reset attempts remaining
 		// as we have successfully delivered the event with this attempt
-		if resp != nil && resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		if attemptResultStatus == "success" {
 			attemptsRemaining = 0
-			attemptResultStatus = "success"
+
+			tx.Model(&models.EventDelivery{Id: eventDeliveryAttempt.EventDeliveryID}).Update("status", constants.DeliveryStatusSuccess)
 		} else {
 			// reschedule attempt in the future
 			// create new event delivery attempt and queue job
@@ -191,27 +320,85 @@ func (app *workerPgGo) CallWebhookEndpointJob(data []byte) error {
 				nextAttemptCounter += 1
 			}
 
-			// circuit breaker on max attempts, update
-			// webhook endpoint state and don't trigger new job
+			// 410 Gone, the receiver doesn't want the events anymore
+			endpointGone := httpStatusCodeResponse != nil && *httpStatusCodeResponse == http.StatusGone
+
+			// no more attempts, don't trigger new job
 			noMoreAttempts := false
-			if eventDelivery.AttemptsRemaining-1 <= 0 {
+			if eventDelivery.AttemptsRemaining-1 <= 0 || endpointGone {
 				noMoreAttempts = true
 			}
 
 			// calculate backoff
 			if noMoreAttempts == true {
-				thisLogger.Info().Str("endpointId", decodedData.EndpointId).Msg("reached maximum attempts, disabling endpoint...")
-				tx.Model(models.Endpoint{
-					Id: decodedData.EndpointId,
-				}).Update("status", constants.StatusDisabled)
+				tx.Model(&models.EventDelivery{Id: eventDeliveryAttempt.EventDeliveryID}).Update("status", constants.DeliveryStatusFailed)
+
+				deliveryExhausted = true
+
+				// a 410 response disables the endpoint, otherwise without circuit breaker the
+				// endpoint is disabled and with it only the delivery fails (the circuit handles the endpoint health)
+				disableReason := ""
+				if endpointGone {
+					thisLogger.Info().Str("endpointId", decodedData.EndpointId).Msg("endpoint responded 410 gone, disabling endpoint...")
+					attemptsRemaining = 0
+					disableReason = constants.StatusReasonHttpGone
+				} else if circuitBreakerEnabled {
+					thisLogger.Info().Str("endpointId", decodedData.EndpointId).Msg("reached maximum attempts, delivery failed")
+				} else {
+					thisLogger.Info().Str("endpointId", decodedData.EndpointId).Msg("reached maximum attempts, disabling endpoint...")
+					disableReason = constants.StatusReasonMaxAttemptsExceeded
+				}
+
+				// keep the failed delivery with its final error until replayed or purged
+				deadLetterReason := constants.StatusReasonMaxAttemptsExceeded
+				if endpointGone {
+					deadLetterReason = constants.StatusReasonHttpGone
+				}
+				deadLetter := models.DeadLetter{
+					TenantID:             endpointToCall.TenantID,
+					EventDeliveryID:      eventDeliveryAttempt.EventDeliveryID,
+					EventID:              eventData.Id,
+					EventType:            eventData.EventType,
+					EndpointID:           decodedData.EndpointId,
+					Reason:               deadLetterReason,
+					AttemptsCounter:      eventDelivery.AttemptsCounter + 1,
+					LastAttemptStatus:    attemptResultStatus,
+					LastHttpStatusCode:   httpStatusCodeResponse,
+					LastHttpBodyResponse: body,
+					LastAttemptMadeAt:    &timestamp,
+				}
+				// nested transaction (savepoint), a failure doesn't abort the delivery update
+				err := tx.Transaction(func(tx *gorm.DB) error {
+					return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&deadLetter).Error
+				})
+				if err != nil {
+					thisLogger.Error().Stack().Err(err).Msg("unable to create dead letter")
+				}
+
+				if disableReason != "" {
+					disabled, err := utils.SetEndpointStatus(tx, decodedData.EndpointId, utils.EndpointStatusChange{
+						Status: constants.StatusDisabled,
+						Reason: disableReason,
+					})
+					if err != nil {
+						thisLogger.Error().Stack().Err(err).Str("endpointId", decodedData.EndpointId).Msg("unable to disable endpoint")
+					} else if disabled {
+						endpointDisabledReason = disableReason
+					}
+				}
 			} else {
-				backoffSeconds := viper.GetUint("backoffStrategy.baseSecs")
-				randomJitter := rand.Float64()
-				nextRetrySeconds := math.Pow(float64(backoffSeconds), float64(nextAttemptCounter)+randomJitter)
+				deliveryPolicy := utils.GetDeliveryPolicy(endpointToCall)
+				backoffStrategy, err := deliveryPolicy.BackoffStrategy()
+				if err != nil {
+					// configuration changed after the endpoint policy was validated
+					thisLogger.Error().Err(err).Str("endpointId", decodedData.EndpointId).Msg("invalid endpoint backoff strategy, using fixed backoff")
+					backoffStrategy, _ = utils.NewBackoffStrategy(constants.BackoffFixed, viper.GetUint("backoffStrategy.baseSecs"), 0, nil)
+				}
+				nextRetrySeconds := backoffStrategy.NextRetrySeconds(nextAttemptCounter)
 
 				nextAttemptScheduled := uint(time.Now().Unix() + int64(nextRetrySeconds))
 
-				thisLogger.Info().Float64("nextRetrySeconds", nextRetrySeconds).Uint("nextAttemptScheduled", nextAttemptScheduled).Msg("rescheduling event delivery with exponential backoff")
+				thisLogger.Info().Float64("nextRetrySeconds", nextRetrySeconds).Uint("nextAttemptScheduled", nextAttemptScheduled).Str("backoffType", deliveryPolicy.BackoffType).Msg("rescheduling event delivery with backoff")
 
 				// update event delivery with next attempt scheduled at
 				tx.Model(models.EventDelivery{
@@ -258,11 +445,44 @@ func (app *workerPgGo) CallWebhookEndpointJob(data []byte) error {
 		return nil
 	})
 
+	if circuitBreakerEnabled {
+		app.recordCircuitOutcome(endpointId, eventDelivery.Id, attemptResultStatus, thisLogger)
+	}
+
+	if endpointDisabledReason != "" {
+		app.emitSystemEvent(endpointToCall, constants.SystemEventEndpointDisabled, map[string]interface{}{
+			"reason":      endpointDisabledReason,
+			"disabled_at": time.Now().Unix(),
+		}, thisLogger)
+	}
+
+	// failed deliveries of system events don't emit new ones
+	if deliveryExhausted && !eventMapping.IsSystemEvent(eventData.EventType) {
+		app.emitSystemEvent(endpointToCall, constants.SystemEventDeliveryExhausted, map[string]interface{}{
+			"event_id":              eventData.Id,
+			"event_type":            eventData.EventType,
+			"event_delivery_id":     eventDelivery.Id,
+			"attempts":              eventDelivery.AttemptsCounter + 1,
+			"last_status":           attemptResultStatus,
+			"last_http_status_code": httpStatusCodeResponse,
+		}, thisLogger)
+	}
+
 	thisLogger.Info().Msg("job processed")
 
 	return nil
 }
 
+// emits a system event about the endpoint to the endpoint tenant
+func (app *workerPgGo) emitSystemEvent(endpoint models.Endpoint, eventType string, content map[string]interface{}, logger zerolog.Logger) {
+	content["endpoint_id"] = endpoint.Id
+	content["endpoint_url"] = endpoint.Url
+
+	if err := eventMapping.EmitSystemEvent(app.gormDb, app.worker, logger, endpoint.TenantID, eventType, content); err != nil {
+		logger.Error().Stack().Err(err).Str("eventType", eventType).Msg("unable to emit system event")
+	}
+}
+
 func (app *workerPgGo) RegisterWorker() {
 	worker := pgq.NewWorker(app.db, pgq.SetLogger(&app.logger))
 	err := worker.RegisterQueue(constants.QueueWebhookDelivery, app.CallWebhookEndpointJob)
@@ -272,6 +492,13 @@ func (app *workerPgGo) RegisterWorker() {
 		panic(err)
 	}
 
+	err = worker.RegisterQueue(constants.QueueCircuitProbe, app.CircuitProbeJob)
+
+	if err != nil {
+		app.logger.Error().Stack().Err(err).Msg("failed to register queue")
+		panic(err)
+	}
+
 	app.worker = worker
 }
 
@@ -317,6 +544,8 @@ func Start() {
 
 	worker.RegisterWorker()
 
+	worker.releaseCircuitsWhenDisabled()
+
 	workerName := constants.WorkerDispatcher
 
 	utils.RunWorker(
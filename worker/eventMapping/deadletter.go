@@ -0,0 +1,49 @@
+package eventMapping
+
+import (
+	"time"
+	"zebrahook/constants"
+	"zebrahook/models"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+// how often the expired dead letters are removed
+const deadLetterPurgeInterval = time.Hour
+
+// marks the dead letters of the event to the endpoint of the new delivery as
+// replayed by it, to be called in the transaction that creates the delivery
+func ResolveDeadLetters(gormDb *gorm.DB, eventDelivery models.EventDelivery) error {
+	result := gormDb.Model(&models.DeadLetter{}).
+		Where("event_id = ? AND endpoint_id = ? AND status = ?", eventDelivery.EventID, eventDelivery.EndpointID, constants.DeadLetterStatusDead).
+		Updates(map[string]interface{}{
+			"status":                   constants.DeadLetterStatusReplayed,
+			"replay_event_delivery_id": eventDelivery.Id,
+			"replayed_at":              time.Now().Unix(),
+		})
+	return result.Error
+}
+
+// job that removes the dead letters older than `deadLetters.retentionDays`
+func (app *workerPgGo) DeadLetterPurgeJob(data []byte) error {
+	thisLogger := app.logger.With().Str("job", constants.QueueDeadLetterPurge).Logger()
+
+	// always scheduled again, even on failure
+	defer app.schedulePeriodicJob(constants.QueueDeadLetterPurge, time.Now().Add(deadLetterPurgeInterval), thisLogger)
+
+	retentionDays := viper.GetInt64("deadLetters.retentionDays")
+	if retentionDays == 0 {
+		return nil
+	}
+
+	result := app.gormDb.Unscoped().Where("created_at < ?", time.Now().Unix()-retentionDays*86400).Delete(&models.DeadLetter{})
+	if result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to remove expired dead letters")
+		return result.Error
+	}
+
+	thisLogger.Info().Int64("deadLettersRemoved", result.RowsAffected).Msg("expired dead letters removed")
+
+	return nil
+}
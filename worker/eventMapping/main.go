@@ -15,7 +15,6 @@ import (
 
 	"github.com/nya1/pgq"
 	"github.com/rs/zerolog"
-	"github.com/spf13/viper"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -73,38 +72,189 @@ func (app *workerPgGo) EventToEndpointsMappingJob(data []byte) error {
 
 	thisLogger.Debug().Interface("decodedEvent", decodedData).Msg("")
 
+	// endpoints are matched only within the tenant that submitted the event
+	var eventFound models.Event
+	if result := app.gormDb.First(&eventFound, "id = ?", eventId); result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to find event")
+		return result.Error
+	}
+
 	eventRegex := buildEventSearchRegex(eventType)
 
 	thisLogger.Debug().Str("regex", eventRegex).Msg("regex to use for query")
 
+	// system events are delivered only to endpoints explicitly subscribed to them
+	systemEvent := IsSystemEvent(eventType)
+
 	var endpointsToCall []models.Endpoint
-	app.gormDb.Raw(`SELECT id, url, enabled_event
+	app.gormDb.Raw(`SELECT DISTINCT id, url
 	FROM (
 		SELECT id, url, unnest(enabled_events) enabled_event
-		FROM endpoints WHERE status = ?) x
-	WHERE enabled_event ~ ? OR enabled_event = '*'
-	`, constants.StatusEnabled, eventRegex).Scan(&endpointsToCall)
+		FROM endpoints WHERE status = ? AND tenant_id = ? AND deleted_at IS NULL) x
+	WHERE enabled_event ~ ? OR (enabled_event = '*' AND NOT ?)
+	`, constants.StatusEnabled, eventFound.TenantID, eventRegex, systemEvent).Scan(&endpointsToCall)
 
 	thisLogger.Debug().Interface("endpointsToCall", endpointsToCall).Msg("")
 
+	endpointIds := []string{}
+	for _, endpoint := range endpointsToCall {
+		endpointIds = append(endpointIds, endpoint.Id)
+	}
+
+	// deliveries, their jobs and the mapping outcome are saved together,
+	// a retry of this job doesn't find partial deliveries
+	err := app.gormDb.Transaction(func(tx *gorm.DB) error {
+		if _, err := CreateEventDeliveries(tx, app.worker, thisLogger, eventId, endpointIds); err != nil {
+			return err
+		}
+
+		endpointsMatched := uint(len(endpointIds))
+		return tx.Model(&models.Event{}).Where("id = ?", eventId).Updates(map[string]interface{}{
+			"mapped_at":         time.Now().Unix(),
+			"endpoints_matched": endpointsMatched,
+		}).Error
+	})
+	if err != nil {
+		thisLogger.Error().Stack().Err(err).Msg("unable to create event deliveries")
+		return err
+	}
+
+	if systemEvent {
+		app.emailSystemEvent(eventFound, thisLogger)
+	}
+
+	thisLogger.Info().Msg("job processed")
+
+	return nil
+}
+
+type EndpointRecovery struct {
+	RecoveryId string
+}
+
+// job that delivers again all the event deliveries of an endpoint
+// that were not successfully delivered (no more attempts remaining),
+// deliveries are enqueued in the original event order
+func (app *workerPgGo) EndpointRecoveryJob(data []byte) error {
+	var decodedData EndpointRecovery
+	json.Unmarshal(data, &decodedData)
+
+	// create new logger with correlation id for this job
+	thisLogger := app.logger.With().Str("recoveryId", decodedData.RecoveryId).Logger()
+
+	thisLogger.Info().Msg("started work")
+
+	var recovery models.EndpointRecovery
+	if result := app.gormDb.First(&recovery, "id = ?", decodedData.RecoveryId); result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("endpoint recovery not found")
+		return result.Error
+	}
+
+	// shared query used to update the recovery progress
+	recoveryQuery := app.gormDb.Model(&models.EndpointRecovery{}).Where("id = ?", recovery.Id).Session(&gorm.Session{})
+
+	// only the latest delivery of each event is considered so that deliveries
+	// already recovered (or manually retried) are not delivered twice
+	var deliveriesToRecover []models.EventDelivery
+	result := app.gormDb.Raw(`SELECT d.id, d.event_id, d.endpoint_id
+	FROM event_deliveries d
+	JOIN events e ON e.id = d.event_id
+	WHERE d.endpoint_id = ? AND d.created_at >= ? AND d.attempts_remaining = 0
+	AND d.status <> ? AND d.deleted_at IS NULL
+	AND (
+		SELECT a.status FROM event_delivery_attempts a
+		WHERE a.event_delivery_id = d.id AND a.attempt_made_at IS NOT NULL
+		ORDER BY a.id DESC LIMIT 1
+	) <> 'success'
+	AND NOT EXISTS (
+		SELECT 1 FROM event_deliveries n
+		WHERE n.event_id = d.event_id AND n.endpoint_id = d.endpoint_id AND n.id > d.id
+	)
+	ORDER BY e.created_at ASC, e.id ASC
+	`, recovery.EndpointID, recovery.Since, constants.DeliveryStatusCancelled).Scan(&deliveriesToRecover)
+
+	if result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to query event deliveries to recover")
+		recoveryQuery.Update("status", constants.RecoveryStatusFailed)
+		return result.Error
+	}
+
+	thisLogger.Info().Int("deliveriesFound", len(deliveriesToRecover)).Msg("event deliveries to recover")
+
+	recoveryQuery.Updates(map[string]interface{}{
+		"status":           constants.RecoveryStatusRunning,
+		"deliveries_found": len(deliveriesToRecover),
+	})
+
+	for _, eventDelivery := range deliveriesToRecover {
+		err := app.gormDb.Transaction(func(tx *gorm.DB) error {
+			eventDeliveries, err := CreateEventDeliveries(tx, app.worker, thisLogger, eventDelivery.EventID, []string{recovery.EndpointID})
+			if err != nil {
+				return err
+			}
+
+			return ResolveDeadLetters(tx, eventDeliveries[0])
+		})
+		if err != nil {
+			thisLogger.Error().Stack().Err(err).Uint("eventId", eventDelivery.EventID).Msg("unable to create event delivery")
+			recoveryQuery.Update("status", constants.RecoveryStatusFailed)
+			return err
+		}
+
+		recoveryQuery.Update("deliveries_enqueued", gorm.Expr("deliveries_enqueued + ?", 1))
+	}
+
+	recoveryQuery.Update("status", constants.RecoveryStatusCompleted)
+
+	thisLogger.Info().Msg("job processed")
+
+	return nil
+}
+
+// creates an event delivery with its first attempt for each provided endpoint
+// and triggers the dispatcher jobs to perform the http requests, must be called
+// within a transaction (jobs are enqueued in it), returns the created event deliveries
+func CreateEventDeliveries(tx *gorm.DB, worker *pgq.Worker, logger zerolog.Logger, eventId uint, endpointIds []string) ([]models.EventDelivery, error) {
 	eventsToDelivery := []models.EventDelivery{}
+	if len(endpointIds) == 0 {
+		return eventsToDelivery, nil
+	}
+
+	sqlTx, err := database.SqlTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// endpoints delivery policy
+	var endpointsFound []models.Endpoint
+	if result := tx.Where("id IN ?", endpointIds).Find(&endpointsFound); result.Error != nil {
+		return nil, result.Error
+	}
+	endpointsById := map[string]models.Endpoint{}
+	for _, endpoint := range endpointsFound {
+		endpointsById[endpoint.Id] = endpoint
+	}
+
 	nextAttempt := uint(time.Now().Unix())
-	for _, endpoint := range endpointsToCall {
+	for _, endpointId := range endpointIds {
 
-		thisLogger.Debug().Interface("endpoint", endpoint).Msg("processing endpoint")
+		logger.Debug().Str("endpointId", endpointId).Msg("processing endpoint")
 
 		eventsToDelivery = append(eventsToDelivery, models.EventDelivery{
 			NextAttemptScheduledAt: &nextAttempt,
-			// on creation set the max attempt from the config
-			AttemptsRemaining: viper.GetUint("backoffStrategy.maxAttempts"),
-			EndpointID:        endpoint.Id,
+			// on creation set the max attempt from the endpoint delivery policy
+			AttemptsRemaining: utils.GetDeliveryPolicy(endpointsById[endpointId]).MaxAttempts,
+			EndpointID:        endpointId,
 			EventID:           eventId,
+			Status:            constants.DeliveryStatusPending,
 		})
 	}
 
-	thisLogger.Debug().Interface("eventsToDelivery", eventsToDelivery).Msg("events to delivery")
+	logger.Debug().Interface("eventsToDelivery", eventsToDelivery).Msg("events to delivery")
 
-	app.gormDb.Create(&eventsToDelivery)
+	if result := tx.Create(&eventsToDelivery); result.Error != nil {
+		return nil, result.Error
+	}
 
 	// create also first attempts records
 	attemptsToCreate := []models.EventDeliveryAttempt{}
@@ -113,9 +263,11 @@ func (app *workerPgGo) EventToEndpointsMappingJob(data []byte) error {
 			EventDeliveryID: eventDelivery.Id,
 		})
 	}
-	thisLogger.Debug().Interface("attemptsToCreate", attemptsToCreate).Msg("initial attempts list")
+	logger.Debug().Interface("attemptsToCreate", attemptsToCreate).Msg("initial attempts list")
 
-	app.gormDb.Create(&attemptsToCreate)
+	if result := tx.Create(&attemptsToCreate); result.Error != nil {
+		return nil, result.Error
+	}
 
 	// trigger jobs
 	for i, eventDeliveryAttempt := range attemptsToCreate {
@@ -125,15 +277,50 @@ func (app *workerPgGo) EventToEndpointsMappingJob(data []byte) error {
 			EventId:                eventsToDelivery[i].EventID,
 		}
 
-		thisLogger.Debug().Interface("eventDeliveryAttemptJob", eventDeliveryAttempt).Msg("triggering dispatcher job")
+		logger.Debug().Interface("eventDeliveryAttemptJob", eventDeliveryAttempt).Msg("triggering dispatcher job")
 
 		encodedData, _ := json.Marshal(eventDeliveryAttempt)
-		app.worker.EnqueueJob(constants.QueueWebhookDelivery, encodedData, pgq.RetryWaits([]time.Duration{}))
+		if _, err := worker.EnqueueJobInTx(sqlTx, constants.QueueWebhookDelivery, encodedData, pgq.RetryWaits([]time.Duration{})); err != nil {
+			return nil, err
+		}
 	}
 
-	thisLogger.Info().Msg("job processed")
+	return eventsToDelivery, nil
+}
 
-	return nil
+// enqueues a periodic job (rescheduled by the job itself) unless one is already
+// pending, jobs being worked on are locked by the worker and skipped. The check and
+// the enqueue are serialized by an advisory lock, workers starting together or a
+// job rescheduling itself don't enqueue twice
+func (app *workerPgGo) schedulePeriodicJob(queueName string, runAfter time.Time, logger zerolog.Logger) {
+	err := app.gormDb.Transaction(func(tx *gorm.DB) error {
+		sqlTx, err := database.SqlTx(tx)
+		if err != nil {
+			return err
+		}
+
+		if result := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", queueName); result.Error != nil {
+			return result.Error
+		}
+
+		var pending int64
+		result := tx.Raw(
+			"SELECT COUNT(*) FROM (SELECT id FROM pgq_jobs WHERE queue_name = ? AND ran_at IS NULL FOR UPDATE SKIP LOCKED) AS pending",
+			queueName,
+		).Scan(&pending)
+		if result.Error != nil {
+			return result.Error
+		}
+		if pending > 0 {
+			return nil
+		}
+
+		_, err = app.worker.EnqueueJobInTx(sqlTx, queueName, []byte("{}"), pgq.After(runAfter), pgq.RetryWaits([]time.Duration{}))
+		return err
+	})
+	if err != nil {
+		logger.Error().Stack().Err(err).Str("queue", queueName).Msg("unable to schedule periodic job")
+	}
 }
 
 func (app *workerPgGo) RegisterWorker() {
@@ -144,7 +331,28 @@ func (app *workerPgGo) RegisterWorker() {
 		panic(err)
 	}
 
+	err = worker.RegisterQueue(constants.QueueEndpointRecovery, app.EndpointRecoveryJob)
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = worker.RegisterQueue(constants.QueueStatsRollup, app.EndpointStatsRollupJob)
+
+	if err != nil {
+		panic(err)
+	}
+
+	err = worker.RegisterQueue(constants.QueueDeadLetterPurge, app.DeadLetterPurgeJob)
+
+	if err != nil {
+		panic(err)
+	}
+
 	app.worker = worker
+
+	app.schedulePeriodicJob(constants.QueueStatsRollup, time.Now(), app.logger)
+	app.schedulePeriodicJob(constants.QueueDeadLetterPurge, time.Now(), app.logger)
 }
 
 func NewWorker() *workerPgGo {
@@ -0,0 +1,153 @@
+package eventMapping
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"zebrahook/constants"
+	"zebrahook/models"
+	"zebrahook/utils"
+
+	"github.com/spf13/viper"
+	"gorm.io/datatypes"
+	"gorm.io/gorm/clause"
+)
+
+// attempts grouped by endpoint, bucket, status, http status code and response time bucket
+type attemptsGroup struct {
+	EndpointId          string
+	BucketStart         int64
+	Status              string
+	HttpStatusCode      *int
+	ResponseTimeBucket  *int
+	Attempts            uint
+	ResponseTimeMaxSecs *float64
+}
+
+// job that updates the endpoint stats rollup table, the buckets are recomputed
+// from the latest one (attempts are recorded when their request completes) and
+// the rollups older than the largest stats window are removed
+func (app *workerPgGo) EndpointStatsRollupJob(data []byte) error {
+	thisLogger := app.logger.With().Str("job", constants.QueueStatsRollup).Logger()
+
+	// always scheduled again, even on failure
+	defer app.schedulePeriodicJob(constants.QueueStatsRollup, time.Now().Add(time.Duration(viper.GetUint("stats.rollupIntervalSecs"))*time.Second), thisLogger)
+
+	now := time.Now().Unix()
+	retention := utils.StatsWindows["7d"] + utils.StatsBucketSecs
+
+	var lastBucketStart *int64
+	if result := app.gormDb.Model(&models.EndpointStatsRollup{}).Select("MAX(bucket_start)").Scan(&lastBucketStart); result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to find latest stats bucket")
+		return result.Error
+	}
+	from := now - retention
+	if lastBucketStart != nil && *lastBucketStart-utils.StatsBucketSecs > from {
+		from = *lastBucketStart - utils.StatsBucketSecs
+	}
+	from -= from % utils.StatsBucketSecs
+
+	bounds := []string{}
+	for _, bound := range utils.ResponseTimeBounds {
+		bounds = append(bounds, strconv.FormatFloat(bound, 'f', -1, 64))
+	}
+
+	var groups []attemptsGroup
+	result := app.gormDb.Raw(`
+		SELECT
+			d.endpoint_id,
+			a.attempt_made_at - a.attempt_made_at % ? AS bucket_start,
+			a.status,
+			a.http_status_code,
+			width_bucket(a.http_response_time_secs, ?::real[]) AS response_time_bucket,
+			COUNT(*) AS attempts,
+			MAX(a.http_response_time_secs) AS response_time_max_secs
+		FROM event_delivery_attempts a
+		JOIN event_deliveries d ON d.id = a.event_delivery_id
+		WHERE a.attempt_made_at >= ? AND a.deleted_at IS NULL
+		GROUP BY 1, 2, 3, 4, 5`,
+		utils.StatsBucketSecs, "{"+strings.Join(bounds, ",")+"}", from,
+	).Scan(&groups)
+	if result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to aggregate delivery attempts")
+		return result.Error
+	}
+
+	type rollupKey struct {
+		endpointId  string
+		bucketStart int64
+	}
+	type rollupCounts struct {
+		rollup           models.EndpointStatsRollup
+		statusCounts     map[string]uint
+		httpStatusCounts map[string]uint
+	}
+	countsByKey := map[rollupKey]*rollupCounts{}
+	keys := []rollupKey{}
+	for _, group := range groups {
+		key := rollupKey{group.EndpointId, group.BucketStart}
+		counts, found := countsByKey[key]
+		if !found {
+			counts = &rollupCounts{
+				rollup: models.EndpointStatsRollup{
+					EndpointID:            group.EndpointId,
+					BucketStart:           group.BucketStart,
+					ResponseTimeHistogram: make([]int64, len(utils.ResponseTimeBounds)+1),
+				},
+				statusCounts:     map[string]uint{},
+				httpStatusCounts: map[string]uint{},
+			}
+			countsByKey[key] = counts
+			keys = append(keys, key)
+		}
+
+		counts.rollup.Attempts += group.Attempts
+		if group.Status == constants.AttemptStatusSuccess {
+			counts.rollup.Successes += group.Attempts
+		}
+		counts.statusCounts[group.Status] += group.Attempts
+		if group.HttpStatusCode != nil {
+			counts.httpStatusCounts[strconv.Itoa(*group.HttpStatusCode)] += group.Attempts
+		}
+		if group.ResponseTimeBucket != nil {
+			counts.rollup.ResponseTimeHistogram[*group.ResponseTimeBucket] += int64(group.Attempts)
+		}
+		if group.ResponseTimeMaxSecs != nil && *group.ResponseTimeMaxSecs > counts.rollup.ResponseTimeMaxSecs {
+			counts.rollup.ResponseTimeMaxSecs = *group.ResponseTimeMaxSecs
+		}
+	}
+
+	rollups := []models.EndpointStatsRollup{}
+	for _, key := range keys {
+		counts := countsByKey[key]
+		encodedStatusCounts, _ := json.Marshal(counts.statusCounts)
+		encodedHttpStatusCounts, _ := json.Marshal(counts.httpStatusCounts)
+		counts.rollup.StatusCounts = datatypes.JSON(encodedStatusCounts)
+		counts.rollup.HttpStatusCounts = datatypes.JSON(encodedHttpStatusCounts)
+		rollups = append(rollups, counts.rollup)
+	}
+
+	if len(rollups) > 0 {
+		result = app.gormDb.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "endpoint_id"}, {Name: "bucket_start"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"attempts", "successes", "status_counts", "http_status_counts",
+				"response_time_histogram", "response_time_max_secs", "updated_at",
+			}),
+		}).CreateInBatches(&rollups, 500)
+		if result.Error != nil {
+			thisLogger.Error().Stack().Err(result.Error).Msg("unable to save stats rollups")
+			return result.Error
+		}
+	}
+
+	if result := app.gormDb.Unscoped().Where("bucket_start < ?", now-retention).Delete(&models.EndpointStatsRollup{}); result.Error != nil {
+		thisLogger.Error().Stack().Err(result.Error).Msg("unable to remove expired stats rollups")
+		return result.Error
+	}
+
+	thisLogger.Info().Int("rollups", len(rollups)).Int64("from", from).Msg("endpoint stats rolled up")
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package eventMapping
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+	"zebrahook/constants"
+	"zebrahook/models"
+	"zebrahook/utils"
+
+	"github.com/nya1/pgq"
+	"github.com/rs/zerolog"
+	"github.com/spf13/viper"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// true for the events emitted by zebrahook (`zebrahook.*`)
+func IsSystemEvent(eventType string) bool {
+	return strings.HasPrefix(eventType, constants.SystemEventPrefix)
+}
+
+// emits a system event for the tenant, the event is mapped like the submitted
+// ones (and emailed when configured) by the event mapping worker
+func EmitSystemEvent(gormDb *gorm.DB, worker *pgq.Worker, logger zerolog.Logger, tenantId string, eventType string, content map[string]interface{}) error {
+	if !viper.GetBool("notifications.systemEvents") {
+		return nil
+	}
+
+	encodedContent, err := json.Marshal(content)
+	if err != nil {
+		return err
+	}
+
+	event := models.Event{
+		EventType:    eventType,
+		EventContent: datatypes.JSON(encodedContent),
+		TenantID:     tenantId,
+	}
+	if result := gormDb.Create(&event); result.Error != nil {
+		return result.Error
+	}
+
+	encodedEventMapping, _ := json.Marshal(EventMapping{
+		EventType: event.EventType,
+		EventId:   event.Id,
+	})
+	if _, err := worker.EnqueueJob(constants.QueueEventMapping, encodedEventMapping, pgq.RetryWaits([]time.Duration{})); err != nil {
+		return err
+	}
+
+	logger.Info().Str("eventType", eventType).Uint("eventId", event.Id).Msg("system event emitted")
+
+	return nil
+}
+
+// emails the system event to the endpoint owner (endpoint metadata) and to the
+// configured operators, failures are logged and not retried
+func (app *workerPgGo) emailSystemEvent(event models.Event, logger zerolog.Logger) {
+	if !viper.GetBool("notifications.smtp.enabled") {
+		return
+	}
+	emailed := false
+	for _, eventType := range viper.GetStringSlice("notifications.smtp.eventTypes") {
+		if eventType == event.EventType {
+			emailed = true
+		}
+	}
+	if !emailed {
+		return
+	}
+
+	var content map[string]interface{}
+	if err := json.Unmarshal(event.EventContent, &content); err != nil {
+		logger.Error().Stack().Err(err).Msg("unable to decode system event content")
+		return
+	}
+
+	recipients := viper.GetStringSlice("notifications.smtp.to")
+
+	endpointId, _ := content["endpoint_id"].(string)
+	var endpoint models.Endpoint
+	if result := app.gormDb.Unscoped().Where("id = ?", endpointId).First(&endpoint); result.Error == nil {
+		var metadata map[string]string
+		json.Unmarshal(endpoint.Metadata, &metadata)
+
+		if ownerEmail := metadata[viper.GetString("notifications.smtp.ownerMetadataKey")]; ownerEmail != "" {
+			// set by the tenant, only a plain address of the allowed domains is emailed
+			if err := utils.CheckTenantEmail(ownerEmail, viper.GetStringSlice("notifications.smtp.ownerDomains")); err != nil {
+				logger.Warn().Err(err).Str("endpointId", endpointId).Msg("invalid owner email in endpoint metadata")
+			} else {
+				recipients = append(recipients, ownerEmail)
+			}
+		}
+	}
+
+	if len(recipients) == 0 {
+		logger.Debug().Msg("no recipients for system event email")
+		return
+	}
+
+	indentedContent, _ := json.MarshalIndent(content, "", "  ")
+	subject := "[Zebrahook] " + event.EventType + " " + endpointId
+	body := "Zebrahook emitted the event " + event.EventType + " for the webhook endpoint " + endpointId + ".\n\n" + string(indentedContent) + "\n"
+
+	if err := utils.NewMailer().Send(recipients, subject, body); err != nil {
+		logger.Error().Stack().Err(err).Msg("unable to send system event email")
+		return
+	}
+
+	logger.Info().Int("recipients", len(recipients)).Msg("system event emailed")
+}